@@ -76,6 +76,42 @@ func (c *replicationClient) DigestObjects(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int,
+) ([]uint64, error) {
+	var resp []uint64
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", fmt.Sprintf("_hashtree/%d", level), nil)
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*90, req, nil, &resp)
+	return resp, err
+}
+
+func (c *replicationClient) DigestObjectsInRange(ctx context.Context,
+	host, index, shard string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	var resp []replica.RepairResponse
+	body, err := json.Marshal(replica.DigestRangeRequest{
+		InitialUUID: initialUUID,
+		FinalUUID:   finalUUID,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal digest objects in range input: %w", err)
+	}
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_range_digest", bytes.NewReader(body))
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*90, req, body, &resp)
+	return resp, err
+}
+
 func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -528,6 +528,59 @@ func TestReplicationDigestObjects(t *testing.T) {
 	assert.Equal(t, expected[1].Version, resp[1].Version)
 }
 
+func TestReplicationHashTreeLevel(t *testing.T) {
+	t.Parallel()
+
+	expected := []uint64{1, 2, 3, 4}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/replicas/indices/C1/shards/S1/objects/_hashtree/2", r.URL.Path)
+		b, _ := json.Marshal(expected)
+		w.Write(b)
+	}))
+	defer server.Close()
+
+	c := newReplicationClient(server.Client())
+	resp, err := c.HashTreeLevel(context.Background(), server.URL[7:], "C1", "S1", 2)
+	require.Nil(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+func TestReplicationDigestObjectsInRange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	expected := []replica.RepairResponse{
+		{
+			ID:         UUID1.String(),
+			UpdateTime: now.UnixMilli(),
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/replicas/indices/C1/shards/S1/objects/_range_digest", r.URL.Path)
+
+		var params replica.DigestRangeRequest
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&params))
+		assert.Equal(t, replica.DigestRangeRequest{
+			InitialUUID: UUID1,
+			FinalUUID:   UUID2,
+			Limit:       10,
+		}, params)
+
+		b, _ := json.Marshal(expected)
+		w.Write(b)
+	}))
+	defer server.Close()
+
+	c := newReplicationClient(server.Client())
+	resp, err := c.DigestObjectsInRange(context.Background(), server.URL[7:], "C1", "S1",
+		UUID1, UUID2, 10)
+	require.Nil(t, err)
+	assert.Equal(t, expected, resp)
+}
+
 func TestReplicationOverwriteObjects(t *testing.T) {
 	t.Parallel()
 
@@ -23,6 +23,7 @@ const (
 	Certainty            = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
 	Distance             = "The required degree of similarity between an object's characteristics and the provided filter values"
 	Vector               = "Target vector to be used in kNN search"
+	TargetVector         = "Name of the named vector to search, required if a class has more than one named vector"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
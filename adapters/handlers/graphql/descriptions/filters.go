@@ -68,6 +68,9 @@ const (
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueTextProximity                = "Specify words and a maximum distance between them. The search will return any result in which all words of the text occur in any order, with at most the specified number of words between the first and the last of them. Requires the operator WithinWords. With the operator Fuzzy, specify a term and its maximum edit distance instead."
+	WhereValueTextProximityText            = "The words to search for."
+	WhereValueTextProximityDistance        = "The maximum number of words between the first and the last matched word, or the maximum edit distance for the operator Fuzzy."
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
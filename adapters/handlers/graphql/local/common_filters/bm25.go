@@ -31,6 +31,11 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	fuzziness, ok := source["fuzziness"]
+	if ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -38,6 +38,9 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 					"LessThanEqual":    &graphql.EnumValueConfig{},
 					"WithinGeoRange":   &graphql.EnumValueConfig{},
 					"IsNull":           &graphql.EnumValueConfig{},
+					"ContainsPhrase":   &graphql.EnumValueConfig{},
+					"WithinWords":      &graphql.EnumValueConfig{},
+					"Fuzzy":            &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -75,6 +78,10 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueTextProximity": &graphql.InputObjectFieldConfig{
+			Type:        newTextProximityInputObject(path),
+			Description: descriptions.WhereValueTextProximity,
+		},
 	}
 
 	// Recurse into the same time.
@@ -110,6 +117,22 @@ func newGeoRangeInputObject(path string) *graphql.InputObject {
 	})
 }
 
+func newTextProximityInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereTextProximityInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"text": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.String),
+				Description: descriptions.WhereValueTextProximityText,
+			},
+			"distance": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Int),
+				Description: descriptions.WhereValueTextProximityDistance,
+			},
+		},
+	})
+}
+
 func newGeoRangeGeoCoordinatesInputObject(path string) *graphql.InputObject {
 	return graphql.NewInputObject(graphql.InputObjectConfig{
 		Name: fmt.Sprintf("%sWhereGeoRangeGeoCoordinatesInpObj", path),
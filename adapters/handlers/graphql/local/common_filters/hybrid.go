@@ -105,6 +105,11 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
 	if _, ok := source["properties"]; ok {
 		properties := source["properties"].([]interface{})
 		args.Properties = make([]string, len(properties))
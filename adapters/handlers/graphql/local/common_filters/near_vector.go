@@ -39,6 +39,11 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 		args.WithDistance = true
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
 	if certaintyOK && distanceOK {
 		return searchparams.NearVector{},
 			fmt.Errorf("cannot provide distance and certainty")
@@ -160,6 +160,63 @@ func TestExtractFilterIsNull(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterPhrase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("contains phrase", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsPhrase,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("name"),
+			},
+			Value: &filters.Value{
+				Value: "machine learning",
+				Type:  schema.DataTypeText,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: ContainsPhrase,
+			valueText: "machine learning",
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("within words", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorWithinWords,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("name"),
+			},
+			Value: &filters.Value{
+				Value: filters.TextProximity{
+					Text:     "machine learning",
+					Distance: 5,
+				},
+				Type: schema.DataTypeText,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: WithinWords,
+			valueTextProximity: { text: "machine learning", distance: 5 }
+		}) }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractFilterGeoLocation(t *testing.T) {
 	t.Parallel()
 
@@ -37,7 +37,7 @@ type mockResolver struct {
 
 type fakeModulesProvider struct{}
 
-func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className, targetVector string, input string) ([]float32, error) {
 	panic("not implemented")
 }
 
@@ -152,6 +152,9 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["certainty"] = b.additionalCertaintyField(class)
 	additionalProperties["distance"] = b.additionalDistanceField(class)
 	additionalProperties["vector"] = b.additionalVectorField(class)
+	if len(class.VectorConfig) > 0 {
+		additionalProperties["vectors"] = b.additionalVectorsField(class)
+	}
 	additionalProperties["id"] = b.additionalIDField()
 	additionalProperties["creationTimeUnix"] = b.additionalCreationTimeUnix()
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
@@ -215,6 +218,21 @@ func (b *classBuilder) additionalVectorField(class *models.Class) *graphql.Field
 	}
 }
 
+func (b *classBuilder) additionalVectorsField(class *models.Class) *graphql.Field {
+	fields := graphql.Fields{}
+	for targetVector := range class.VectorConfig {
+		fields[targetVector] = &graphql.Field{
+			Type: graphql.NewList(graphql.Float),
+		}
+	}
+	return &graphql.Field{
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name:   fmt.Sprintf("%sAdditionalVectors", class.Class),
+			Fields: fields,
+		}),
+	}
+}
+
 func (b *classBuilder) additionalCreationTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
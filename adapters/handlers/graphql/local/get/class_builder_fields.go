@@ -562,7 +562,7 @@ type additionalCheck struct {
 
 func (ac *additionalCheck) isAdditional(name string) bool {
 	if name == "classification" || name == "certainty" ||
-		name == "distance" || name == "id" || name == "vector" ||
+		name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
 		name == "group" {
@@ -642,7 +642,7 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ID = true
 							continue
 						}
-						if additionalProperty == "vector" {
+						if additionalProperty == "vector" || additionalProperty == "vectors" {
 							additionalProps.Vector = true
 							continue
 						}
@@ -1204,6 +1204,27 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for actions with target vector", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+								targetVector: "title"
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector:       []float32{0.123, 0.984},
+				TargetVector: "title",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -1852,6 +1873,29 @@ func TestBM25WithSort(t *testing.T) {
 	resolver.AssertFailToResolve(t, query, "bm25 search is not compatible with sort")
 }
 
+func TestBM25WithFuzziness(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "aple",
+			Properties: []string{"name"},
+			Fuzziness:  1,
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ Get { SomeAction(bm25:{query:"aple",properties:["name"],fuzziness:1}) { intField } } }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestHybridWithSort(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
@@ -56,6 +56,10 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Vector search",
 			Type:        graphql.NewList(graphql.Float),
 		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVector,
+			Type:        graphql.String,
+		},
 		"properties": &graphql.InputObjectFieldConfig{
 			Description: "Which properties should be included in the sparse search",
 			Type:        graphql.NewList(graphql.String),
@@ -39,5 +39,10 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "The maximum number of edits (0 to 2) for a query term to match a term of the properties. " +
+				"Terms of up to 2 characters always need to match exactly, terms of up to 5 characters allow a single edit",
+			Type: graphql.Int,
+		},
 	}
 }
@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchObjects imports all objects of the request. A failure of a single
+// object does not fail the whole batch, it is instead reported in the errors
+// of the reply with the index of the object in the request.
+func (s *Server) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	var batchErrors []*pb.BatchError
+
+	// objects which cannot be converted are not sent to the batch manager, so
+	// the indexes of the batch need to be mapped back to the request
+	objs := make([]*models.Object, 0, len(req.Objects))
+	requestIndex := make([]int, 0, len(req.Objects))
+	for i, in := range req.Objects {
+		obj, err := objectFromProto(in)
+		if err != nil {
+			batchErrors = append(batchErrors, &pb.BatchError{Index: int32(i), Error: err.Error()})
+			continue
+		}
+		objs = append(objs, obj)
+		requestIndex = append(requestIndex, i)
+	}
+
+	res, err := s.batchManager.AddObjects(ctx, principal, objs, nil,
+		replicationPropertiesFromProto(req.ConsistencyLevel))
+	if err != nil {
+		return nil, errorToStatus(err)
+	}
+
+	for _, obj := range res {
+		if obj.Err != nil {
+			batchErrors = append(batchErrors, &pb.BatchError{
+				Index: int32(requestIndex[obj.OriginalIndex]),
+				Error: obj.Err.Error(),
+			})
+		}
+	}
+
+	return &pb.BatchObjectsReply{
+		Errors: sortBatchErrors(batchErrors),
+		Took:   float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+// BatchReferences adds all references of the request. Like for objects,
+// errors are reported per reference.
+func (s *Server) BatchReferences(ctx context.Context, req *pb.BatchReferencesRequest) (*pb.BatchReferencesReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	refs := make([]*models.BatchReference, len(req.References))
+	for i, ref := range req.References {
+		refs[i] = batchReferenceFromProto(ref)
+	}
+
+	res, err := s.batchManager.AddReferences(ctx, principal, refs,
+		replicationPropertiesFromProto(req.ConsistencyLevel))
+	if err != nil {
+		return nil, errorToStatus(err)
+	}
+
+	var batchErrors []*pb.BatchError
+	for _, ref := range res {
+		if ref.Err != nil {
+			batchErrors = append(batchErrors, &pb.BatchError{
+				Index: int32(ref.OriginalIndex),
+				Error: ref.Err.Error(),
+			})
+		}
+	}
+
+	return &pb.BatchReferencesReply{
+		Errors: sortBatchErrors(batchErrors),
+		Took:   float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Server) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if req.Filters == nil {
+		return nil, status.Error(codes.InvalidArgument, "filters are required")
+	}
+
+	where, err := whereFilterFromProto(req.Filters)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "extract filters: %v", err)
+	}
+
+	output := "minimal"
+	if req.Verbose {
+		output = "verbose"
+	}
+
+	res, err := s.batchManager.DeleteObjects(ctx, principal,
+		&models.BatchDeleteMatch{Class: req.ClassName, Where: where},
+		&req.DryRun, &output,
+		replicationPropertiesFromProto(req.ConsistencyLevel), req.Tenant)
+	if err != nil {
+		return nil, errorToStatus(err)
+	}
+
+	reply := batchDeleteResultToProto(res.Result, req.Verbose)
+	reply.Took = float32(time.Since(before).Seconds())
+	return reply, nil
+}
+
+func batchDeleteResultToProto(res objects.BatchDeleteResult, verbose bool) *pb.BatchDeleteReply {
+	reply := &pb.BatchDeleteReply{
+		Matches: res.Matches,
+		Limit:   res.Limit,
+	}
+
+	for _, obj := range res.Objects {
+		successful := obj.Err == nil
+		if successful {
+			reply.Successful++
+		} else {
+			reply.Failed++
+		}
+
+		if successful && !verbose {
+			continue
+		}
+
+		out := &pb.BatchDeleteObject{
+			Uuid:       obj.UUID.String(),
+			Successful: successful,
+		}
+		if obj.Err != nil {
+			out.Error = obj.Err.Error()
+		}
+		reply.Objects = append(reply.Objects, out)
+	}
+
+	return reply
+}
+
+func batchReferenceFromProto(in *pb.BatchReference) *models.BatchReference {
+	from := crossref.NewSource(schema.ClassName(in.FromClassName),
+		schema.PropertyName(in.FromProperty), strfmt.UUID(in.FromUuid))
+	to := crossref.NewLocalhost(in.ToClassName, strfmt.UUID(in.ToUuid))
+
+	return &models.BatchReference{
+		From:   strfmt.URI(from.String()),
+		To:     strfmt.URI(to.String()),
+		Tenant: in.Tenant,
+	}
+}
+
+// sortBatchErrors orders the errors by their index in the request, conversion
+// errors are collected before the errors of the batch manager
+func sortBatchErrors(errs []*pb.BatchError) []*pb.BatchError {
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+	return errs
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestBatchReferenceFromProto(t *testing.T) {
+	ref := batchReferenceFromProto(&pb.BatchReference{
+		FromClassName: "Article",
+		FromUuid:      "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+		FromProperty:  "author",
+		ToClassName:   "Author",
+		ToUuid:        "0b1e5a8c-9a1d-4c1e-a0ad-6e1a1b3a9c2d",
+		Tenant:        "tenant1",
+	})
+
+	assert.Equal(t, &models.BatchReference{
+		From:   "weaviate://localhost/Article/73f2eb5f-5abf-447a-81ca-74b1dd168247/author",
+		To:     "weaviate://localhost/Author/0b1e5a8c-9a1d-4c1e-a0ad-6e1a1b3a9c2d",
+		Tenant: "tenant1",
+	}, ref)
+}
+
+func TestBatchDeleteResultToProto(t *testing.T) {
+	res := objects.BatchDeleteResult{
+		Matches: 3,
+		Limit:   10000,
+		Objects: objects.BatchSimpleObjects{
+			{UUID: strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247")},
+			{UUID: strfmt.UUID("0b1e5a8c-9a1d-4c1e-a0ad-6e1a1b3a9c2d"), Err: errors.New("failed")},
+			{UUID: strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")},
+		},
+	}
+
+	t.Run("minimal", func(t *testing.T) {
+		reply := batchDeleteResultToProto(res, false)
+		assert.Equal(t, int64(3), reply.Matches)
+		assert.Equal(t, int64(10000), reply.Limit)
+		assert.Equal(t, int64(2), reply.Successful)
+		assert.Equal(t, int64(1), reply.Failed)
+		assert.Equal(t, []*pb.BatchDeleteObject{
+			{Uuid: "0b1e5a8c-9a1d-4c1e-a0ad-6e1a1b3a9c2d", Error: "failed"},
+		}, reply.Objects)
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		reply := batchDeleteResultToProto(res, true)
+		assert.Len(t, reply.Objects, 3)
+		assert.True(t, reply.Objects[0].Successful)
+		assert.False(t, reply.Objects[1].Successful)
+	})
+}
+
+func TestSortBatchErrors(t *testing.T) {
+	errs := sortBatchErrors([]*pb.BatchError{
+		{Index: 3, Error: "c"},
+		{Index: 0, Error: "a"},
+		{Index: 1, Error: "b"},
+	})
+	assert.Equal(t, []int32{0, 1, 3}, []int32{errs[0].Index, errs[1].Index, errs[2].Index})
+}
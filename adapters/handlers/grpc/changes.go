@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/cdc"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+var changeEventTypes = map[cdc.EventType]pb.ChangeEvent_Type{
+	cdc.EventTypeCreate:          pb.ChangeEvent_TYPE_CREATE,
+	cdc.EventTypeUpdate:          pb.ChangeEvent_TYPE_UPDATE,
+	cdc.EventTypeDelete:          pb.ChangeEvent_TYPE_DELETE,
+	cdc.EventTypeReferenceChange: pb.ChangeEvent_TYPE_REFERENCE_CHANGE,
+}
+
+// StreamChanges follows the mutations of a class, or of a single tenant of it.
+// The stream never ends on its own, consumers which reconnect pass the
+// sequence number of the last received event to continue where they left off.
+func (s *Server) StreamChanges(req *pb.StreamChangesRequest, stream pb.Weaviate_StreamChangesServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	send := func(events []cdc.Event) error {
+		reply, err := changeEventsToProto(events)
+		if err != nil {
+			return err
+		}
+		return stream.Send(reply)
+	}
+
+	err = s.objectsManager.ChangeStream(ctx, principal, req.ClassName, req.Tenant,
+		req.AfterSequence, send)
+	if err != nil && ctx.Err() == nil {
+		return errorToStatus(err)
+	}
+	return ctx.Err()
+}
+
+func changeEventsToProto(events []cdc.Event) (*pb.StreamChangesReply, error) {
+	out := &pb.StreamChangesReply{Events: make([]*pb.ChangeEvent, len(events))}
+	for i, event := range events {
+		pbEvent := &pb.ChangeEvent{
+			Sequence:  event.Sequence,
+			Type:      changeEventTypes[event.Type],
+			ClassName: event.Class,
+			Tenant:    event.Tenant,
+			Uuid:      event.ID.String(),
+			TimeUnix:  event.Time,
+		}
+
+		if event.Object != nil {
+			obj, err := objectToProto(event.Object)
+			if err != nil {
+				return nil, fmt.Errorf("change event %d: %w", event.Sequence, err)
+			}
+			pbEvent.Object = obj
+		}
+
+		out.Events[i] = pbEvent
+	}
+	return out, nil
+}
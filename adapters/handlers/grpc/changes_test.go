@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cdc"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestChangeEventsToProto(t *testing.T) {
+	id := strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	out, err := changeEventsToProto([]cdc.Event{
+		{
+			Sequence: 1,
+			Type:     cdc.EventTypeCreate,
+			Class:    "Article",
+			Tenant:   "tenant1",
+			ID:       id,
+			Time:     1000,
+			Object: &models.Object{
+				ID:         id,
+				Class:      "Article",
+				Tenant:     "tenant1",
+				Properties: map[string]interface{}{"name": "foo"},
+			},
+		},
+		{
+			Sequence: 2,
+			Type:     cdc.EventTypeDelete,
+			Class:    "Article",
+			Tenant:   "tenant1",
+			ID:       id,
+			Time:     2000,
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, out.Events, 2)
+
+	created := out.Events[0]
+	assert.Equal(t, uint64(1), created.Sequence)
+	assert.Equal(t, pb.ChangeEvent_TYPE_CREATE, created.Type)
+	assert.Equal(t, "Article", created.ClassName)
+	assert.Equal(t, "tenant1", created.Tenant)
+	assert.Equal(t, id.String(), created.Uuid)
+	assert.Equal(t, int64(1000), created.TimeUnix)
+	require.NotNil(t, created.Object)
+	assert.Equal(t, map[string]interface{}{"name": "foo"}, created.Object.Properties.AsMap())
+
+	deleted := out.Events[1]
+	assert.Equal(t, uint64(2), deleted.Sequence)
+	assert.Equal(t, pb.ChangeEvent_TYPE_DELETE, deleted.Type)
+	assert.Equal(t, int64(2000), deleted.TimeUnix)
+	assert.Nil(t, deleted.Object)
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+
+	"github.com/weaviate/weaviate/entities/cdc"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorToStatus maps the errors of the objects usecase to gRPC status codes,
+// the same way the REST handlers map them to HTTP status codes
+func errorToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var objErr *objects.Error
+	if errors.As(err, &objErr) {
+		switch {
+		case objErr.NotFound():
+			return status.Error(codes.NotFound, err.Error())
+		case objErr.Forbidden():
+			return status.Error(codes.PermissionDenied, err.Error())
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			return status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	switch {
+	case errors.Is(err, cdc.ErrSequenceTrimmed):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, cdc.ErrDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.As(err, &autherrs.Forbidden{}):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &objects.ErrNotFound{}):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &objects.ErrInvalidUserInput{}),
+		errors.As(err, &objects.ErrMultiTenancy{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const defaultExportBatchSize = 1000
+
+// ExportObjects streams all objects of a class including their vectors. It
+// pages through the class with the cursor API, so the objects are returned in
+// the order of their uuids and an interrupted export can be resumed by
+// passing the last received uuid as after.
+func (s *Server) ExportObjects(req *pb.ExportObjectsRequest, stream pb.Weaviate_ExportObjectsServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	query := func(after string, limit int64) ([]*models.Object, error) {
+		res, err := s.objectsManager.Query(ctx, principal, &objects.QueryParams{
+			Class:      req.ClassName,
+			After:      &after,
+			Limit:      &limit,
+			Tenant:     &req.Tenant,
+			Additional: additional.Properties{Vector: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	send := func(objs []*models.Object) error {
+		reply := &pb.ExportObjectsReply{Objects: make([]*pb.Object, len(objs))}
+		for i, obj := range objs {
+			out, err := objectToProto(obj)
+			if err != nil {
+				return err
+			}
+			reply.Objects[i] = out
+		}
+		return stream.Send(reply)
+	}
+
+	return exportPages(ctx, req.After, req.BatchSize, query, send)
+}
+
+// exportPages requests pages of objects after the last object of the previous
+// page until a page is not full anymore
+func exportPages(ctx context.Context, after string, batchSize uint32,
+	query func(after string, limit int64) ([]*models.Object, error),
+	send func([]*models.Object) error,
+) error {
+	limit := int64(defaultExportBatchSize)
+	if batchSize > 0 {
+		limit = int64(batchSize)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		objs, err := query(after, limit)
+		if err != nil {
+			return errorToStatus(err)
+		}
+
+		if len(objs) > 0 {
+			if err := send(objs); err != nil {
+				return err
+			}
+			after = objs[len(objs)-1].ID.String()
+		}
+
+		if int64(len(objs)) < limit {
+			return nil
+		}
+	}
+}
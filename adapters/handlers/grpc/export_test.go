@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportPages(t *testing.T) {
+	var all []*models.Object
+	for i := 0; i < 7; i++ {
+		all = append(all, &models.Object{
+			ID: strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i)),
+		})
+	}
+
+	// fakeQuery behaves like the cursor API, it returns the objects following
+	// the one with the after uuid
+	fakeQuery := func(after string, limit int64) ([]*models.Object, error) {
+		start := 0
+		if after != "" {
+			for i, obj := range all {
+				if obj.ID.String() == after {
+					start = i + 1
+				}
+			}
+		}
+		end := start + int(limit)
+		if end > len(all) {
+			end = len(all)
+		}
+		return all[start:end], nil
+	}
+
+	collect := func(t *testing.T, after string, batchSize uint32) ([]strfmt.UUID, []int) {
+		var ids []strfmt.UUID
+		var pageSizes []int
+		err := exportPages(context.Background(), after, batchSize, fakeQuery,
+			func(objs []*models.Object) error {
+				pageSizes = append(pageSizes, len(objs))
+				for _, obj := range objs {
+					ids = append(ids, obj.ID)
+				}
+				return nil
+			})
+		require.Nil(t, err)
+		return ids, pageSizes
+	}
+
+	t.Run("whole class", func(t *testing.T) {
+		ids, pageSizes := collect(t, "", 3)
+		require.Len(t, ids, 7)
+		for i, obj := range all {
+			assert.Equal(t, obj.ID, ids[i])
+		}
+		assert.Equal(t, []int{3, 3, 1}, pageSizes)
+	})
+
+	t.Run("batch size dividing the number of objects", func(t *testing.T) {
+		ids, pageSizes := collect(t, all[0].ID.String(), 2)
+		assert.Len(t, ids, 6)
+		assert.Equal(t, []int{2, 2, 2}, pageSizes)
+	})
+
+	t.Run("resuming after an object", func(t *testing.T) {
+		ids, _ := collect(t, all[4].ID.String(), 0)
+		assert.Equal(t, []strfmt.UUID{all[5].ID, all[6].ID}, ids)
+	})
+
+	t.Run("resuming after the last object", func(t *testing.T) {
+		ids, pageSizes := collect(t, all[6].ID.String(), 0)
+		assert.Len(t, ids, 0)
+		assert.Len(t, pageSizes, 0)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		err := exportPages(context.Background(), "", 0,
+			func(after string, limit int64) ([]*models.Object, error) {
+				return nil, &objects.Error{Code: objects.StatusBadRequest, Err: errors.New("invalid")}
+			},
+			func(objs []*models.Object) error { return nil })
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("send error", func(t *testing.T) {
+		err := exportPages(context.Background(), "", 2, fakeQuery,
+			func(objs []*models.Object) error { return errors.New("stream closed") })
+		assert.EqualError(t, err, "stream closed")
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := exportPages(ctx, "", 2, fakeQuery,
+			func(objs []*models.Object) error { return nil })
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+var filterOperators = map[pb.Filters_Operator]string{
+	pb.Filters_OPERATOR_EQUAL:              models.WhereFilterOperatorEqual,
+	pb.Filters_OPERATOR_NOT_EQUAL:          models.WhereFilterOperatorNotEqual,
+	pb.Filters_OPERATOR_GREATER_THAN:       models.WhereFilterOperatorGreaterThan,
+	pb.Filters_OPERATOR_GREATER_THAN_EQUAL: models.WhereFilterOperatorGreaterThanEqual,
+	pb.Filters_OPERATOR_LESS_THAN:          models.WhereFilterOperatorLessThan,
+	pb.Filters_OPERATOR_LESS_THAN_EQUAL:    models.WhereFilterOperatorLessThanEqual,
+	pb.Filters_OPERATOR_AND:                models.WhereFilterOperatorAnd,
+	pb.Filters_OPERATOR_OR:                 models.WhereFilterOperatorOr,
+	pb.Filters_OPERATOR_NOT:                models.WhereFilterOperatorNot,
+	pb.Filters_OPERATOR_LIKE:               models.WhereFilterOperatorLike,
+	pb.Filters_OPERATOR_IS_NULL:            models.WhereFilterOperatorIsNull,
+	pb.Filters_OPERATOR_WITHIN_GEO_RANGE:   models.WhereFilterOperatorWithinGeoRange,
+	pb.Filters_OPERATOR_CONTAINS_PHRASE:    models.WhereFilterOperatorContainsPhrase,
+	pb.Filters_OPERATOR_WITHIN_WORDS:       models.WhereFilterOperatorWithinWords,
+	pb.Filters_OPERATOR_FUZZY:              models.WhereFilterOperatorFuzzy,
+}
+
+// filtersFromProto converts the protobuf filters of a search request. It goes
+// through the REST representation, so that paths (including reference paths)
+// and values are parsed exactly like they are for the REST and GraphQL APIs.
+func filtersFromProto(in *pb.Filters, className string) (*filters.LocalFilter, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	where, err := whereFilterFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterext.Parse(where, className)
+}
+
+// whereFilterFromProto converts the protobuf filters to the REST
+// representation, so they can be passed to the usecases which already parse
+// and validate those
+func whereFilterFromProto(in *pb.Filters) (*models.WhereFilter, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	operator, ok := filterOperators[in.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported filter operator %v", in.Operator)
+	}
+
+	out := &models.WhereFilter{
+		Operator: operator,
+		Path:     in.Path,
+	}
+
+	switch v := in.TestValue.(type) {
+	case *pb.Filters_ValueText:
+		out.ValueText = &v.ValueText
+	case *pb.Filters_ValueInt:
+		out.ValueInt = &v.ValueInt
+	case *pb.Filters_ValueBoolean:
+		out.ValueBoolean = &v.ValueBoolean
+	case *pb.Filters_ValueNumber:
+		out.ValueNumber = &v.ValueNumber
+	case *pb.Filters_ValueDate:
+		out.ValueDate = &v.ValueDate
+	case *pb.Filters_ValueGeo:
+		if v.ValueGeo == nil {
+			return nil, fmt.Errorf("geo range value is empty")
+		}
+		out.ValueGeoRange = &models.WhereFilterGeoRange{
+			GeoCoordinates: &models.GeoCoordinates{
+				Latitude:  &v.ValueGeo.Latitude,
+				Longitude: &v.ValueGeo.Longitude,
+			},
+			Distance: &models.WhereFilterGeoRangeDistance{
+				Max: float64(v.ValueGeo.Distance),
+			},
+		}
+	case *pb.Filters_ValueTextProximity:
+		if v.ValueTextProximity == nil {
+			return nil, fmt.Errorf("text proximity value is empty")
+		}
+		out.ValueTextProximity = &models.WhereFilterTextProximity{
+			Text:     v.ValueTextProximity.Text,
+			Distance: v.ValueTextProximity.Distance,
+		}
+	}
+
+	for i, operand := range in.Operands {
+		converted, err := whereFilterFromProto(operand)
+		if err != nil {
+			return nil, fmt.Errorf("operand %d: %w", i, err)
+		}
+		out.Operands = append(out.Operands, converted)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestWhereFilterFromProto(t *testing.T) {
+	text := "foo"
+	number := 1.5
+	truth := true
+
+	tests := []struct {
+		name        string
+		in          *pb.Filters
+		expected    *models.WhereFilter
+		expectedErr bool
+	}{
+		{
+			name:     "no filter",
+			in:       nil,
+			expected: nil,
+		},
+		{
+			name: "single text filter",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_LIKE,
+				Path:      []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: text},
+			},
+			expected: &models.WhereFilter{
+				Operator:  models.WhereFilterOperatorLike,
+				Path:      []string{"name"},
+				ValueText: &text,
+			},
+		},
+		{
+			name: "nested filters",
+			in: &pb.Filters{
+				Operator: pb.Filters_OPERATOR_AND,
+				Operands: []*pb.Filters{
+					{
+						Operator:  pb.Filters_OPERATOR_GREATER_THAN,
+						Path:      []string{"price"},
+						TestValue: &pb.Filters_ValueNumber{ValueNumber: number},
+					},
+					{
+						Operator:  pb.Filters_OPERATOR_IS_NULL,
+						Path:      []string{"description"},
+						TestValue: &pb.Filters_ValueBoolean{ValueBoolean: truth},
+					},
+				},
+			},
+			expected: &models.WhereFilter{
+				Operator: models.WhereFilterOperatorAnd,
+				Operands: []*models.WhereFilter{
+					{
+						Operator:    models.WhereFilterOperatorGreaterThan,
+						Path:        []string{"price"},
+						ValueNumber: &number,
+					},
+					{
+						Operator:     models.WhereFilterOperatorIsNull,
+						Path:         []string{"description"},
+						ValueBoolean: &truth,
+					},
+				},
+			},
+		},
+		{
+			name: "unspecified operator",
+			in: &pb.Filters{
+				Path:      []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: text},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unspecified operator in operand",
+			in: &pb.Filters{
+				Operator: pb.Filters_OPERATOR_OR,
+				Operands: []*pb.Filters{{Path: []string{"name"}}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := whereFilterFromProto(test.in)
+			if test.expectedErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
+
+func TestFiltersFromProto(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+					{Name: "published", DataType: schema.DataTypeDate.PropString()},
+					{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+					{Name: "hasAuthor", DataType: []string{"Author"}},
+				},
+			},
+			{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+				},
+			},
+		},
+	}}
+
+	t.Run("no filters", func(t *testing.T) {
+		out, err := filtersFromProto(nil, "Article")
+		require.Nil(t, err)
+		assert.Nil(t, out)
+	})
+
+	t.Run("not with nested operands", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator: pb.Filters_OPERATOR_NOT,
+			Operands: []*pb.Filters{{
+				Operator: pb.Filters_OPERATOR_OR,
+				Operands: []*pb.Filters{
+					{
+						Operator:  pb.Filters_OPERATOR_LIKE,
+						Path:      []string{"title"},
+						TestValue: &pb.Filters_ValueText{ValueText: "foo*"},
+					},
+					{
+						Operator:  pb.Filters_OPERATOR_GREATER_THAN_EQUAL,
+						Path:      []string{"published"},
+						TestValue: &pb.Filters_ValueDate{ValueDate: "2023-01-01T00:00:00Z"},
+					},
+				},
+			}},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+
+		assert.Equal(t, filters.OperatorNot, out.Root.Operator)
+		require.Len(t, out.Root.Operands, 1)
+		or := out.Root.Operands[0]
+		assert.Equal(t, filters.OperatorOr, or.Operator)
+		require.Len(t, or.Operands, 2)
+		assert.Equal(t, filters.OperatorLike, or.Operands[0].Operator)
+		assert.Equal(t, schema.DataTypeText, or.Operands[0].Value.Type)
+		assert.Equal(t, schema.DataTypeDate, or.Operands[1].Value.Type)
+	})
+
+	t.Run("within geo range", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator: pb.Filters_OPERATOR_WITHIN_GEO_RANGE,
+			Path:     []string{"location"},
+			TestValue: &pb.Filters_ValueGeo{ValueGeo: &pb.GeoCoordinatesFilter{
+				Latitude:  52.36,
+				Longitude: 4.9,
+				Distance:  2000,
+			}},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+
+		geoRange, ok := out.Root.Value.Value.(filters.GeoRange)
+		require.True(t, ok)
+		assert.Equal(t, float32(2000), geoRange.Distance)
+		assert.Equal(t, float32(52.36), *geoRange.Latitude)
+		assert.Equal(t, float32(4.9), *geoRange.Longitude)
+	})
+
+	t.Run("contains phrase", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator:  pb.Filters_OPERATOR_CONTAINS_PHRASE,
+			Path:      []string{"title"},
+			TestValue: &pb.Filters_ValueText{ValueText: "machine learning"},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+
+		assert.Equal(t, filters.OperatorContainsPhrase, out.Root.Operator)
+		assert.Equal(t, "machine learning", out.Root.Value.Value)
+	})
+
+	t.Run("within words", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator: pb.Filters_OPERATOR_WITHIN_WORDS,
+			Path:     []string{"title"},
+			TestValue: &pb.Filters_ValueTextProximity{ValueTextProximity: &pb.TextProximityFilter{
+				Text:     "machine learning",
+				Distance: 3,
+			}},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+
+		assert.Equal(t, filters.OperatorWithinWords, out.Root.Operator)
+		assert.Equal(t, filters.TextProximity{Text: "machine learning", Distance: 3}, out.Root.Value.Value)
+	})
+
+	t.Run("fuzzy", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator: pb.Filters_OPERATOR_FUZZY,
+			Path:     []string{"title"},
+			TestValue: &pb.Filters_ValueTextProximity{ValueTextProximity: &pb.TextProximityFilter{
+				Text:     "iphnoe",
+				Distance: 2,
+			}},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+
+		assert.Equal(t, filters.OperatorFuzzy, out.Root.Operator)
+		assert.Equal(t, filters.TextProximity{Text: "iphnoe", Distance: 2}, out.Root.Value.Value)
+	})
+
+	t.Run("is null", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator:  pb.Filters_OPERATOR_IS_NULL,
+			Path:      []string{"title"},
+			TestValue: &pb.Filters_ValueBoolean{ValueBoolean: true},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+	})
+
+	t.Run("reference path", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator:  pb.Filters_OPERATOR_EQUAL,
+			Path:      []string{"hasAuthor", "Author", "name"},
+			TestValue: &pb.Filters_ValueText{ValueText: "Jane"},
+		}, "Article")
+		require.Nil(t, err)
+		require.Nil(t, filters.ValidateFilters(sch, out))
+
+		assert.Equal(t, schema.ClassName("Article"), out.Root.On.Class)
+		assert.Equal(t, schema.PropertyName("hasAuthor"), out.Root.On.Property)
+		require.NotNil(t, out.Root.On.Child)
+		assert.Equal(t, schema.ClassName("Author"), out.Root.On.Child.Class)
+		assert.Equal(t, schema.PropertyName("name"), out.Root.On.Child.Property)
+	})
+
+	t.Run("value type not matching the property", func(t *testing.T) {
+		out, err := filtersFromProto(&pb.Filters{
+			Operator:  pb.Filters_OPERATOR_EQUAL,
+			Path:      []string{"title"},
+			TestValue: &pb.Filters_ValueInt{ValueInt: 7},
+		}, "Article")
+		require.Nil(t, err)
+		assert.NotNil(t, filters.ValidateFilters(sch, out))
+	})
+
+	t.Run("nested operator with path", func(t *testing.T) {
+		_, err := filtersFromProto(&pb.Filters{
+			Operator: pb.Filters_OPERATOR_AND,
+			Path:     []string{"title"},
+			Operands: []*pb.Filters{{
+				Operator:  pb.Filters_OPERATOR_EQUAL,
+				Path:      []string{"title"},
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			}},
+		}, "Article")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("empty geo range value", func(t *testing.T) {
+		_, err := filtersFromProto(&pb.Filters{
+			Operator:  pb.Filters_OPERATOR_WITHIN_GEO_RANGE,
+			Path:      []string{"location"},
+			TestValue: &pb.Filters_ValueGeo{},
+		}, "Article")
+		assert.NotNil(t, err)
+	})
+}
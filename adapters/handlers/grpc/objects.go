@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (s *Server) GetObject(ctx context.Context, req *pb.GetObjectRequest) (*pb.GetObjectReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	obj, err := s.objectsManager.GetObject(ctx, principal, req.ClassName,
+		strfmt.UUID(req.Uuid), additional.Properties{Vector: req.Vector},
+		replicationPropertiesFromProto(req.ConsistencyLevel), req.Tenant)
+	if err != nil {
+		return nil, errorToStatus(err)
+	}
+
+	out, err := objectToProto(obj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "convert object: %v", err)
+	}
+
+	return &pb.GetObjectReply{Object: out}, nil
+}
+
+// PutObject creates the object if it does not exist yet and replaces it
+// otherwise
+func (s *Server) PutObject(ctx context.Context, req *pb.PutObjectRequest) (*pb.PutObjectReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	obj, err := objectFromProto(req.Object)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "extract object: %v", err)
+	}
+
+	repl := replicationPropertiesFromProto(req.ConsistencyLevel)
+
+	exists := false
+	if obj.ID != "" {
+		var headErr error
+		exists, headErr = s.headObject(ctx, principal, obj, repl)
+		if headErr != nil {
+			return nil, errorToStatus(headErr)
+		}
+	}
+
+	var res *models.Object
+	if exists {
+		res, err = s.objectsManager.UpdateObject(ctx, principal, obj.Class,
+			obj.ID, obj, repl)
+	} else {
+		res, err = s.objectsManager.AddObject(ctx, principal, obj, repl)
+	}
+	if err != nil {
+		return nil, errorToStatus(err)
+	}
+
+	out, err := objectToProto(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "convert object: %v", err)
+	}
+
+	return &pb.PutObjectReply{Object: out}, nil
+}
+
+func (s *Server) headObject(ctx context.Context, principal *models.Principal,
+	obj *models.Object, repl *additional.ReplicationProperties,
+) (bool, error) {
+	exists, objErr := s.objectsManager.HeadObject(ctx, principal, obj.Class,
+		obj.ID, repl, obj.Tenant)
+	if objErr != nil {
+		return false, objErr
+	}
+	return exists, nil
+}
+
+func (s *Server) DeleteObject(ctx context.Context, req *pb.DeleteObjectRequest) (*pb.DeleteObjectReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	err = s.objectsManager.DeleteObject(ctx, principal, req.ClassName,
+		strfmt.UUID(req.Uuid), replicationPropertiesFromProto(req.ConsistencyLevel),
+		req.Tenant)
+	if err != nil {
+		return nil, errorToStatus(err)
+	}
+
+	return &pb.DeleteObjectReply{}, nil
+}
+
+func replicationPropertiesFromProto(level pb.ConsistencyLevel) *additional.ReplicationProperties {
+	switch level {
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_ONE:
+		return &additional.ReplicationProperties{ConsistencyLevel: string(replica.One)}
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM:
+		return &additional.ReplicationProperties{ConsistencyLevel: string(replica.Quorum)}
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_ALL:
+		return &additional.ReplicationProperties{ConsistencyLevel: string(replica.All)}
+	default:
+		return nil
+	}
+}
+
+func objectFromProto(in *pb.Object) (*models.Object, error) {
+	if in == nil {
+		return nil, fmt.Errorf("object is required")
+	}
+
+	obj := &models.Object{
+		ID:     strfmt.UUID(in.Uuid),
+		Class:  in.ClassName,
+		Tenant: in.Tenant,
+	}
+
+	if in.Properties != nil {
+		obj.Properties = in.Properties.AsMap()
+	}
+
+	if len(in.Vector) > 0 {
+		obj.Vector = in.Vector
+	}
+
+	if len(in.Vectors) > 0 {
+		obj.Vectors = make(models.Vectors, len(in.Vectors))
+		for name, vec := range in.Vectors {
+			if vec == nil {
+				return nil, fmt.Errorf("named vector %q is empty", name)
+			}
+			obj.Vectors[name] = vec.Values
+		}
+	}
+
+	return obj, nil
+}
+
+func objectToProto(in *models.Object) (*pb.Object, error) {
+	out := &pb.Object{
+		Uuid:               in.ID.String(),
+		ClassName:          in.Class,
+		Tenant:             in.Tenant,
+		Vector:             in.Vector,
+		CreationTimeUnix:   in.CreationTimeUnix,
+		LastUpdateTimeUnix: in.LastUpdateTimeUnix,
+	}
+
+	if len(in.Vectors) > 0 {
+		out.Vectors = make(map[string]*pb.Vector, len(in.Vectors))
+		for name, vec := range in.Vectors {
+			out.Vectors[name] = &pb.Vector{Values: vec}
+		}
+	}
+
+	if in.Properties != nil {
+		props, err := propertiesToStruct(in.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("object %s: %w", in.ID, err)
+		}
+		out.Properties = props
+	}
+
+	return out, nil
+}
+
+// propertiesToStruct uses the same encoding as the REST API, properties can
+// contain types such as geo coordinates or references which cannot be
+// converted to a struct directly
+func propertiesToStruct(props interface{}) (*structpb.Struct, error) {
+	raw, err := json.Marshal(props)
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties: %w", err)
+	}
+
+	out := &structpb.Struct{}
+	if err := out.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("unmarshal properties: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cdc"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestObjectFromProto(t *testing.T) {
+	props, err := structpb.NewStruct(map[string]interface{}{
+		"name":  "foo",
+		"count": 3,
+	})
+	require.Nil(t, err)
+
+	t.Run("with class-level vector", func(t *testing.T) {
+		obj, err := objectFromProto(&pb.Object{
+			Uuid:       "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+			ClassName:  "Article",
+			Tenant:     "tenant1",
+			Properties: props,
+			Vector:     []float32{1, 2, 3},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &models.Object{
+			ID:     "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+			Class:  "Article",
+			Tenant: "tenant1",
+			Properties: map[string]interface{}{
+				"name":  "foo",
+				"count": float64(3),
+			},
+			Vector: []float32{1, 2, 3},
+		}, obj)
+	})
+
+	t.Run("with named vectors", func(t *testing.T) {
+		obj, err := objectFromProto(&pb.Object{
+			ClassName: "Article",
+			Vectors: map[string]*pb.Vector{
+				"title": {Values: []float32{1, 2}},
+			},
+		})
+		require.Nil(t, err)
+		assert.Nil(t, obj.Vector)
+		assert.Equal(t, models.Vectors{"title": {1, 2}}, obj.Vectors)
+	})
+
+	t.Run("with empty named vector", func(t *testing.T) {
+		_, err := objectFromProto(&pb.Object{
+			ClassName: "Article",
+			Vectors:   map[string]*pb.Vector{"title": nil},
+		})
+		assert.EqualError(t, err, "named vector \"title\" is empty")
+	})
+
+	t.Run("without object", func(t *testing.T) {
+		_, err := objectFromProto(nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestObjectToProto(t *testing.T) {
+	id := strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	out, err := objectToProto(&models.Object{
+		ID:    id,
+		Class: "Article",
+		Properties: map[string]interface{}{
+			"name": "foo",
+			"location": &models.GeoCoordinates{
+				Latitude:  ptFloat32(1.5),
+				Longitude: ptFloat32(2.5),
+			},
+			"author": models.MultipleRef{
+				crossref.NewLocalhost("Author", id).SingleRef(),
+			},
+		},
+		Vectors:            models.Vectors{"title": {1, 2}},
+		CreationTimeUnix:   1000,
+		LastUpdateTimeUnix: 2000,
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, id.String(), out.Uuid)
+	assert.Equal(t, "Article", out.ClassName)
+	assert.Equal(t, int64(1000), out.CreationTimeUnix)
+	assert.Equal(t, int64(2000), out.LastUpdateTimeUnix)
+	assert.Equal(t, []float32{1, 2}, out.Vectors["title"].Values)
+	assert.Equal(t, map[string]interface{}{
+		"name": "foo",
+		"location": map[string]interface{}{
+			"latitude":  1.5,
+			"longitude": 2.5,
+		},
+		"author": []interface{}{
+			map[string]interface{}{
+				"beacon": "weaviate://localhost/Author/" + id.String(),
+			},
+		},
+	}, out.Properties.AsMap())
+}
+
+func TestErrorToStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: &objects.Error{Code: objects.StatusNotFound}, code: codes.NotFound},
+		{err: &objects.Error{Code: objects.StatusForbidden}, code: codes.PermissionDenied},
+		{err: &objects.Error{Code: objects.StatusBadRequest}, code: codes.InvalidArgument},
+		{err: objects.NewErrNotFound("not found"), code: codes.NotFound},
+		{err: objects.NewErrInvalidUserInput("invalid"), code: codes.InvalidArgument},
+		{err: objects.NewErrMultiTenancy(errors.New("no tenant")), code: codes.InvalidArgument},
+		{err: fmt.Errorf("read: %w", cdc.ErrSequenceTrimmed), code: codes.OutOfRange},
+		{err: cdc.ErrDisabled, code: codes.FailedPrecondition},
+		{err: errors.New("something else"), code: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			assert.Equal(t, test.code, status.Code(errorToStatus(test.err)))
+		})
+	}
+}
+
+func ptFloat32(in float32) *float32 {
+	return &in
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/traverser"
 	"google.golang.org/grpc"
 )
@@ -47,6 +48,8 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 			state.APIKey, state.OIDC),
 		allowAnonymousAccess: state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		schemaManager:        state.SchemaManager,
+		objectsManager:       state.ObjectsManager,
+		batchManager:         state.BatchManager,
 	})
 
 	return &GRPCServer{s}
@@ -77,6 +80,8 @@ type Server struct {
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
+	objectsManager       *objects.Manager
+	batchManager         *objects.BatchManager
 }
 
 func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
@@ -367,16 +372,17 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 	}
 
 	if hs := req.HybridSearch; hs != nil {
-		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha)}
+		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha), TargetVector: hs.TargetVector}
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: bm25.Properties, Type: "bm25", AdditionalExplanations: explainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: bm25.Properties, Type: "bm25", AdditionalExplanations: explainScore, Fuzziness: int(bm25.Fuzziness)}
 	}
 
 	if nv := req.NearVector; nv != nil {
 		out.NearVector = &searchparams.NearVector{
-			Vector: nv.Vector,
+			Vector:       nv.Vector,
+			TargetVector: nv.TargetVector,
 		}
 
 		// The following business logic should not sit in the API. However, it is
@@ -398,7 +404,8 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 
 	if no := req.NearObject; no != nil {
 		out.NearObject = &searchparams.NearObject{
-			ID: req.NearObject.Id,
+			ID:           req.NearObject.Id,
+			TargetVector: req.NearObject.TargetVector,
 		}
 
 		// The following business logic should not sit in the API. However, it is
@@ -418,6 +425,14 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		}
 	}
 
+	if req.Filters != nil {
+		where, err := filtersFromProto(req.Filters, req.ClassName)
+		if err != nil {
+			return out, fmt.Errorf("filters: %w", err)
+		}
+		out.Filters = where
+	}
+
 	out.Pagination = &filters.Pagination{}
 	if req.Limit > 0 {
 		out.Pagination.Limit = int(req.Limit)
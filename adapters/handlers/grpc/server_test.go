@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestSearchParamsFromProtoBM25(t *testing.T) {
+	out, err := searchParamsFromProto(&pb.SearchRequest{
+		ClassName: "Article",
+		Bm25Search: &pb.BM25SearchParams{
+			Query:      "iphnoe",
+			Properties: []string{"title"},
+			Fuzziness:  2,
+		},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, &searchparams.KeywordRanking{
+		Type:       "bm25",
+		Query:      "iphnoe",
+		Properties: []string{"title"},
+		Fuzziness:  2,
+	}, out.KeywordRanking)
+}
@@ -28,12 +28,17 @@ type backupManager interface {
 	OnStatus(ctx context.Context, req *backup.StatusRequest) *backup.StatusResponse
 }
 
+type backupScheduler interface {
+	OnCancel(ctx context.Context, req *backup.AbortRequest) error
+}
+
 type backups struct {
-	manager backupManager
+	manager   backupManager
+	scheduler backupScheduler
 }
 
-func NewBackups(manager backupManager) *backups {
-	return &backups{manager: manager}
+func NewBackups(manager backupManager, scheduler backupScheduler) *backups {
+	return &backups{manager: manager, scheduler: scheduler}
 }
 
 func (b *backups) CanCommit() http.Handler {
@@ -120,6 +125,34 @@ func (b *backups) Abort() http.Handler {
 	})
 }
 
+// Cancel cancels a backup coordinated by this node
+func (b *backups) Cancel() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("read request body: %w", err).Error(), status)
+			return
+		}
+		defer r.Body.Close()
+
+		var req backup.AbortRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("unmarshal request: %w", err).Error(), status)
+			return
+		}
+
+		if err := b.scheduler.OnCancel(r.Context(), &req); err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("cancel: %w", err).Error(), status)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (b *backups) Status() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
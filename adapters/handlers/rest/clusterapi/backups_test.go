@@ -28,12 +28,14 @@ import (
 func TestInternalBackupsAPI(t *testing.T) {
 	nodes := []*backupNode{
 		{
-			name:          "node1",
-			backupManager: &fakeBackupManager{},
+			name:            "node1",
+			backupManager:   &fakeBackupManager{},
+			backupScheduler: &fakeBackupScheduler{},
 		},
 		{
-			name:          "node2",
-			backupManager: &fakeBackupManager{},
+			name:            "node2",
+			backupManager:   &fakeBackupManager{},
+			backupScheduler: &fakeBackupScheduler{},
 		},
 	}
 	hosts := setupClusterAPI(t, nodes)
@@ -56,19 +58,29 @@ func TestInternalBackupsAPI(t *testing.T) {
 		err := coord.Backup(context.Background(), &backup.Request{Method: backup.OpCreate}, true)
 		require.Nil(t, err)
 	})
+
+	t.Run("cancel on the coordinator", func(t *testing.T) {
+		req := &backup.AbortRequest{Method: backup.OpCreate, ID: "1234", Backend: "s3"}
+		nodes[1].backupScheduler.On("OnCancel", req).Return(nil)
+
+		err := coord.client.Cancel(context.Background(), hosts["node2"], req)
+		require.Nil(t, err)
+		nodes[1].backupScheduler.AssertCalled(t, "OnCancel", req)
+	})
 }
 
 func setupClusterAPI(t *testing.T, nodes []*backupNode) map[string]string {
 	hosts := make(map[string]string)
 
 	for _, node := range nodes {
-		backupsHandler := clusterapi.NewBackups(node.backupManager)
+		backupsHandler := clusterapi.NewBackups(node.backupManager, node.backupScheduler)
 
 		mux := http.NewServeMux()
 		mux.Handle("/backups/can-commit", backupsHandler.CanCommit())
 		mux.Handle("/backups/commit", backupsHandler.Commit())
 		mux.Handle("/backups/abort", backupsHandler.Abort())
 		mux.Handle("/backups/status", backupsHandler.Status())
+		mux.Handle("/backups/cancel", backupsHandler.Cancel())
 		server := httptest.NewServer(mux)
 
 		parsedURL, err := url.Parse(server.URL)
@@ -81,8 +93,9 @@ func setupClusterAPI(t *testing.T, nodes []*backupNode) map[string]string {
 }
 
 type backupNode struct {
-	name          string
-	backupManager *fakeBackupManager
+	name            string
+	backupManager   *fakeBackupManager
+	backupScheduler *fakeBackupScheduler
 }
 
 func newFakeNodeResolver(hosts map[string]string) *fakeNodeResolver {
@@ -178,3 +191,12 @@ func (m *fakeBackupManager) OnStatus(ctx context.Context, req *backup.StatusRequ
 	args := m.Called(req)
 	return args.Get(0).(*backup.StatusResponse)
 }
+
+type fakeBackupScheduler struct {
+	mock.Mock
+}
+
+func (s *fakeBackupScheduler) OnCancel(ctx context.Context, req *backup.AbortRequest) error {
+	args := s.Called(req)
+	return args.Error(0)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	schemaent "github.com/weaviate/weaviate/entities/schema"
 	ucs "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -56,6 +57,10 @@ func (f *fakeRepo) NewShards(ctx context.Context, class string, shards []ucs.Key
 	return nil
 }
 
+func (f *fakeRepo) UpdateShards(ctx context.Context, class string, shards []ucs.KeyValuePair) error {
+	return nil
+}
+
 func (f *fakeRepo) DeleteShards(ctx context.Context, class string, shards []string) error {
 	return nil
 }
@@ -72,7 +77,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schemaent.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return ""
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schemaent.VectorIndexConfig, error) {
 	return fakeVectorConfig(in.(map[string]interface{})), nil
 }
 
@@ -213,6 +222,14 @@ func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, pa
 	return nil, nil
 }
 
+func (n *NilMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	return nil
+}
+
+func (n *NilMigrator) DropShards(ctx context.Context, className string, shards []string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -233,6 +250,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schemaent.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -241,6 +262,10 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdatePropertyTokenization(ctx context.Context, updated *models.Class, propNames []string) (commit func(success bool), err error) {
+	return func(bool) {}, nil
+}
+
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -54,6 +55,10 @@ type replicator interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []replica.RepairResponse, err error)
+	HashTreeLevel(ctx context.Context, class, shardName string,
+		level int) ([]uint64, error)
+	DigestObjectsInRange(ctx context.Context, class, shardName string,
+		initialUUID, finalUUID strfmt.UUID, limit int) ([]replica.RepairResponse, error)
 }
 
 type localScaler interface {
@@ -73,6 +78,10 @@ var (
 		`\/shards\/(` + sh + `)\/objects/_overwrite`)
 	regxObjectsDigest = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/_digest`)
+	regxHashTreeLevel = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_hashtree\/([0-9]+)`)
+	regxObjectsRangeDigest = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_range_digest`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects`)
 	regxReferences = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
@@ -100,6 +109,22 @@ func (i *replicatedIndices) Indices() http.Handler {
 				return
 			}
 
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxHashTreeLevel.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getHashTreeLevel().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxObjectsRangeDigest.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getObjectsRangeDigest().ServeHTTP(w, r)
+				return
+			}
+
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 		case regxOverwriteObjects.MatchString(path):
@@ -373,6 +398,80 @@ func (i *replicatedIndices) getObjectsDigest() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getHashTreeLevel() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxHashTreeLevel.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		level, err := strconv.Atoi(args[3])
+		if err != nil {
+			http.Error(w, "invalid hash tree level: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		digests, err := i.shards.HashTreeLevel(r.Context(), index, shard, level)
+		if err != nil {
+			http.Error(w, "hash tree level: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(digests)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
+func (i *replicatedIndices) getObjectsRangeDigest() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxObjectsRangeDigest.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var params replica.DigestRangeRequest
+		if err := json.Unmarshal(reqPayload, &params); err != nil {
+			http.Error(w, "unmarshal digest objects in range params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.DigestObjectsInRange(r.Context(), index, shard,
+			params.InitialUUID, params.FinalUUID, params.Limit)
+		if err != nil {
+			http.Error(w, "digest objects in range: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) putOverwriteObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxOverwriteObjects.FindStringSubmatch(r.URL.Path)
@@ -31,7 +31,7 @@ func Serve(appState *state.State) {
 	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager())
 	nodes := NewNodes(appState.RemoteNodeIncoming)
-	backups := NewBackups(appState.BackupManager)
+	backups := NewBackups(appState.BackupManager, appState.BackupScheduler)
 
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/",
@@ -48,6 +48,7 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/commit", backups.Commit())
 	mux.Handle("/backups/abort", backups.Abort())
 	mux.Handle("/backups/status", backups.Status())
+	mux.Handle("/backups/cancel", backups.Cancel())
 
 	mux.Handle("/", index())
 	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
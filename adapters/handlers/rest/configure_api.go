@@ -39,7 +39,7 @@ import (
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	"github.com/weaviate/weaviate/entities/moduletools"
-	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
@@ -168,11 +168,15 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		MemtablesMaxSizeMB:        appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
 		MemtablesMinActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
 		MemtablesMaxActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
+		ObjectsCompression:        appState.ServerConfig.Config.Persistence.ObjectsCompression,
+		ChangeStream:              appState.ServerConfig.Config.ChangeStream,
+		AntiEntropy:               appState.ServerConfig.Config.AntiEntropy,
 		RootPath:                  appState.ServerConfig.Config.Persistence.DataPath,
 		QueryLimit:                appState.ServerConfig.Config.QueryDefaults.Limit,
 		QueryMaximumResults:       appState.ServerConfig.Config.QueryMaximumResults,
 		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
+		ReindexTokenization:       appState.ServerConfig.Config.ReindexTokenizationAtStartup,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics) // TODO client
 	if err != nil {
@@ -217,7 +221,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	schemaTxClient := clients.NewClusterSchema(clusterHttpClient)
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
-		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
+		vectorindex.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
 		appState.Modules, appState.Cluster, schemaTxClient, scaler,
 	)
 	if err != nil {
@@ -239,6 +243,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		repo, appState.Modules,
 		appState.Cluster,
 		appState.Logger)
+	appState.BackupScheduler = backupScheduler
 
 	backupManager := backup.NewManager(appState.Logger, appState.Authorizer,
 		schemaManager, repo, appState.Modules)
@@ -266,6 +271,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	batchObjectsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
+	appState.ObjectsManager = objectsManager
+	appState.BatchManager = batchObjectsManager
 
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
@@ -311,6 +318,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	if appState.ServerConfig.Config.IndexMissingTextFilterableAtStartup {
 		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskMissingTextFilterable")
 	}
+	if len(appState.ServerConfig.Config.ReindexTokenizationAtStartup) > 0 {
+		reindexTaskNames = append(reindexTaskNames, "ShardInvertedReindexTaskTokenization")
+	}
 	if len(reindexTaskNames) > 0 {
 		// start reindexing inverted indexes (if requested by user) in the background
 		// allowing db to complete api configuration and start handling requests
@@ -334,6 +344,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		if appState.PeriodicBackups != nil {
+			if err := appState.PeriodicBackups.Shutdown(ctx); err != nil {
+				appState.Logger.WithField("action", "shutdown").WithError(err).
+					Error("could not stop periodic backups")
+			}
+		}
+
 		if err := repo.Shutdown(ctx); err != nil {
 			panic(err)
 		}
@@ -355,6 +372,28 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			Fatal("modules didn't initialize")
 	}
 
+	if backend := appState.ServerConfig.Config.TenantOffload.Backend; backend != "" {
+		offloadBackend, err := appState.Modules.BackupBackend(backend)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("invalid tenant offload backend")
+		}
+		repo.SetTenantOffloadBackend(offloadBackend)
+	}
+
+	if cfg := appState.ServerConfig.Config.BackupSchedule; cfg.Enabled() {
+		periodicBackups, err := backup.NewPeriodicBackups(backupScheduler,
+			appState.Cluster, cfg, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("invalid backup schedule")
+		}
+		periodicBackups.Start()
+		appState.PeriodicBackups = periodicBackups
+	}
+
 	// manually update schema once
 	schema := schemaManager.GetSchemaSkipAuth()
 	updateSchemaCallback(schema)
@@ -148,6 +148,54 @@ func init() {
       }
     },
     "/backups/{backend}": {
+      "get": {
+        "description": "List all backups stored in a backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Existing backups successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
       "post": {
         "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
@@ -266,6 +314,124 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.backup"
         ]
+      },
+      "delete": {
+        "description": "Deletes a backup which is not in progress and is not the base of an incremental backup",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup deletion attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}/cancel": {
+      "post": {
+        "description": "Cancels a backup which is in progress and deletes the files it has already uploaded",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.cancel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup successfully cancelled."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup cancellation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
     "/backups/{backend}/{id}/restore": {
@@ -2777,6 +2943,64 @@ func init() {
           }
         }
       },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
       "post": {
         "description": "Create a new tenant for a specific class",
         "tags": [
@@ -2913,11 +3137,54 @@ func init() {
         }
       }
     },
+    "AnalyzerConfig": {
+      "description": "text analysis chain applied to a property both at index and at query time",
+      "type": "object",
+      "properties": {
+        "asciiFolding": {
+          "description": "Fold accented and other non-ASCII letters into their ASCII equivalent, e.g. ` + "`" + `é` + "`" + ` into ` + "`" + `e` + "`" + `",
+          "type": "boolean"
+        },
+        "lowercase": {
+          "description": "Lowercase all tokens",
+          "type": "boolean"
+        },
+        "ngramMax": {
+          "description": "Maximum length of the character n-grams, only applies to the ngram tokenization. Defaults to 3",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ngramMin": {
+          "description": "Minimum length of the character n-grams, only applies to the ngram tokenization. Defaults to 3",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stemmer": {
+          "description": "Language specific stemmer reducing tokens to their stem",
+          "type": "string",
+          "enum": [
+            "none",
+            "english",
+            "german",
+            "french",
+            "spanish"
+          ]
+        },
+        "stopwordPreset": {
+          "description": "Language specific stopword preset, stopwords are removed from the tokens after lowercasing",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Tokenization applied as the first step of the chain. Defaults to the tokenization of the property",
+          "type": "string"
+        }
+      }
+    },
     "BackupCreateRequest": {
       "description": "Request body for creating a backup of a set of classes",
       "properties": {
         "config": {
-          "description": "Custom configuration for the backup creation process",
+          "description": "Custom configuration for the backup creation process. Supported keys are ` + "`" + `compression` + "`" + ` (gzip or zstd), ` + "`" + `compressionLevel` + "`" + `, ` + "`" + `chunkSize` + "`" + ` (uncompressed size of an archive chunk in MB) and ` + "`" + `incrementalBaseBackupId` + "`" + ` (only upload files which are not part of this backup)",
           "type": "object"
         },
         "exclude": {
@@ -2975,7 +3242,8 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
@@ -3008,16 +3276,53 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
     },
+    "BackupListResponse": {
+      "description": "The definition of a backup list response body",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classes": {
+            "description": "The list of classes in the backup",
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          "id": {
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "type": "string"
+          },
+          "incrementalBaseBackupId": {
+            "description": "The ID of the base backup if this backup is incremental",
+            "type": "string"
+          },
+          "status": {
+            "description": "status of backup process",
+            "type": "string",
+            "enum": [
+              "STARTED",
+              "TRANSFERRING",
+              "TRANSFERRED",
+              "SUCCESS",
+              "FAILED",
+              "CANCELED"
+            ]
+          }
+        }
+      }
+    },
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
         "config": {
-          "description": "Custom configuration for the backup restoration process",
+          "description": "Custom configuration for the backup restoration process. Supported keys are ` + "`" + `classMapping` + "`" + ` (restore classes under new names, e.g. {\"Article\": \"ArticleStaging\"}) and ` + "`" + `nodeMapping` + "`" + ` (restore the data of a node in the backup onto a node with a different name, e.g. {\"node1\": \"staging-node1\"})",
           "type": "object"
         },
         "exclude": {
@@ -3435,6 +3740,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
         "properties": {
           "description": "The properties of the class.",
           "type": "array",
@@ -3449,6 +3757,13 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexConfig": {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
@@ -3757,6 +4072,13 @@ func init() {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Text analysis chains by property name, applied at index and at query time",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/AnalyzerConfig"
+          }
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -3779,6 +4101,14 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "description": "Query-time synonym sets for keyword search, can be changed without reindexing",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymSet"
+          },
+          "x-omitempty": true
         }
       }
     },
@@ -3970,9 +4300,32 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
+    "ObjectTtlConfig": {
+      "description": "Configure the automatic expiry of objects in a class",
+      "properties": {
+        "defaultTtl": {
+          "description": "Number of seconds after the time in deleteOn at which an object expires",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "Either _creationTimeUnix or the name of a date property the expiry is based on. Defaults to _creationTimeUnix",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether or not objects of this class expire",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      },
+      "type": "object"
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -4212,6 +4565,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the tokens of this property be stored in an additional inverted index. Defaults to false. Applicable only to properties of data type text and text[]. Required for phrase and proximity queries, i.e. the ` + "`" + `ContainsPhrase` + "`" + ` and ` + "`" + `WithinWords` + "`" + ` operators of where filters and quoted phrases in bm25 queries",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexSearchable": {
           "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
@@ -4226,13 +4584,15 @@ func init() {
           "type": "string"
         },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `ngram` + "`" + ` (splits on any non-alphanumerical, lowercases, indexes character n-grams of each word, trigrams unless configured otherwise in the analyzer), ` + "`" + `cjk` + "`" + ` (splits Chinese, Japanese and Korean text into overlapping bigrams, tokenizes remaining text like ` + "`" + `word` + "`" + `). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "ngram",
+            "cjk"
           ]
         }
       }
@@ -4446,20 +4806,72 @@ func init() {
         }
       }
     },
+    "SynonymSet": {
+      "description": "terms which are interchangeable in keyword search",
+      "type": "object",
+      "properties": {
+        "terms": {
+          "description": "terms of the set, a query term matching one of them also matches all others",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "weight": {
+          "description": "weight of a synonym relative to the query term it was expanded from, greater than 0 and at most 1. Defaults to 1",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
       "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are offloaded to the configured object storage. ` + "`" + `FROZEN` + "`" + ` can only be set when updating tenant",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "COLD",
+            "FROZEN"
+          ]
+        },
         "name": {
           "description": "name of the tenant",
           "type": "string"
         }
       }
     },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -4486,7 +4898,10 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "ContainsPhrase",
+            "WithinWords",
+            "Fuzzy"
           ],
           "example": "GreaterThanEqual"
         },
@@ -4545,6 +4960,12 @@ func init() {
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextProximity": {
+          "description": "value as text and maximum distance between its words, or maximum edit distance for the Fuzzy operator",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterTextProximity"
         }
       }
     },
@@ -4566,6 +4987,21 @@ func init() {
           "$ref": "#/definitions/GeoCoordinates"
         }
       }
+    },
+    "WhereFilterTextProximity": {
+      "description": "filter for words occurring close to each other",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "maximum number of words between the first and the last matched word, or the maximum edit distance for the Fuzzy operator",
+          "type": "integer",
+          "format": "int64"
+        },
+        "text": {
+          "description": "words to search for",
+          "type": "string"
+        }
+      }
     }
   },
   "parameters": {
@@ -4769,7 +5205,189 @@ func init() {
             }
           },
           "404": {
-            "description": "Not found, no oidc provider present"
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/backups/{backend}": {
+      "get": {
+        "description": "List all backups stored in a backend",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Existing backups successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of creating a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4778,31 +5396,16 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
-      }
-    },
-    "/backups/{backend}": {
-      "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a backup which is not in progress and is not the base of an incremental backup",
         "tags": [
           "backups"
         ],
-        "operationId": "backups.create",
+        "operationId": "backups.delete",
         "parameters": [
           {
             "type": "string",
@@ -4812,20 +5415,16 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
-            }
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup create process successfully started.",
-            "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
-            }
+          "204": {
+            "description": "Backup successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4836,8 +5435,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Invalid backup deletion attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4854,13 +5459,13 @@ func init() {
         ]
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+    "/backups/{backend}/{id}/cancel": {
+      "post": {
+        "description": "Cancels a backup which is in progress and deletes the files it has already uploaded",
         "tags": [
           "backups"
         ],
-        "operationId": "backups.create.status",
+        "operationId": "backups.cancel",
         "parameters": [
           {
             "type": "string",
@@ -4878,11 +5483,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup creation status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
-            }
+          "204": {
+            "description": "Backup successfully cancelled."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4900,7 +5502,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid backup cancellation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7540,6 +8142,64 @@ func init() {
           }
         }
       },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
       "post": {
         "description": "Create a new tenant for a specific class",
         "tags": [
@@ -7676,11 +8336,54 @@ func init() {
         }
       }
     },
+    "AnalyzerConfig": {
+      "description": "text analysis chain applied to a property both at index and at query time",
+      "type": "object",
+      "properties": {
+        "asciiFolding": {
+          "description": "Fold accented and other non-ASCII letters into their ASCII equivalent, e.g. ` + "`" + `é` + "`" + ` into ` + "`" + `e` + "`" + `",
+          "type": "boolean"
+        },
+        "lowercase": {
+          "description": "Lowercase all tokens",
+          "type": "boolean"
+        },
+        "ngramMax": {
+          "description": "Maximum length of the character n-grams, only applies to the ngram tokenization. Defaults to 3",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ngramMin": {
+          "description": "Minimum length of the character n-grams, only applies to the ngram tokenization. Defaults to 3",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stemmer": {
+          "description": "Language specific stemmer reducing tokens to their stem",
+          "type": "string",
+          "enum": [
+            "none",
+            "english",
+            "german",
+            "french",
+            "spanish"
+          ]
+        },
+        "stopwordPreset": {
+          "description": "Language specific stopword preset, stopwords are removed from the tokens after lowercasing",
+          "type": "string"
+        },
+        "tokenization": {
+          "description": "Tokenization applied as the first step of the chain. Defaults to the tokenization of the property",
+          "type": "string"
+        }
+      }
+    },
     "BackupCreateRequest": {
       "description": "Request body for creating a backup of a set of classes",
       "properties": {
         "config": {
-          "description": "Custom configuration for the backup creation process",
+          "description": "Custom configuration for the backup creation process. Supported keys are ` + "`" + `compression` + "`" + ` (gzip or zstd), ` + "`" + `compressionLevel` + "`" + `, ` + "`" + `chunkSize` + "`" + ` (uncompressed size of an archive chunk in MB) and ` + "`" + `incrementalBaseBackupId` + "`" + ` (only upload files which are not part of this backup)",
           "type": "object"
         },
         "exclude": {
@@ -7738,7 +8441,8 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
@@ -7771,16 +8475,53 @@ func init() {
             "TRANSFERRING",
             "TRANSFERRED",
             "SUCCESS",
-            "FAILED"
+            "FAILED",
+            "CANCELED"
           ]
         }
       }
     },
+    "BackupListResponse": {
+      "description": "The definition of a backup list response body",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classes": {
+            "description": "The list of classes in the backup",
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          "id": {
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "type": "string"
+          },
+          "incrementalBaseBackupId": {
+            "description": "The ID of the base backup if this backup is incremental",
+            "type": "string"
+          },
+          "status": {
+            "description": "status of backup process",
+            "type": "string",
+            "enum": [
+              "STARTED",
+              "TRANSFERRING",
+              "TRANSFERRED",
+              "SUCCESS",
+              "FAILED",
+              "CANCELED"
+            ]
+          }
+        }
+      }
+    },
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
         "config": {
-          "description": "Custom configuration for the backup restoration process",
+          "description": "Custom configuration for the backup restoration process. Supported keys are ` + "`" + `classMapping` + "`" + ` (restore classes under new names, e.g. {\"Article\": \"ArticleStaging\"}) and ` + "`" + `nodeMapping` + "`" + ` (restore the data of a node in the backup onto a node with a different name, e.g. {\"node1\": \"staging-node1\"})",
           "type": "object"
         },
         "exclude": {
@@ -8331,6 +9072,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
         "properties": {
           "description": "The properties of the class.",
           "type": "array",
@@ -8345,6 +9089,13 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexConfig": {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
@@ -8679,6 +9430,13 @@ func init() {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Text analysis chains by property name, applied at index and at query time",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/AnalyzerConfig"
+          }
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -8701,6 +9459,14 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "description": "Query-time synonym sets for keyword search, can be changed without reindexing",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymSet"
+          },
+          "x-omitempty": true
         }
       }
     },
@@ -8892,9 +9658,32 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
+    "ObjectTtlConfig": {
+      "description": "Configure the automatic expiry of objects in a class",
+      "properties": {
+        "defaultTtl": {
+          "description": "Number of seconds after the time in deleteOn at which an object expires",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "Either _creationTimeUnix or the name of a date property the expiry is based on. Defaults to _creationTimeUnix",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether or not objects of this class expire",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      },
+      "type": "object"
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -9152,6 +9941,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the tokens of this property be stored in an additional inverted index. Defaults to false. Applicable only to properties of data type text and text[]. Required for phrase and proximity queries, i.e. the ` + "`" + `ContainsPhrase` + "`" + ` and ` + "`" + `WithinWords` + "`" + ` operators of where filters and quoted phrases in bm25 queries",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexSearchable": {
           "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
@@ -9166,13 +9960,15 @@ func init() {
           "type": "string"
         },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `ngram` + "`" + ` (splits on any non-alphanumerical, lowercases, indexes character n-grams of each word, trigrams unless configured otherwise in the analyzer), ` + "`" + `cjk` + "`" + ` (splits Chinese, Japanese and Korean text into overlapping bigrams, tokenizes remaining text like ` + "`" + `word` + "`" + `). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "ngram",
+            "cjk"
           ]
         }
       }
@@ -9386,20 +10182,72 @@ func init() {
         }
       }
     },
+    "SynonymSet": {
+      "description": "terms which are interchangeable in keyword search",
+      "type": "object",
+      "properties": {
+        "terms": {
+          "description": "terms of the set, a query term matching one of them also matches all others",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "weight": {
+          "description": "weight of a synonym relative to the query term it was expanded from, greater than 0 and at most 1. Defaults to 1",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
       "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `FROZEN` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are offloaded to the configured object storage. ` + "`" + `FROZEN` + "`" + ` can only be set when updating tenant",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "COLD",
+            "FROZEN"
+          ]
+        },
         "name": {
           "description": "name of the tenant",
           "type": "string"
         }
       }
     },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -9426,7 +10274,10 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "ContainsPhrase",
+            "WithinWords",
+            "Fuzzy"
           ],
           "example": "GreaterThanEqual"
         },
@@ -9485,6 +10336,12 @@ func init() {
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextProximity": {
+          "description": "value as text and maximum distance between its words, or maximum edit distance for the Fuzzy operator",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterTextProximity"
         }
       }
     },
@@ -9515,6 +10372,21 @@ func init() {
           "format": "float64"
         }
       }
+    },
+    "WhereFilterTextProximity": {
+      "description": "filter for words occurring close to each other",
+      "type": "object",
+      "properties": {
+        "distance": {
+          "description": "maximum number of words between the first and the last matched word, or the maximum edit distance for the Fuzzy operator",
+          "type": "integer",
+          "format": "int64"
+        },
+        "text": {
+          "description": "words to search for",
+          "type": "string"
+        }
+      }
     }
   },
   "parameters": {
@@ -143,6 +143,12 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNot, nil
 	case models.WhereFilterOperatorIsNull:
 		return filters.OperatorIsNull, nil
+	case models.WhereFilterOperatorContainsPhrase:
+		return filters.OperatorContainsPhrase, nil
+	case models.WhereFilterOperatorWithinWords:
+		return filters.OperatorWithinWords, nil
+	case models.WhereFilterOperatorFuzzy:
+		return filters.OperatorFuzzy, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
@@ -168,5 +174,6 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueText == nil &&
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
-		in.ValueGeoRange == nil
+		in.ValueGeoRange == nil &&
+		in.ValueTextProximity == nil
 }
@@ -156,6 +156,31 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid text proximity filter",
+				input: &models.WhereFilter{
+					Operator: "WithinWords",
+					ValueTextProximity: &models.WhereFilterTextProximity{
+						Text:     "machine learning",
+						Distance: 3,
+					},
+					Path: []string{"textField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinWords,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("textField"),
+					},
+					Value: &filters.Value{
+						Value: filters.TextProximity{
+							Text:     "machine learning",
+							Distance: 3,
+						},
+						Type: schema.DataTypeText,
+					},
+				}},
+			},
 			{
 				name: "[deprected string] valid string filter",
 				input: &models.WhereFilter{
@@ -235,6 +260,18 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'distance.max' must be a positive number"),
 			},
+			{
+				name: "text proximity having no distance",
+				input: &models.WhereFilter{
+					Operator: "WithinWords",
+					ValueTextProximity: &models.WhereFilterTextProximity{
+						Text: "machine learning",
+					},
+					Path: []string{"textField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueTextProximity: " +
+					"field 'distance' must be a positive number"),
+			},
 			{
 				name: "and operator and path set",
 				input: &models.WhereFilter{
@@ -330,6 +367,21 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("LessThanEqual"),
 				expectedFilter: intFilterWithOp(filters.OperatorLessThanEqual),
 			},
+			{
+				name:           "contains phrase",
+				input:          inputIntFilterWithOp("ContainsPhrase"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsPhrase),
+			},
+			{
+				name:           "within words",
+				input:          inputIntFilterWithOp("WithinWords"),
+				expectedFilter: intFilterWithOp(filters.OperatorWithinWords),
+			},
+			{
+				name:           "fuzzy",
+				input:          inputIntFilterWithOp("Fuzzy"),
+				expectedFilter: intFilterWithOp(filters.OperatorFuzzy),
+			},
 		}
 
 		for _, test := range tests {
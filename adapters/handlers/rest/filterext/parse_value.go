@@ -116,6 +116,21 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// text proximity
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueTextProximity == nil {
+			return nil, nil
+		}
+
+		if in.ValueTextProximity.Distance < 1 {
+			return nil, fmt.Errorf("valueTextProximity: field 'distance' must be a positive number")
+		}
+
+		return valueFilter(filters.TextProximity{
+			Text:     in.ValueTextProximity.Text,
+			Distance: int(in.ValueTextProximity.Distance),
+		}, schema.DataTypeText), nil
+	},
 	// deprecated string
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueString == nil {
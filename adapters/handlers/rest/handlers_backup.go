@@ -31,11 +31,19 @@ type backupHandlers struct {
 func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	cfg, err := ubak.ParseCreateConfig(params.Body.Config)
+	if err != nil {
+		s.metricRequestsTotal.logUserError("")
+		return backups.NewBackupsCreateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 	req := ubak.BackupRequest{
-		ID:      params.Body.ID,
-		Backend: params.Backend,
-		Include: params.Body.Include,
-		Exclude: params.Body.Exclude,
+		ID:           params.Body.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		Compression:  cfg.Compression,
+		BaseBackupID: cfg.BaseBackupID,
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -94,11 +102,19 @@ func (s *backupHandlers) createBackupStatus(params backups.BackupsCreateStatusPa
 func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	cfg, err := ubak.ParseRestoreConfig(params.Body.Config)
+	if err != nil {
+		s.metricRequestsTotal.logUserError("")
+		return backups.NewBackupsRestoreUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 	req := ubak.BackupRequest{
-		ID:      params.ID,
-		Backend: params.Backend,
-		Include: params.Body.Include,
-		Exclude: params.Body.Exclude,
+		ID:           params.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		ClassMapping: cfg.ClassMapping,
+		NodeMapping:  cfg.NodeMapping,
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -157,6 +173,81 @@ func (s *backupHandlers) restoreBackupStatus(params backups.BackupsRestoreStatus
 	return backups.NewBackupsRestoreStatusOK().WithPayload(&payload)
 }
 
+func (s *backupHandlers) listBackups(params backups.BackupsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := s.manager.List(params.HTTPRequest.Context(), principal, params.Backend)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsListOK().WithPayload(*list)
+}
+
+func (s *backupHandlers) cancelBackup(params backups.BackupsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.Cancel(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsCancelNoContent()
+}
+
+func (s *backupHandlers) deleteBackup(params backups.BackupsDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.Delete(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsDeleteNoContent()
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
 	scheduler *ubak.Scheduler, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
 ) {
@@ -169,6 +260,12 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsRestoreHandlerFunc(h.restoreBackup)
 	api.BackupsBackupsRestoreStatusHandler = backups.
 		BackupsRestoreStatusHandlerFunc(h.restoreBackupStatus)
+	api.BackupsBackupsListHandler = backups.
+		BackupsListHandlerFunc(h.listBackups)
+	api.BackupsBackupsCancelHandler = backups.
+		BackupsCancelHandlerFunc(h.cancelBackup)
+	api.BackupsBackupsDeleteHandler = backups.
+		BackupsDeleteHandlerFunc(h.deleteBackup)
 }
 
 type backupRequestsTotal struct {
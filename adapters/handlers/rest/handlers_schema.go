@@ -233,6 +233,29 @@ func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 	return schema.NewTenantsCreateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) updateTenants(params schema.TenantsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.UpdateTenants(
+		params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := params.Body
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewTenantsUpdateOK().WithPayload(payload)
+}
+
 func (s *schemaHandlers) deleteTenants(params schema.TenantsDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -303,6 +326,8 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		TenantsCreateHandlerFunc(h.createTenants)
 	api.SchemaTenantsDeleteHandler = schema.
 		TenantsDeleteHandlerFunc(h.deleteTenants)
+	api.SchemaTenantsUpdateHandler = schema.
+		TenantsUpdateHandlerFunc(h.updateTenants)
 
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
 }
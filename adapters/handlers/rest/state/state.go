@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/locks"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -53,10 +54,14 @@ type State struct {
 	RemoteNodeIncoming    *sharding.RemoteNodeIncoming
 	RemoteReplicaIncoming *replica.RemoteReplicaIncoming
 	Traverser             *traverser.Traverser
+	ObjectsManager        *objects.Manager
+	BatchManager          *objects.BatchManager
 
 	ClassificationRepo *classifications.DistributedRepo
 	Metrics            *monitoring.PrometheusMetrics
 	BackupManager      *backup.Manager
+	BackupScheduler    *backup.Scheduler
+	PeriodicBackups    *backup.PeriodicBackups
 	DB                 *db.DB
 }
 
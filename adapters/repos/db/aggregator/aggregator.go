@@ -39,6 +39,7 @@ type Aggregator struct {
 	getSchema              schemaUC.SchemaGetter
 	classSearcher          inverted.ClassSearcher // to support ref-filters
 	deletedDocIDs          inverted.DeletedDocIDChecker
+	expired                helpers.AllowList // objects which outlived the TTL of the class
 	vectorIndex            vectorIndex
 	stopwords              stopwords.StopwordDetector
 	shardVersion           uint16
@@ -48,8 +49,8 @@ type Aggregator struct {
 
 func New(store *lsmkv.Store, params aggregation.Params,
 	getSchema schemaUC.SchemaGetter, classSearcher inverted.ClassSearcher,
-	deletedDocIDs inverted.DeletedDocIDChecker, stopwords stopwords.StopwordDetector,
-	shardVersion uint16, vectorIndex vectorIndex, logger logrus.FieldLogger,
+	deletedDocIDs inverted.DeletedDocIDChecker, expired helpers.AllowList,
+	stopwords stopwords.StopwordDetector, shardVersion uint16, vectorIndex vectorIndex, logger logrus.FieldLogger,
 	propLengths *inverted.JsonPropertyLengthTracker, isFallbackToSearchable inverted.IsFallbackToSearchable,
 ) *Aggregator {
 	return &Aggregator{
@@ -59,6 +60,7 @@ func New(store *lsmkv.Store, params aggregation.Params,
 		getSchema:              getSchema,
 		classSearcher:          classSearcher,
 		deletedDocIDs:          deletedDocIDs,
+		expired:                expired,
 		stopwords:              stopwords,
 		shardVersion:           shardVersion,
 		vectorIndex:            vectorIndex,
@@ -72,13 +74,38 @@ func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
 		return newGroupedAggregator(a).Do(ctx)
 	}
 
-	if a.params.Filters != nil || len(a.params.SearchVector) > 0 || a.params.Hybrid != nil {
+	// the unfiltered aggregator reads the inverted index, which still contains
+	// expired objects until they are deleted, so they can only be left out by
+	// aggregating the remaining objects one by one
+	if a.params.Filters != nil || len(a.params.SearchVector) > 0 ||
+		a.params.Hybrid != nil || a.hasExpired() {
 		return newFilteredAggregator(a).Do(ctx)
 	}
 
 	return newUnfilteredAggregator(a).Do(ctx)
 }
 
+func (a *Aggregator) hasExpired() bool {
+	return a.expired != nil && !a.expired.IsEmpty()
+}
+
+// removeExpired drops the doc ids of expired objects from ids
+func (a *Aggregator) removeExpired(ids []uint64) []uint64 {
+	if !a.hasExpired() {
+		return ids
+	}
+
+	n := 0
+	for _, id := range ids {
+		if a.expired.Contains(id) {
+			continue
+		}
+		ids[n] = id
+		n++
+	}
+	return ids[:n]
+}
+
 func (a *Aggregator) aggTypeOfProperty(
 	name schema.PropertyName,
 ) (aggregation.PropertyType, schema.DataType, error) {
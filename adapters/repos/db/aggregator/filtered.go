@@ -92,7 +92,7 @@ func (fa *filteredAggregator) hybrid(ctx context.Context) (*aggregation.Result,
 		ids[i] = r.DocID
 	}
 
-	return fa.prepareResult(ctx, ids)
+	return fa.prepareResult(ctx, fa.removeExpired(ids))
 }
 
 func (fa *filteredAggregator) filtered(ctx context.Context) (*aggregation.Result, error) {
@@ -58,6 +58,9 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 	err := ScanAllLSM(g.store, func(prop *models.PropertySchema, docID uint64) (bool, error) {
+		if g.hasExpired() && g.expired.Contains(docID) {
+			return true, nil
+		}
 		return true, g.addElementById(prop, docID)
 	})
 	if err != nil {
@@ -151,7 +154,7 @@ func (g *grouper) hybrid(ctx context.Context, allowList helpers.AllowList) ([]ui
 		ids[i] = r.DocID
 	}
 
-	return ids, nil
+	return g.removeExpired(ids), nil
 }
 
 func (g *grouper) addElementById(s *models.PropertySchema, docID uint64) error {
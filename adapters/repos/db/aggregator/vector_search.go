@@ -100,5 +100,37 @@ func (a *Aggregator) buildAllowList(ctx context.Context) (helpers.AllowList, err
 		}
 	}
 
+	if a.hasExpired() {
+		if allow == nil {
+			ids, err := a.allDocIDs()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve all doc IDs: %w", err)
+			}
+			return helpers.NewAllowList(a.removeExpired(ids)...), nil
+		}
+		return helpers.NewAllowList(a.removeExpired(allow.Slice())...), nil
+	}
+
 	return allow, nil
 }
+
+func (a *Aggregator) allDocIDs() ([]uint64, error) {
+	b := a.store.Bucket(helpers.ObjectsBucketLSM)
+	if b == nil {
+		return nil, fmt.Errorf("objects bucket not found")
+	}
+
+	c := b.Cursor()
+	defer c.Close()
+
+	var ids []uint64
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		id, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal doc id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -19,12 +19,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
 type BackupState struct {
 	BackupID   string
 	InProgress bool
+	// coldShards are the shards of COLD tenants which were loaded for the
+	// backup only. They are shut down once the backup is released.
+	coldShards []*Shard
 }
 
 // Backupable returns whether all given class can be backed up.
@@ -65,8 +69,10 @@ func (db *DB) BackupDescriptors(ctx context.Context, bakid string, classes []str
 				desc.Error = fmt.Errorf("class %v doesn't exist any more", c)
 			} else if err := idx.descriptor(ctx, bakid, &desc); err != nil {
 				desc.Error = fmt.Errorf("backup class %v descriptor: %w", c, err)
-			} else {
-				desc.Error = ctx.Err()
+			} else if err := ctx.Err(); err != nil {
+				// the backup has been cancelled and this descriptor won't be consumed
+				desc.Error = err
+				go idx.ReleaseBackup(context.Background(), bakid)
 			}
 
 			ds <- desc
@@ -99,6 +105,11 @@ func (db *DB) ShardsBackup(
 	sm := make(map[string]*Shard, len(shards))
 	for _, shardName := range shards {
 		shard := idx.shards.Load(shardName)
+		if shard == nil {
+			if shard, err = idx.loadColdShard(ctx, shardName); err != nil {
+				return cd, fmt.Errorf("class %q: %w", class, err)
+			}
+		}
 		if shard == nil {
 			return cd, fmt.Errorf("no shard %q for class %q", shardName, class)
 		}
@@ -188,12 +199,12 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 	}
 	defer func() {
 		if err != nil {
-			go i.ReleaseBackup(ctx, backupID)
+			go i.ReleaseBackup(context.Background(), backupID)
 		}
 	}()
 
-	if err = i.ForEachShard(func(name string, s *Shard) error {
-		if err = s.beginBackup(ctx); err != nil {
+	backupShard := func(s *Shard) error {
+		if err := s.beginBackup(ctx); err != nil {
 			return fmt.Errorf("pause compaction and flush: %w", err)
 		}
 		var ddesc backup.ShardDescriptor
@@ -203,10 +214,31 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 
 		desc.Shards = append(desc.Shards, ddesc)
 		return nil
+	}
+	if err = i.ForEachShard(func(name string, s *Shard) error {
+		return backupShard(s)
 	}); err != nil {
 		return err
 	}
 
+	// shards of COLD tenants are not loaded, but their files are on disk
+	ss := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	for _, name := range ss.AllLocalPhysicalShards() {
+		if i.shards.Load(name) != nil {
+			continue
+		}
+		s, err := i.loadColdShard(ctx, name)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			continue
+		}
+		if err := backupShard(s); err != nil {
+			return err
+		}
+	}
+
 	if desc.ShardingState, err = i.marshalShardingState(); err != nil {
 		return fmt.Errorf("marshal sharding state %w", err)
 	}
@@ -221,10 +253,42 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 // or is already inactive.
 func (i *Index) ReleaseBackup(ctx context.Context, id string) error {
 	defer i.resetBackupState()
-	if err := i.resumeMaintenanceCycles(ctx); err != nil {
-		return err
+	err := i.resumeMaintenanceCycles(ctx)
+
+	i.backupStateLock.RLock()
+	coldShards := i.backupState.coldShards
+	i.backupStateLock.RUnlock()
+	for _, shard := range coldShards {
+		if err2 := shard.shutdown(ctx); err2 != nil {
+			err = err2
+			i.logger.WithField("shard", shard.name).WithField("op", "release_backup").Error(err2)
+		}
 	}
-	return nil
+	return err
+}
+
+// loadColdShard loads the shard of a COLD tenant for the duration of a
+// backup. It is not added to the loaded shards of the index, so the tenant
+// stays inactive. It returns nil if the shard is not a local COLD shard.
+func (i *Index) loadColdShard(ctx context.Context, name string) (*Shard, error) {
+	ss := i.getSchema.CopyShardingState(i.Config.ClassName.String())
+	p, ok := ss.Physical[name]
+	if !ok || !ss.IsLocalShard(name) || p.ActivityStatus() != models.TenantActivityStatusCOLD ||
+		!i.shardExistsOnDisk(name) {
+		return nil, nil
+	}
+
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.ClassName)
+	shard, err := NewShard(ctx, nil, name, i, class, i.centralJobQueue)
+	if err != nil {
+		return nil, fmt.Errorf("load inactive shard %q: %w", name, err)
+	}
+
+	i.backupStateLock.Lock()
+	i.backupState.coldShards = append(i.backupState.coldShards, shard)
+	i.backupStateLock.Unlock()
+	return shard, nil
 }
 
 func (i *Index) initBackup(id string) error {
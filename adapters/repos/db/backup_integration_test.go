@@ -16,6 +16,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
@@ -24,13 +25,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestBackup_DBLevel(t *testing.T) {
@@ -252,6 +260,126 @@ func TestBackup_BucketLevel(t *testing.T) {
 	})
 }
 
+func TestBackup_ColdTenants(t *testing.T) {
+	var (
+		ctx      = testCtx()
+		logger   = logrus.New()
+		backupID = "backup-cold-tenants"
+		objID    = strfmt.UUID("ff9fcae5-57b8-431c-b8e2-986fd78f5809")
+		class    = &models.Class{
+			Class:               "ColdTenantBackupClass",
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+			Properties: []*models.Property{{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			}},
+		}
+	)
+
+	newDB := func(t *testing.T, rootDir string, shardState *sharding.State) (*DB, *Migrator) {
+		schemaGetter := &fakeSchemaGetter{
+			schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+			shardState: shardState,
+		}
+		db, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  rootDir,
+			QueryMaximumResults:       10,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		db.SetSchemaGetter(schemaGetter)
+		require.Nil(t, db.WaitForStartup(testCtx()))
+		migrator := NewMigrator(db, logger)
+		require.Nil(t, migrator.AddClass(ctx, class, shardState))
+		return db, migrator
+	}
+	setStatus := func(t *testing.T, migrator *Migrator, shardState *sharding.State, tenant, status string) {
+		p := shardState.Physical[tenant]
+		previous := p.ActivityStatus()
+		p.Status = status
+		shardState.Physical[tenant] = p
+		require.Nil(t, migrator.UpdateTenants(ctx, class,
+			[]*migrate.UpdateTenantPayload{{Name: tenant, Status: status, PreviousStatus: previous}}))
+	}
+
+	shardState, err := sharding.InitState("backup-cold-tenants", sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("hot", []string{"node1"}, models.TenantActivityStatusHOT)
+	shardState.AddPartition("cold", []string{"node1"}, models.TenantActivityStatusHOT)
+
+	dirName := t.TempDir()
+	db, migrator := newDB(t, dirName, shardState)
+	defer db.Shutdown(context.Background())
+
+	require.Nil(t, db.PutObject(ctx, &models.Object{
+		ID:         objID,
+		Class:      class.Class,
+		Tenant:     "cold",
+		Properties: map[string]interface{}{"name": "cold"},
+	}, []float32{1, 2, 3}, nil))
+	setStatus(t, migrator, shardState, "cold", models.TenantActivityStatusCOLD)
+
+	index := db.GetIndex(schema.ClassName(class.Class))
+	require.Nil(t, index.shards.Load("cold"))
+
+	var desc backup.ClassDescriptor
+	t.Run("cold tenants are part of the backup", func(t *testing.T) {
+		for d := range db.BackupDescriptors(ctx, backupID, []string{class.Class}) {
+			require.Nil(t, d.Error)
+			desc = d
+		}
+		names := make([]string, 0, len(desc.Shards))
+		for _, shd := range desc.Shards {
+			names = append(names, shd.Name)
+			if shd.Name == "cold" {
+				assert.NotEmpty(t, shd.Files)
+			}
+		}
+		assert.ElementsMatch(t, []string{"hot", "cold"}, names)
+
+		// the tenant stays inactive
+		assert.Nil(t, index.shards.Load("cold"))
+	})
+
+	restoreDir := t.TempDir()
+	t.Run("copy the backed up files", func(t *testing.T) {
+		for _, shd := range desc.Shards {
+			for _, file := range shd.Files {
+				data, err := os.ReadFile(path.Join(dirName, file))
+				require.Nil(t, err)
+				dest := path.Join(restoreDir, file)
+				require.Nil(t, os.MkdirAll(path.Dir(dest), os.ModePerm))
+				require.Nil(t, os.WriteFile(dest, data, os.ModePerm))
+			}
+			require.Nil(t, os.WriteFile(path.Join(restoreDir, shd.DocIDCounterPath), shd.DocIDCounter, os.ModePerm))
+			require.Nil(t, os.WriteFile(path.Join(restoreDir, shd.PropLengthTrackerPath), shd.PropLengthTracker, os.ModePerm))
+			require.Nil(t, os.WriteFile(path.Join(restoreDir, shd.ShardVersionPath), shd.Version, os.ModePerm))
+		}
+		require.Nil(t, db.ReleaseBackup(ctx, backupID, class.Class))
+	})
+
+	t.Run("restore the cold tenant", func(t *testing.T) {
+		var restoredState sharding.State
+		require.Nil(t, json.Unmarshal(desc.ShardingState, &restoredState))
+		restoredState.SetLocalName("node1")
+		assert.Equal(t, models.TenantActivityStatusCOLD, restoredState.Physical["cold"].ActivityStatus())
+
+		restored, migrator := newDB(t, restoreDir, &restoredState)
+		defer restored.Shutdown(context.Background())
+
+		setStatus(t, migrator, &restoredState, "cold", models.TenantActivityStatusHOT)
+		res, err := restored.ObjectByID(ctx, objID, search.SelectProperties{}, additional.Properties{}, "cold")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, objID, res.ID)
+	})
+}
+
 func setupTestDB(t *testing.T, rootDir string, classes ...*models.Class) *DB {
 	logger, _ := test.NewNullLogger()
 
@@ -0,0 +1,578 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cdc"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// changeStreamBatchSize is the maximum number of events passed to the send
+// function of a change stream at once
+const changeStreamBatchSize = 100
+
+// changeSequenceBlockSize is the number of sequence numbers which are
+// reserved on disk at once, so the high-water mark only has to be written
+// once per block instead of for every event
+const changeSequenceBlockSize = 1000
+
+// the key of sequence 0 is never used by an event, it holds the sequence up to
+// which events have been trimmed instead
+var changesTrimmedKey = sequenceKey(0)
+
+// changeLog assigns the sequence numbers of the change events of an index.
+// Sequence numbers are class-wide, but every event is stored in the shard it
+// belongs to, so the events of a tenant share the lifecycle of the tenant.
+//
+// Events are written in two steps as part of the mutation they describe. A
+// sequence number is reserved and the event is stored before the object is
+// written, so a failure to store the event fails the request before anything
+// was persisted. The event becomes visible to change streams once the object
+// has been written. If that fails, the event is removed again, only a crash
+// in between can leave an event for a write which never happened. Only the
+// bookkeeping of the sequence numbers is done under the lock of the
+// changeLog, events are written concurrently.
+//
+// An event only becomes visible once all events with a lower sequence number
+// have been committed or aborted, so streams never skip an event which is
+// still being written. A nil *changeLog means that the change stream is
+// disabled.
+//
+// The highest reserved sequence number is persisted in a file of the index,
+// because the events of inactive or deleted tenants are not loaded on
+// startup. Numbers are reserved in blocks, so sequences skip the rest of a
+// block after a restart.
+type changeLog struct {
+	maxEventsPerShard int
+	trimBatchSize     int
+	path              string
+
+	sync.Mutex
+	// last is the highest sequence number that has been assigned
+	last uint64
+	// reserved is the high-water mark stored on disk, last never exceeds it
+	reserved uint64
+	// visible is the sequence up to which all events have been committed
+	visible uint64
+	// pending holds the sequence numbers of events which are being written
+	pending map[uint64]struct{}
+	// notify is closed and replaced whenever visible has advanced
+	notify chan struct{}
+}
+
+func newChangeLog(cfg config.ChangeStream, path string) (*changeLog, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	maxEvents := cfg.MaxEventsPerShard
+	if maxEvents <= 0 {
+		maxEvents = config.DefaultChangeStreamMaxEventsPerShard
+	}
+
+	// trimming requires a cursor, so it is done in batches rather than for
+	// every single event
+	trimBatchSize := maxEvents / 100
+	if trimBatchSize < 1 {
+		trimBatchSize = 1
+	}
+
+	l := &changeLog{
+		maxEventsPerShard: maxEvents,
+		trimBatchSize:     trimBatchSize,
+		path:              path,
+		pending:           map[uint64]struct{}{},
+		notify:            make(chan struct{}),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "read change sequence")
+	}
+	if len(data) == 8 {
+		l.reserved = binary.LittleEndian.Uint64(data)
+		l.last = l.reserved
+		l.visible = l.reserved
+	}
+
+	return l, nil
+}
+
+// persistReserved must be called with the lock held. The file is replaced
+// atomically, so a crash leaves either the old or the new mark.
+func (l *changeLog) persistReserved(reserved uint64) error {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, reserved)
+
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return err
+	}
+
+	l.reserved = reserved
+	return nil
+}
+
+// drop removes the persisted high-water mark, e.g. when the class is deleted
+func (l *changeLog) drop() error {
+	if l == nil {
+		return nil
+	}
+
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove change sequence")
+	}
+	return nil
+}
+
+// shardChanges is the part of the change log which is stored in a shard. Its
+// lock protects the counters and serializes trimming, it is independent of
+// the locks of other shards.
+type shardChanges struct {
+	sync.Mutex
+	bucket  *lsmkv.Bucket
+	count   int
+	trimmed uint64
+}
+
+func sequenceKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+// loadShardChanges counts the events present in the bucket and returns the
+// highest sequence number among them
+func loadShardChanges(bucket *lsmkv.Bucket) (*shardChanges, uint64, error) {
+	sc := &shardChanges{bucket: bucket}
+
+	trimmed, err := bucket.Get(changesTrimmedKey)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "read trimmed sequence")
+	}
+	if len(trimmed) == 8 {
+		sc.trimmed = binary.BigEndian.Uint64(trimmed)
+	}
+
+	last := sc.trimmed
+	c := bucket.Cursor()
+	defer c.Close()
+	for k, _ := c.Seek(sequenceKey(1)); k != nil; k, _ = c.Next() {
+		last = binary.BigEndian.Uint64(k)
+		sc.count++
+	}
+
+	return sc, last, nil
+}
+
+// observe makes sure that sequence numbers keep increasing when the events of
+// a shard are loaded, e.g. when a tenant is activated
+func (l *changeLog) observe(seq uint64) {
+	if l == nil {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if seq > l.last {
+		l.last = seq
+	}
+	l.advance()
+}
+
+// reserve assigns the next sequence number, it stays invisible to streams
+// until it is released
+func (l *changeLog) reserve() (uint64, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.last+1 > l.reserved {
+		if err := l.persistReserved(l.last + changeSequenceBlockSize); err != nil {
+			return 0, errors.Wrap(err, "reserve change sequence")
+		}
+	}
+
+	l.last++
+	l.pending[l.last] = struct{}{}
+	return l.last, nil
+}
+
+// release marks the event with the sequence number as written or removed
+func (l *changeLog) release(seq uint64) {
+	l.Lock()
+	defer l.Unlock()
+
+	delete(l.pending, seq)
+	l.advance()
+}
+
+// advance must be called with the lock held
+func (l *changeLog) advance() {
+	visible := l.last
+	for seq := range l.pending {
+		if seq <= visible {
+			visible = seq - 1
+		}
+	}
+
+	if visible > l.visible {
+		l.visible = visible
+		close(l.notify)
+		l.notify = make(chan struct{})
+	}
+}
+
+// trimIfFull removes the oldest events of a shard once it holds
+// trimBatchSize events more than maxEventsPerShard
+func (l *changeLog) trimIfFull(sc *shardChanges) error {
+	sc.Lock()
+	defer sc.Unlock()
+
+	sc.count++
+	if sc.count < l.maxEventsPerShard+l.trimBatchSize {
+		return nil
+	}
+	return l.trim(sc)
+}
+
+// trim removes the oldest events of a shard until maxEventsPerShard are left,
+// it must be called with the lock of the shardChanges held
+func (l *changeLog) trim(sc *shardChanges) error {
+	remove := sc.count - l.maxEventsPerShard
+	keys := make([][]byte, 0, remove)
+
+	c := sc.bucket.Cursor()
+	for k, _ := c.Seek(sequenceKey(1)); k != nil && len(keys) < remove; k, _ = c.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	c.Close()
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	for _, key := range keys {
+		if err := sc.bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	trimmed := keys[len(keys)-1]
+	if err := sc.bucket.Put(changesTrimmedKey, trimmed); err != nil {
+		return err
+	}
+	sc.trimmed = binary.BigEndian.Uint64(trimmed)
+	sc.count -= len(keys)
+
+	return nil
+}
+
+// wait returns the sequence number up to which events are visible and a
+// channel which is closed once newer events become visible
+func (l *changeLog) wait() (uint64, <-chan struct{}) {
+	l.Lock()
+	defer l.Unlock()
+	return l.visible, l.notify
+}
+
+// read returns up to limit events of the given shards with a sequence number
+// larger than after, ordered by their sequence number. The returned sequence
+// is the one to continue reading after, it can be larger than the one of the
+// last event if newer events only exist in other shards.
+func (l *changeLog) read(shards []*shardChanges, after uint64,
+	limit int,
+) ([]cdc.Event, uint64, error) {
+	until, _ := l.wait()
+	if err := checkTrimmed(shards, after); err != nil {
+		return nil, after, err
+	}
+
+	var events []cdc.Event
+	for _, sc := range shards {
+		c := sc.bucket.Cursor()
+		n := 0
+		for k, v := c.Seek(sequenceKey(after + 1)); k != nil && n < limit; k, v = c.Next() {
+			if binary.BigEndian.Uint64(k) > until {
+				break
+			}
+
+			var event cdc.Event
+			if err := json.Unmarshal(v, &event); err != nil {
+				c.Close()
+				return nil, after, errors.Wrap(err, "unmarshal change event")
+			}
+			events = append(events, event)
+			n++
+		}
+		c.Close()
+	}
+
+	// events might have been trimmed while they were read
+	if err := checkTrimmed(shards, after); err != nil {
+		return nil, after, err
+	}
+
+	sort.Slice(events, func(a, b int) bool {
+		return events[a].Sequence < events[b].Sequence
+	})
+	if len(events) >= limit {
+		events = events[:limit]
+		return events, events[limit-1].Sequence, nil
+	}
+
+	return events, until, nil
+}
+
+// checkTrimmed fails if events after the sequence have been removed from
+// any of the shards. Streams which start from the beginning are served the
+// oldest retained events.
+func checkTrimmed(shards []*shardChanges, after uint64) error {
+	if after == 0 {
+		return nil
+	}
+
+	for _, sc := range shards {
+		sc.Lock()
+		trimmed := sc.trimmed
+		sc.Unlock()
+		if after < trimmed {
+			return cdc.ErrSequenceTrimmed
+		}
+	}
+	return nil
+}
+
+func (s *Shard) initChangeLog(ctx context.Context) error {
+	if s.index.changes == nil {
+		return nil
+	}
+
+	err := s.store.CreateOrLoadBucket(ctx, helpers.ChangesBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "create changes bucket")
+	}
+
+	changes, last, err := loadShardChanges(s.store.Bucket(helpers.ChangesBucketLSM))
+	if err != nil {
+		return errors.Wrap(err, "load changes")
+	}
+
+	s.changes = changes
+	s.index.changes.observe(last)
+	return nil
+}
+
+// pendingChange is an event which has been stored, but is not visible to
+// change streams yet. Exactly one of commit or abort has to be called once
+// the mutation has been written or has failed. A nil *pendingChange means
+// that the change stream is disabled.
+type pendingChange struct {
+	shard *Shard
+	seq   uint64
+}
+
+// prepareChange stores the event of a mutation which is about to be written.
+// obj is the state of the object after the mutation and must be nil for
+// deletes.
+func (s *Shard) prepareChange(eventType cdc.EventType, id strfmt.UUID,
+	obj *storobj.Object,
+) (*pendingChange, error) {
+	if s.changes == nil {
+		return nil, nil
+	}
+
+	event := cdc.Event{
+		Type:  eventType,
+		Class: s.index.Config.ClassName.String(),
+		ID:    id,
+		Time:  time.Now().UnixMilli(),
+	}
+	if s.index.partitioningEnabled {
+		event.Tenant = s.name
+	}
+
+	if obj != nil {
+		out := obj.Object
+		out.Tenant = event.Tenant
+		out.Vector = obj.Vector
+		if len(obj.Vectors) > 0 {
+			out.Vectors = make(models.Vectors, len(obj.Vectors))
+			for name, vec := range obj.Vectors {
+				out.Vectors[name] = vec
+			}
+		}
+		event.Object = &out
+	}
+
+	seq, err := s.index.changes.reserve()
+	if err != nil {
+		return nil, err
+	}
+	event.Sequence = seq
+	data, err := json.Marshal(event)
+	if err == nil {
+		err = s.changes.bucket.Put(sequenceKey(event.Sequence), data)
+	}
+	if err != nil {
+		s.index.changes.release(event.Sequence)
+		return nil, errors.Wrap(err, "store change event")
+	}
+
+	return &pendingChange{shard: s, seq: event.Sequence}, nil
+}
+
+// commit makes the event visible to change streams. Trimming old events is
+// not part of the mutation, so errors are only logged.
+func (c *pendingChange) commit() {
+	if c == nil {
+		return
+	}
+
+	changes := c.shard.index.changes
+	changes.release(c.seq)
+	if err := changes.trimIfFull(c.shard.changes); err != nil {
+		c.shard.index.logger.WithError(err).
+			WithField("action", "trim_change_events").
+			WithField("shard", c.shard.name).
+			Error("failed to trim change events")
+	}
+}
+
+// abort removes the event of a mutation which could not be written
+func (c *pendingChange) abort() {
+	if c == nil {
+		return
+	}
+
+	if err := c.shard.changes.bucket.Delete(sequenceKey(c.seq)); err != nil {
+		c.shard.index.logger.WithError(err).
+			WithField("action", "abort_change_event").
+			WithField("shard", c.shard.name).
+			Error("failed to remove the change event of a failed write")
+	}
+	c.shard.index.changes.release(c.seq)
+}
+
+// changeStream passes the change events of the index, or of a single tenant,
+// with a sequence number larger than after to send. It blocks until the
+// context is cancelled or an error occurs.
+func (i *Index) changeStream(ctx context.Context, tenant string, after uint64,
+	send func([]cdc.Event) error,
+) error {
+	if i.changes == nil {
+		return cdc.ErrDisabled
+	}
+
+	if tenant != "" && !i.partitioningEnabled {
+		return objects.NewErrMultiTenancy(
+			fmt.Errorf("class %s has multi-tenancy disabled, but request was with tenant", i.Config.ClassName),
+		)
+	}
+
+	for {
+		last, notify := i.changes.wait()
+		if last > after {
+			shards, err := i.changeStreamShards(tenant)
+			if err != nil {
+				return err
+			}
+
+			events, next, err := i.changes.read(shards, after, changeStreamBatchSize)
+			if err != nil {
+				return err
+			}
+
+			if len(events) > 0 {
+				if err := send(events); err != nil {
+					return err
+				}
+			}
+			after = next
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// changeStreamShards returns the local shards which are part of a change
+// stream, shards are resolved again for every read, so tenants which are
+// activated while streaming are included
+func (i *Index) changeStreamShards(tenant string) ([]*shardChanges, error) {
+	if tenant != "" {
+		shardName, err := i.determineObjectShard("", tenant)
+		if err != nil {
+			return nil, err
+		}
+		shard := i.localShard(shardName)
+		if shard == nil {
+			return nil, objects.NewErrNotFound("tenant %q is not stored on this node", tenant)
+		}
+		return []*shardChanges{shard.changes}, nil
+	}
+
+	var shards []*shardChanges
+	i.ForEachShard(func(_ string, shard *Shard) error {
+		if shard != nil && shard.changes != nil {
+			shards = append(shards, shard.changes)
+		}
+		return nil
+	})
+	return shards, nil
+}
+
+// ChangeStream passes the change events of a class, or of a single tenant of
+// it, to send until the context is cancelled.
+//
+// Events are not fanned out across the cluster: only the events of the
+// shards stored on this node are part of the stream, and sequence numbers are
+// assigned by every node independently. Consumers of a class which is
+// sharded across several nodes have to stream from each of them and must not
+// resume a stream on another node with a sequence number of this one. With
+// replication, every replica records the events of the writes it receives.
+func (db *DB) ChangeStream(ctx context.Context, class, tenant string,
+	after uint64, send func([]cdc.Event) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return objects.NewErrNotFound("class %q not found", class)
+	}
+
+	return idx.changeStream(ctx, tenant, after, send)
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_ChangeLogVisibility(t *testing.T) {
+	isClosed := func(ch <-chan struct{}) bool {
+		select {
+		case <-ch:
+			return true
+		default:
+			return false
+		}
+	}
+
+	l, err := newChangeLog(config.ChangeStream{Enabled: true},
+		path.Join(t.TempDir(), "index.changes"))
+	require.Nil(t, err)
+	require.NotNil(t, l)
+
+	reserve := func(t *testing.T) uint64 {
+		seq, err := l.reserve()
+		require.Nil(t, err)
+		return seq
+	}
+
+	first := reserve(t)
+	second := reserve(t)
+	assert.Equal(t, uint64(1), first)
+	assert.Equal(t, uint64(2), second)
+
+	visible, notify := l.wait()
+	assert.Equal(t, uint64(0), visible)
+
+	t.Run("a later event waits for an earlier pending one", func(t *testing.T) {
+		l.release(second)
+		visible, _ := l.wait()
+		assert.Equal(t, uint64(0), visible)
+		assert.False(t, isClosed(notify))
+	})
+
+	t.Run("releasing the earlier event makes both visible", func(t *testing.T) {
+		l.release(first)
+		visible, _ := l.wait()
+		assert.Equal(t, uint64(2), visible)
+		assert.True(t, isClosed(notify))
+	})
+
+	t.Run("loaded events are visible unless a lower one is pending", func(t *testing.T) {
+		_, notify := l.wait()
+		pending := reserve(t)
+		l.observe(10)
+		visible, _ := l.wait()
+		assert.Equal(t, uint64(2), visible)
+		assert.False(t, isClosed(notify))
+
+		l.release(pending)
+		visible, _ = l.wait()
+		assert.Equal(t, uint64(10), visible)
+		assert.True(t, isClosed(notify))
+		assert.Equal(t, uint64(11), reserve(t))
+	})
+}
+
+func Test_ChangeLogHighWaterMark(t *testing.T) {
+	cfg := config.ChangeStream{Enabled: true}
+	fileName := path.Join(t.TempDir(), "index.changes")
+
+	l, err := newChangeLog(cfg, fileName)
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		seq, err := l.reserve()
+		require.Nil(t, err)
+		l.release(seq)
+	}
+
+	t.Run("a restart continues after the reserved block", func(t *testing.T) {
+		restarted, err := newChangeLog(cfg, fileName)
+		require.Nil(t, err)
+
+		visible, _ := restarted.wait()
+		assert.Equal(t, uint64(changeSequenceBlockSize), visible)
+
+		seq, err := restarted.reserve()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(changeSequenceBlockSize+1), seq)
+	})
+
+	t.Run("loaded events beyond the reserved block move the mark", func(t *testing.T) {
+		restarted, err := newChangeLog(cfg, fileName)
+		require.Nil(t, err)
+		restarted.observe(3 * changeSequenceBlockSize)
+		for i := 0; i < 2; i++ {
+			_, err := restarted.reserve()
+			require.Nil(t, err)
+		}
+
+		restarted, err = newChangeLog(cfg, fileName)
+		require.Nil(t, err)
+		seq, err := restarted.reserve()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(4*changeSequenceBlockSize+1), seq)
+	})
+
+	t.Run("dropping removes the mark", func(t *testing.T) {
+		require.Nil(t, l.drop())
+		restarted, err := newChangeLog(cfg, fileName)
+		require.Nil(t, err)
+		seq, err := restarted.reserve()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), seq)
+	})
+}
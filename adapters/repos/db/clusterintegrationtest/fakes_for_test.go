@@ -102,11 +102,12 @@ func (n *node) init(dirName string, shardStateRaw []byte,
 	mux := http.NewServeMux()
 	mux.Handle("/indices/", indices.Indices())
 
-	backups := clusterapi.NewBackups(n.backupManager)
+	backups := clusterapi.NewBackups(n.backupManager, n.scheduler)
 	mux.Handle("/backups/can-commit", backups.CanCommit())
 	mux.Handle("/backups/commit", backups.Commit())
 	mux.Handle("/backups/abort", backups.Abort())
 	mux.Handle("/backups/status", backups.Status())
+	mux.Handle("/backups/cancel", backups.Cancel())
 
 	srv := httptest.NewServer(mux)
 	u, err := url.Parse(srv.URL)
@@ -154,8 +155,8 @@ func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) {
 	return ss.Physical[shard].BelongsToNodes[0], nil
 }
 
-func (f *fakeSchemaManager) TenantShard(class, tenant string) string {
-	return tenant
+func (f *fakeSchemaManager) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
 }
 
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string {
@@ -307,6 +308,18 @@ func (f *fakeBackupBackend) PutObject(ctx context.Context, backupID, key string,
 	return nil
 }
 
+func (f *fakeBackupBackend) AllBackups(ctx context.Context) ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	return nil, nil
+}
+
+func (f *fakeBackupBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) Initialize(ctx context.Context, backupID string) error {
 	f.Lock()
 	defer f.Unlock()
@@ -32,7 +32,7 @@ import (
 func (db *DB) PutObject(ctx context.Context, obj *models.Object,
 	vector []float32, repl *additional.ReplicationProperties,
 ) error {
-	object := storobj.FromObject(obj, vector)
+	object := storobj.FromObject(obj, vector, obj.Vectors)
 	idx := db.GetIndex(object.Class())
 	if idx == nil {
 		return fmt.Errorf("import into non-existing index for %s", object.Class())
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_Analyzers(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className := "Recipe"
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.Analyzers = map[string]models.AnalyzerConfig{
+		"title": {
+			Lowercase:      true,
+			AsciiFolding:   true,
+			Stemmer:        models.AnalyzerConfigStemmerFrench,
+			StopwordPreset: "fr",
+		},
+		"tags": {
+			AsciiFolding: true,
+			Stemmer:      models.AnalyzerConfigStemmerGerman,
+		},
+	}
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "plain",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := []strfmt.UUID{
+		"a1000000-0000-0000-0000-000000000001",
+		"a1000000-0000-0000-0000-000000000002",
+		"a1000000-0000-0000-0000-000000000003",
+	}
+	objects := []map[string]interface{}{
+		{"title": "Les crèmes brûlées de la maison", "tags": []string{"Nachspeisen"}, "plain": "crèmes"},
+		{"title": "Une crème légère", "tags": []string{"Kuchen"}, "plain": "crème"},
+		{"title": "Le pain de campagne", "tags": []string{"Brote", "Bäckerei"}, "plain": "pain"},
+	}
+	for i, props := range objects {
+		obj := &models.Object{Class: className, ID: ids[i], Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	bm25 := func(t *testing.T, query string, properties ...string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, _, err := idx.objectSearch(context.Background(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID()
+		}
+		return found
+	}
+
+	filter := func(t *testing.T, prop, value string) []strfmt.UUID {
+		f := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    f,
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("bm25 matches stemmed and folded terms", func(t *testing.T) {
+		assert.ElementsMatch(t, ids[:2], bm25(t, "CREME", "title"))
+		assert.ElementsMatch(t, ids[:2], bm25(t, "crèmes", "title"))
+	})
+
+	t.Run("bm25 ignores stopwords of the analyzer", func(t *testing.T) {
+		assert.Empty(t, bm25(t, "de la", "title"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, bm25(t, "le pains", "title"))
+	})
+
+	t.Run("bm25 on a property without analyzer is unchanged", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, bm25(t, "crème", "plain"))
+		assert.Empty(t, bm25(t, "creme", "plain"))
+	})
+
+	t.Run("bm25 across properties with different analyzers", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[1], ids[2]},
+			bm25(t, "crème Bäckereien", "title", "tags"))
+	})
+
+	t.Run("filters are analyzed with the chain of the property", func(t *testing.T) {
+		assert.ElementsMatch(t, ids[:2], filter(t, "title", "Crèmes"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, filter(t, "tags", "brot"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, filter(t, "plain", "crème"))
+	})
+}
@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestCRUD_AntiEntropy(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "AntiEntropyClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+
+	// node2 is the remote replica, node1 repairs it through a client which
+	// calls into the remote repo directly
+	shardState := singleShardState()
+	remote := newAntiEntropyTestRepo(t, logger, class, shardState, &fakeReplicationClient{})
+	local := newAntiEntropyTestRepo(t, logger, class, shardState, &antiEntropyTestClient{remote: remote})
+
+	var (
+		stale   = strfmt.UUID("10000000-0000-0000-0000-000000000001")
+		missing = strfmt.UUID("20000000-0000-0000-0000-000000000001")
+		deleted = strfmt.UUID("30000000-0000-0000-0000-000000000001")
+		newer   = strfmt.UUID("40000000-0000-0000-0000-000000000001")
+	)
+
+	put := func(t *testing.T, repo *DB, id strfmt.UUID, name string, updateTime int64) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:                 id,
+			Class:              class.Class,
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: updateTime,
+			Properties:         map[string]interface{}{"name": name},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	get := func(t *testing.T, repo *DB, id strfmt.UUID) *search.Result {
+		res, err := repo.ObjectByID(context.Background(), id,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("diverging replicas", func(t *testing.T) {
+		put(t, local, stale, "local", 2000)
+		put(t, local, missing, "local", 1000)
+		put(t, local, deleted, "local", 1000)
+		put(t, local, newer, "local", 1000)
+
+		put(t, remote, stale, "remote", 1000)
+		put(t, remote, deleted, "remote", 1000)
+		require.Nil(t, remote.DeleteObject(context.Background(), class.Class, deleted, nil, ""))
+		put(t, remote, newer, "remote", 2000)
+	})
+
+	t.Run("repairing the remote replica", func(t *testing.T) {
+		index := local.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, index)
+
+		shardName := shardState.AllPhysicalShards()[0]
+		shard := index.shards.Load(shardName)
+		require.NotNil(t, shard)
+
+		stats, err := index.replicator.RepairShard(context.Background(), shardName,
+			&localReplica{index: index, shard: shard})
+		require.Nil(t, err)
+		assert.Equal(t, 2, stats.Repaired)
+		assert.Equal(t, 1, stats.Conflicts)
+	})
+
+	t.Run("the remote replica has been repaired", func(t *testing.T) {
+		res := get(t, remote, stale)
+		require.NotNil(t, res)
+		assert.Equal(t, "local", res.Schema.(map[string]interface{})["name"])
+
+		res = get(t, remote, missing)
+		require.NotNil(t, res)
+		assert.Equal(t, "local", res.Schema.(map[string]interface{})["name"])
+
+		assert.Nil(t, get(t, remote, deleted))
+
+		res = get(t, remote, newer)
+		require.NotNil(t, res)
+		assert.Equal(t, "remote", res.Schema.(map[string]interface{})["name"])
+	})
+}
+
+func newAntiEntropyTestRepo(t *testing.T, logger logrus.FieldLogger, class *models.Class,
+	shardState *sharding.State, client replica.Client,
+) *DB {
+	schemaGetter := &antiEntropyTestSchemaGetter{
+		fakeSchemaGetter: &fakeSchemaGetter{
+			shardState: shardState,
+			schema: schema.Schema{
+				Objects: &models.Schema{Classes: []*models.Class{class}},
+			},
+		},
+	}
+	repo, err := New(logger, Config{
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, client, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	t.Cleanup(func() { repo.Shutdown(context.Background()) })
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+	return repo
+}
+
+// antiEntropyTestSchemaGetter places every shard on node1 and node2
+type antiEntropyTestSchemaGetter struct {
+	*fakeSchemaGetter
+}
+
+func (f *antiEntropyTestSchemaGetter) ResolveParentNodes(string, string) (map[string]string, error) {
+	return map[string]string{"node1": "node1", "node2": "node2"}, nil
+}
+
+// antiEntropyTestClient serves the remote replica from another repo
+type antiEntropyTestClient struct {
+	fakeReplicationClient
+	remote *DB
+}
+
+func (c *antiEntropyTestClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int,
+) ([]uint64, error) {
+	return c.remote.HashTreeLevel(ctx, index, shard, level)
+}
+
+func (c *antiEntropyTestClient) DigestObjectsInRange(ctx context.Context,
+	host, index, shard string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	return c.remote.DigestObjectsInRange(ctx, index, shard, initialUUID, finalUUID, limit)
+}
+
+func (c *antiEntropyTestClient) DigestObjects(ctx context.Context,
+	host, index, shard string, ids []strfmt.UUID,
+) ([]replica.RepairResponse, error) {
+	return c.remote.DigestObjects(ctx, index, shard, ids)
+}
+
+func (c *antiEntropyTestClient) OverwriteObjects(ctx context.Context,
+	host, index, shard string, vobjects []*objects.VObject,
+) ([]replica.RepairResponse, error) {
+	return c.remote.OverwriteObjects(ctx, index, shard, vobjects)
+}
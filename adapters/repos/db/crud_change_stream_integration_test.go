@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cdc"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestCRUD_ChangeStream(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ChangeStreamClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+			{
+				Name:     "friend",
+				DataType: []string{"ChangeStreamClass"},
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+		ChangeStream: config.ChangeStream{
+			Enabled:           true,
+			MaxEventsPerShard: 5,
+		},
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	// collect reads from the change stream until at least n events have been
+	// received
+	collect := func(t *testing.T, after uint64, n int) []cdc.Event {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var events []cdc.Event
+		err := repo.ChangeStream(ctx, class.Class, "", after, func(batch []cdc.Event) error {
+			events = append(events, batch...)
+			if len(events) >= n {
+				cancel()
+			}
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		return events
+	}
+
+	id := strfmt.UUID("5b6a2c1e-8f3d-4c0a-9e1b-7d2f4a6c8e01")
+
+	t.Run("mutating an object", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:    id,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "initial",
+			},
+		}, []float32{1, 2, 3}, nil))
+
+		require.Nil(t, repo.Merge(context.Background(), objects.MergeDocument{
+			Class:           class.Class,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "updated"},
+			UpdateTime:      time.Now().UnixMilli(),
+		}, nil, ""))
+
+		source := crossref.NewSource(schema.ClassName(class.Class), "friend", id)
+		target := crossref.New("localhost", class.Class, id)
+		require.Nil(t, repo.AddReference(context.Background(), source, target, nil, ""))
+
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil, ""))
+	})
+
+	t.Run("the stream contains all mutations in order", func(t *testing.T) {
+		events := collect(t, 0, 4)
+		require.Len(t, events, 4)
+
+		expected := []cdc.EventType{
+			cdc.EventTypeCreate,
+			cdc.EventTypeUpdate,
+			cdc.EventTypeReferenceChange,
+			cdc.EventTypeDelete,
+		}
+		for i, event := range events {
+			assert.Equal(t, uint64(i+1), event.Sequence)
+			assert.Equal(t, expected[i], event.Type)
+			assert.Equal(t, class.Class, event.Class)
+			assert.Equal(t, id, event.ID)
+		}
+
+		require.NotNil(t, events[0].Object)
+		assert.Equal(t, "initial", events[0].Object.Properties.(map[string]interface{})["name"])
+		assert.Equal(t, []float32{1, 2, 3}, []float32(events[0].Object.Vector))
+		require.NotNil(t, events[1].Object)
+		assert.Equal(t, "updated", events[1].Object.Properties.(map[string]interface{})["name"])
+		assert.Nil(t, events[3].Object)
+	})
+
+	t.Run("resuming the stream", func(t *testing.T) {
+		events := collect(t, 2, 2)
+		require.Len(t, events, 2)
+		assert.Equal(t, uint64(3), events[0].Sequence)
+		assert.Equal(t, uint64(4), events[1].Sequence)
+	})
+
+	t.Run("events beyond the retention are trimmed", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				ID:    strfmt.UUID(fmt.Sprintf("5b6a2c1e-8f3d-4c0a-9e1b-7d2f4a6c8f%02d", i)),
+				Class: class.Class,
+			}, []float32{1, 2, 3}, nil))
+		}
+
+		err := repo.ChangeStream(context.Background(), class.Class, "", 2,
+			func([]cdc.Event) error { return nil })
+		assert.ErrorIs(t, err, cdc.ErrSequenceTrimmed)
+
+		events := collect(t, 0, 5)
+		require.Len(t, events, 5)
+		assert.Equal(t, uint64(4), events[0].Sequence)
+		assert.Equal(t, uint64(8), events[4].Sequence)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		err := repo.ChangeStream(context.Background(), "Unknown", "", 0,
+			func([]cdc.Event) error { return nil })
+		assert.ErrorAs(t, err, &objects.ErrNotFound{})
+	})
+}
+
+func TestCRUD_ChangeStreamRestartWithColdTenant(t *testing.T) {
+	ctx := testCtx()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ChangeStreamTenantClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+
+	shardState, err := sharding.InitState("change-stream-tenants", sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("hot", []string{"node1"}, models.TenantActivityStatusHOT)
+	shardState.AddPartition("cold", []string{"node1"}, models.TenantActivityStatusHOT)
+
+	newDB := func(t *testing.T) (*DB, *Migrator) {
+		schemaGetter := &fakeSchemaGetter{
+			schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+			shardState: shardState,
+		}
+		repo, err := New(logger, Config{
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			MemtablesFlushIdleAfter:   60,
+			ChangeStream:              config.ChangeStream{Enabled: true},
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		migrator := NewMigrator(repo, logger)
+		require.Nil(t, migrator.AddClass(ctx, class, shardState))
+		return repo, migrator
+	}
+	setStatus := func(t *testing.T, migrator *Migrator, tenant, status string) {
+		p := shardState.Physical[tenant]
+		previous := p.ActivityStatus()
+		p.Status = status
+		shardState.Physical[tenant] = p
+		require.Nil(t, migrator.UpdateTenants(ctx, class,
+			[]*migrate.UpdateTenantPayload{{Name: tenant, Status: status, PreviousStatus: previous}}))
+	}
+	put := func(t *testing.T, repo *DB, tenant string, i int) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:     strfmt.UUID(fmt.Sprintf("6c1d9e2a-4b3f-4a8e-9c7d-1e2f3a4b5c%02d", i)),
+			Class:  class.Class,
+			Tenant: tenant,
+		}, []float32{1, 2, 3}, nil))
+	}
+	collect := func(t *testing.T, repo *DB, tenant string, n int) []cdc.Event {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var events []cdc.Event
+		err := repo.ChangeStream(ctx, class.Class, tenant, 0, func(batch []cdc.Event) error {
+			events = append(events, batch...)
+			if len(events) >= n {
+				cancel()
+			}
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		return events
+	}
+
+	repo, migrator := newDB(t)
+	put(t, repo, "hot", 0)
+	// the cold tenant holds the highest sequence numbers
+	put(t, repo, "cold", 1)
+	put(t, repo, "cold", 2)
+	setStatus(t, migrator, "cold", models.TenantActivityStatusCOLD)
+	require.Nil(t, repo.Shutdown(context.Background()))
+
+	repo, migrator = newDB(t)
+	defer repo.Shutdown(context.Background())
+	put(t, repo, "hot", 3)
+
+	t.Run("sequences continue after the events of the cold tenant", func(t *testing.T) {
+		events := collect(t, repo, "hot", 2)
+		require.Len(t, events, 2)
+		assert.Equal(t, uint64(1), events[0].Sequence)
+		assert.Greater(t, events[1].Sequence, uint64(3))
+	})
+
+	t.Run("the activated tenant keeps its events", func(t *testing.T) {
+		setStatus(t, migrator, "cold", models.TenantActivityStatusHOT)
+		events := collect(t, repo, "cold", 2)
+		require.Len(t, events, 2)
+		assert.Equal(t, uint64(2), events[0].Sequence)
+		assert.Equal(t, uint64(3), events[1].Sequence)
+
+		all := collect(t, repo, "", 4)
+		require.Len(t, all, 4)
+		for i := 1; i < len(all); i++ {
+			assert.Less(t, all[i-1].Sequence, all[i].Sequence)
+		}
+	})
+}
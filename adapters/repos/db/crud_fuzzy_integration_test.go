@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_Fuzzy(t *testing.T) {
+	dirName := t.TempDir()
+	className := "Product"
+	vFalse := false
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:            "description",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vFalse,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := []strfmt.UUID{
+		"f1000000-0000-0000-0000-000000000001",
+		"f1000000-0000-0000-0000-000000000002",
+		"f1000000-0000-0000-0000-000000000003",
+		"f1000000-0000-0000-0000-000000000004",
+	}
+	objects := []map[string]interface{}{
+		{
+			"name":        "iPhone 14",
+			"description": "Smartphone with a large display",
+			"tags":        []interface{}{"smartphone", "apple"},
+		},
+		{
+			"name":        "iPhones refurbished",
+			"description": "Bundle of used smartphones",
+			"tags":        []interface{}{"bundle"},
+		},
+		{
+			"name":        "Galaxy",
+			"description": "Android phone with stylus",
+			"tags":        []interface{}{"smartphone", "android"},
+		},
+		{
+			"name":        "Headphones",
+			"description": "Wireless noise cancelling",
+			"tags":        []interface{}{"audio"},
+		},
+	}
+	for i, props := range objects {
+		obj := &models.Object{Class: className, ID: ids[i], Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	fuzzy := func(t *testing.T, prop string, value interface{}) []strfmt.UUID {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorFuzzy,
+				On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+				Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+			}},
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	bm25 := func(t *testing.T, query string, fuzziness int, properties ...string) ([]strfmt.UUID, error) {
+		kwr := &searchparams.KeywordRanking{
+			Type: "bm25", Properties: properties, Query: query, Fuzziness: fuzziness,
+		}
+		res, _, err := idx.objectSearch(context.Background(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID()
+		}
+		return found, nil
+	}
+
+	t.Run("fuzzy filter on filterable property", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, fuzzy(t, "name", "iphnoe"))
+		assert.ElementsMatch(t, ids[:2], fuzzy(t, "name", "iphone"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, fuzzy(t, "name", "galaxi"))
+		// short terms must match exactly
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, fuzzy(t, "name", "14"))
+		assert.Empty(t, fuzzy(t, "name", "15"))
+	})
+
+	t.Run("fuzzy filter on searchable only property", func(t *testing.T) {
+		assert.ElementsMatch(t, ids[:2], fuzzy(t, "description", "smartphon"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[3]}, fuzzy(t, "description", "wireles"))
+	})
+
+	t.Run("fuzzy filter with explicit distance", func(t *testing.T) {
+		assert.Empty(t, fuzzy(t, "name", filters.TextProximity{Text: "iphnoe", Distance: 1}))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]},
+			fuzzy(t, "name", filters.TextProximity{Text: "iphnoe", Distance: 2}))
+		// short terms only match exactly unless a distance is set
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]},
+			fuzzy(t, "name", filters.TextProximity{Text: "15", Distance: 1}))
+	})
+
+	t.Run("fuzzy filter on array property", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[2]}, fuzzy(t, "tags", "smartfone"))
+	})
+
+	t.Run("bm25 with fuzziness", func(t *testing.T) {
+		found, err := bm25(t, "iphnoe", 0, "name")
+		require.Nil(t, err)
+		assert.Empty(t, found)
+
+		found, err = bm25(t, "iphnoe", 1, "name")
+		require.Nil(t, err)
+		assert.Empty(t, found)
+
+		found, err = bm25(t, "iphnoe", 2, "name")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+
+		found, err = bm25(t, "iphone", 1, "name")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, ids[:2], found)
+		// fuzzy matches score lower than exact matches
+		assert.Equal(t, ids[0], found[0])
+		found, err = bm25(t, "iphones", 1, "name")
+		require.Nil(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, ids[1], found[0])
+
+		found, err = bm25(t, "iphnoe stylsu", 2, "name", "description")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[2]}, found)
+	})
+
+	t.Run("bm25 with invalid fuzziness", func(t *testing.T) {
+		_, err := bm25(t, "iphnoe", 3, "name")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "fuzziness")
+	})
+}
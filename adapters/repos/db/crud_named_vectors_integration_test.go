@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestCRUD_NamedVectors(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	skipConfig := enthnsw.NewDefaultUserConfig()
+	skipConfig.Skip = true
+	class := &models.Class{
+		Class:               "NamedVectorsClass",
+		VectorIndexConfig:   skipConfig,
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			},
+			"description": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "flat",
+				VectorIndexConfig: flat.NewDefaultUserConfig(),
+			},
+		},
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	firstID := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	secondID := strfmt.UUID("0b1e5a8c-9a1d-4c1e-a0ad-6e1a1b3a9c2d")
+
+	t.Run("adding objects", func(t *testing.T) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    firstID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "first",
+			},
+			Vectors: models.Vectors{
+				"title":       {1, 0, 0},
+				"description": {0, 1},
+			},
+		}, nil, nil)
+		require.Nil(t, err)
+
+		err = repo.PutObject(context.Background(), &models.Object{
+			ID:    secondID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "second",
+			},
+			Vectors: models.Vectors{
+				"title":       {0, 1, 0},
+				"description": {1, 0},
+			},
+		}, nil, nil)
+		require.Nil(t, err)
+	})
+
+	t.Run("named vectors are returned when getting by id", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), firstID, search.SelectProperties{},
+			additional.Properties{Vector: true}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, models.Vectors{
+			"title":       {1, 0, 0},
+			"description": {0, 1},
+		}, res.Vectors)
+	})
+
+	vectorSearch := func(t *testing.T, vector []float32, targetVector string) []search.Result {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			TargetVector: targetVector,
+			Pagination: &filters.Pagination{
+				Limit: 10,
+			},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("searching each target vector", func(t *testing.T) {
+		res := vectorSearch(t, []float32{1, 0, 0}, "title")
+		require.Len(t, res, 2)
+		assert.Equal(t, firstID, res[0].ID)
+
+		res = vectorSearch(t, []float32{1, 0}, "description")
+		require.Len(t, res, 2)
+		assert.Equal(t, secondID, res[0].ID)
+	})
+
+	t.Run("searching an unknown target vector", func(t *testing.T) {
+		_, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0},
+			TargetVector: "summary",
+			Pagination: &filters.Pagination{
+				Limit: 10,
+			},
+		})
+		require.NotNil(t, err)
+	})
+
+	t.Run("merging a single named vector", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class: class.Class,
+			ID:    firstID,
+			Vectors: map[string][]float32{
+				"description": {1, 0.1},
+			},
+			UpdateTime: 1000002,
+		}, nil, "")
+		require.Nil(t, err)
+
+		res := vectorSearch(t, []float32{1, 0.1}, "description")
+		require.Len(t, res, 2)
+		assert.Equal(t, firstID, res[0].ID)
+
+		// the other named vector is left untouched
+		res = vectorSearch(t, []float32{1, 0, 0}, "title")
+		require.Len(t, res, 2)
+		assert.Equal(t, firstID, res[0].ID)
+	})
+
+	t.Run("deleting an object removes it from all vector indexes", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, firstID, nil, ""))
+
+		res := vectorSearch(t, []float32{1, 0, 0}, "title")
+		require.Len(t, res, 1)
+		assert.Equal(t, secondID, res[0].ID)
+
+		res = vectorSearch(t, []float32{1, 0}, "description")
+		require.Len(t, res, 1)
+		assert.Equal(t, secondID, res[0].ID)
+	})
+}
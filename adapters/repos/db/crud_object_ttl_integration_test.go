@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_ObjectTTL(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	inverted := invertedConfig()
+	inverted.IndexTimestamps = true
+	class := &models.Class{
+		Class:               "ExpiringClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: inverted,
+		ObjectTTLConfig: &models.ObjectTTLConfig{
+			Enabled:    true,
+			DeleteOn:   filters.InternalPropCreationTimeUnix,
+			DefaultTTL: 3600,
+		},
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	now := time.Now()
+	expiredID := strfmt.UUID("1c2a6b8e-0b8b-4d4e-9a55-5e1d2f8f0a01")
+	liveID := strfmt.UUID("1c2a6b8e-0b8b-4d4e-9a55-5e1d2f8f0a02")
+
+	t.Run("adding objects", func(t *testing.T) {
+		// the expired object is imported first and is closest to the search
+		// vector, so it would take the first place in limited results
+		for _, o := range []struct {
+			id      strfmt.UUID
+			created time.Time
+			vector  []float32
+		}{
+			{id: expiredID, created: now.Add(-2 * time.Hour), vector: []float32{1, 2, 3}},
+			{id: liveID, created: now.Add(-time.Minute), vector: []float32{1, 2, 4}},
+		} {
+			obj := &models.Object{
+				ID:                 o.id,
+				Class:              class.Class,
+				CreationTimeUnix:   o.created.UnixMilli(),
+				LastUpdateTimeUnix: o.created.UnixMilli(),
+				Properties: map[string]interface{}{
+					"name": "some name",
+				},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, o.vector, nil))
+		}
+	})
+
+	t.Run("expired objects are hidden from queries", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), expiredID,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		exists, err := repo.Exists(context.Background(), class.Class, expiredID, nil, "")
+		require.Nil(t, err)
+		assert.False(t, exists)
+
+		exists, err = repo.Exists(context.Background(), class.Class, liveID, nil, "")
+		require.Nil(t, err)
+		assert.True(t, exists)
+
+		list, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, liveID, list[0].ID)
+
+		list, err = repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, liveID, list[0].ID)
+	})
+
+	t.Run("expired objects don't count towards the limit", func(t *testing.T) {
+		for name, params := range map[string]dto.GetParams{
+			"list": {
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 1},
+			},
+			"filtered list": {
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 1},
+				Filters: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "name"},
+					Value:    &filters.Value{Value: "some", Type: schema.DataTypeText},
+				}},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				list, err := repo.Search(context.Background(), params)
+				require.Nil(t, err)
+				require.Len(t, list, 1)
+				assert.Equal(t, liveID, list[0].ID)
+			})
+		}
+
+		list, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 3},
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, liveID, list[0].ID)
+	})
+
+	t.Run("expired objects are left out of aggregations and counts", func(t *testing.T) {
+		nameFilter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "name"},
+			Value:    &filters.Value{Value: "some", Type: schema.DataTypeText},
+		}}
+		objectLimit := 10
+		nameProp := []aggregation.ParamProperty{{
+			Name:        "name",
+			Aggregators: []aggregation.Aggregator{aggregation.CountAggregator},
+		}}
+
+		for name, params := range map[string]aggregation.Params{
+			"unfiltered": {
+				ClassName:        schema.ClassName(class.Class),
+				IncludeMetaCount: true,
+				Properties:       nameProp,
+			},
+			"filtered": {
+				ClassName:        schema.ClassName(class.Class),
+				IncludeMetaCount: true,
+				Properties:       nameProp,
+				Filters:          nameFilter,
+			},
+			"vector search": {
+				ClassName:        schema.ClassName(class.Class),
+				IncludeMetaCount: true,
+				Properties:       nameProp,
+				SearchVector:     []float32{1, 2, 3},
+				ObjectLimit:      &objectLimit,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				res, err := repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+				require.Len(t, res.Groups, 1)
+				assert.Equal(t, 1, res.Groups[0].Count)
+				assert.Equal(t, 1, res.Groups[0].Properties["name"].TextAggregation.Count)
+			})
+		}
+
+		t.Run("grouped", func(t *testing.T) {
+			res, err := repo.Aggregate(context.Background(), aggregation.Params{
+				ClassName:        schema.ClassName(class.Class),
+				IncludeMetaCount: true,
+				GroupBy:          &filters.Path{Class: schema.ClassName(class.Class), Property: "name"},
+			})
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 1, res.Groups[0].Count)
+		})
+
+		t.Run("node status", func(t *testing.T) {
+			status := repo.localNodeStatus(class.Class)
+			require.NotNil(t, status.Stats)
+			assert.Equal(t, int64(1), status.Stats.ObjectCount)
+		})
+	})
+
+	t.Run("the cycle deletes expired objects", func(t *testing.T) {
+		index := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, index)
+
+		shouldBreak := func() bool { return false }
+		assert.True(t, index.deleteExpiredObjects(shouldBreak))
+		assert.False(t, index.deleteExpiredObjects(shouldBreak))
+
+		count := 0
+		index.ForEachShard(func(_ string, shard *Shard) error {
+			count += shard.store.Bucket(helpers.ObjectsBucketLSM).Count()
+			return nil
+		})
+		assert.Equal(t, 1, count)
+
+		res, err := repo.ObjectByID(context.Background(), liveID,
+			search.SelectProperties{}, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+	})
+}
@@ -0,0 +1,238 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_Phrases(t *testing.T) {
+	dirName := t.TempDir()
+	className := "Contract"
+	vTrue := true
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Properties: []*models.Property{
+			{
+				Name:           "body",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "clauses",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := []strfmt.UUID{
+		"c1000000-0000-0000-0000-000000000001",
+		"c1000000-0000-0000-0000-000000000002",
+		"c1000000-0000-0000-0000-000000000003",
+		"c1000000-0000-0000-0000-000000000004",
+	}
+	objects := []map[string]interface{}{
+		{
+			"body":    "Applying machine learning to contracts",
+			"clauses": []interface{}{"force majeure", "governing law"},
+			"title":   "machine learning",
+		},
+		{
+			"body":    "The learning machine was delivered late",
+			"clauses": []interface{}{"force", "majeure event"},
+			"title":   "delivery",
+		},
+		{
+			"body":    "Machine translation and deep learning",
+			"clauses": []interface{}{"governing law of the state"},
+			"title":   "translation",
+		},
+		{
+			"body":    "No relevant terms at all",
+			"clauses": []interface{}{},
+			"title":   "machine learning",
+		},
+	}
+	for i, props := range objects {
+		obj := &models.Object{Class: className, ID: ids[i], Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	search := func(t *testing.T, f *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    f,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found, nil
+	}
+
+	filter := func(operator filters.Operator, prop string, value interface{}) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: operator,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+	}
+
+	containsPhrase := func(t *testing.T, prop, phrase string) []strfmt.UUID {
+		found, err := search(t, filter(filters.OperatorContainsPhrase, prop, phrase))
+		require.Nil(t, err)
+		return found
+	}
+
+	withinWords := func(t *testing.T, prop, text string, distance int) []strfmt.UUID {
+		found, err := search(t, filter(filters.OperatorWithinWords, prop,
+			filters.TextProximity{Text: text, Distance: distance}))
+		require.Nil(t, err)
+		return found
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	bm25 := func(t *testing.T, query string, properties ...string) ([]strfmt.UUID, error) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, _, err := idx.objectSearch(context.Background(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID()
+		}
+		return found, nil
+	}
+
+	t.Run("contains phrase", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, containsPhrase(t, "body", "machine learning"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, containsPhrase(t, "body", "learning machine"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, containsPhrase(t, "body", "deep learning"))
+		assert.Empty(t, containsPhrase(t, "body", "machine deep"))
+	})
+
+	t.Run("contains phrase does not match across array elements", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, containsPhrase(t, "clauses", "force majeure"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[2]}, containsPhrase(t, "clauses", "governing law"))
+		assert.Empty(t, containsPhrase(t, "clauses", "majeure governing"))
+	})
+
+	t.Run("within words", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[1]}, withinWords(t, "body", "machine learning", 1))
+		assert.ElementsMatch(t, ids[:2], withinWords(t, "body", "learning machine", 3))
+		assert.ElementsMatch(t, ids[:3], withinWords(t, "body", "learning machine", 4))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, withinWords(t, "clauses", "majeure force", 1))
+		assert.Empty(t, withinWords(t, "clauses", "force event", inverted.MaxPhraseDistance))
+	})
+
+	t.Run("phrase on property without positions", func(t *testing.T) {
+		_, err := search(t, filter(filters.OperatorContainsPhrase, "title", "machine learning"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "indexPositions")
+	})
+
+	t.Run("bm25 with quoted phrases", func(t *testing.T) {
+		found, err := bm25(t, "machine learning", "body")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, ids[:3], found)
+
+		found, err = bm25(t, `"machine learning"`, "body")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+
+		found, err = bm25(t, `"machine learning"~3`, "body")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, ids[:2], found)
+
+		found, err = bm25(t, `"machine learning" "force majeure"`, "body", "clauses")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+
+		// properties without positions are scored, but can not match phrases
+		found, err = bm25(t, `"machine learning"`, "body", "title")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, found)
+
+		_, err = bm25(t, `"machine learning"`, "title")
+		require.NotNil(t, err)
+	})
+
+	t.Run("update and delete objects", func(t *testing.T) {
+		obj := &models.Object{Class: className, ID: ids[1], Properties: map[string]interface{}{
+			"body":    "It is about machine learning now",
+			"clauses": []interface{}{},
+			"title":   "delivery",
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+		require.Nil(t, repo.DeleteObject(context.Background(), className, ids[0], nil, ""))
+
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, containsPhrase(t, "body", "machine learning"))
+		assert.Empty(t, containsPhrase(t, "body", "learning machine"))
+		assert.Empty(t, containsPhrase(t, "clauses", "force majeure"))
+	})
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCRUD_Synonyms(t *testing.T) {
+	dirName := t.TempDir()
+	className := "Drug"
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.Synonyms = []*models.SynonymSet{
+		{Terms: []string{"Tylenol", "acetaminophen", "paracetamol"}, Weight: ptFloat32(0.8)},
+	}
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "code",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := []strfmt.UUID{
+		"d1000000-0000-0000-0000-000000000001",
+		"d1000000-0000-0000-0000-000000000002",
+		"d1000000-0000-0000-0000-000000000003",
+		"d1000000-0000-0000-0000-000000000004",
+	}
+	objects := []map[string]interface{}{
+		{"name": "Tylenol extra strength", "code": "Tylenol"},
+		{"name": "Acetaminophen oral suspension", "code": "acetaminophen"},
+		{"name": "Paracetamol for children", "code": "paracetamol"},
+		{"name": "Aspirin low dose", "code": "ASA"},
+	}
+	for i, props := range objects {
+		obj := &models.Object{Class: className, ID: ids[i], Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	bm25 := func(t *testing.T, query string, properties ...string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, _, err := idx.objectSearch(context.Background(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID()
+		}
+		return found
+	}
+
+	t.Run("query terms are expanded with synonyms", func(t *testing.T) {
+		found := bm25(t, "tylenol", "name")
+		require.Len(t, found, 3)
+		assert.ElementsMatch(t, ids[:3], found)
+		// synonyms are weighted below the query term itself
+		assert.Equal(t, ids[0], found[0])
+
+		assert.ElementsMatch(t, ids[:3], bm25(t, "paracetamol", "name"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[3]}, bm25(t, "aspirin", "name"))
+	})
+
+	t.Run("synonyms are analyzed like the query", func(t *testing.T) {
+		// field tokenization keeps the case of the synonym set entries
+		assert.ElementsMatch(t, ids[:3], bm25(t, "Tylenol", "code"))
+		assert.Empty(t, bm25(t, "tylenol", "code"))
+	})
+
+	t.Run("synonyms can be changed without reindexing", func(t *testing.T) {
+		class.InvertedIndexConfig.Synonyms = []*models.SynonymSet{
+			{Terms: []string{"aspirin", "ASA"}},
+		}
+
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, bm25(t, "tylenol", "name"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[3]}, bm25(t, "asa", "name", "code"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[3]}, bm25(t, "ASA", "code"))
+
+		class.InvertedIndexConfig.Synonyms = nil
+		assert.Empty(t, bm25(t, "asa", "name"))
+	})
+}
@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestCRUD_TenantActivityStatus(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "TenantActivityClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+
+	shardState, err := sharding.InitState("test-index", sharding.Config{},
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("hot", []string{"node1"}, models.TenantActivityStatusHOT)
+	shardState.AddPartition("cold", []string{"node1"}, models.TenantActivityStatusCOLD)
+
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	backend := &fakeOffloadBackend{
+		dataPath:    dirName,
+		backupsPath: t.TempDir(),
+	}
+	repo.SetTenantOffloadBackend(backend)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	index := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, index)
+
+	setStatus := func(t *testing.T, tenant, status string) {
+		p := shardState.Physical[tenant]
+		previous := p.ActivityStatus()
+		p.Status = status
+		shardState.Physical[tenant] = p
+		require.Nil(t, migrator.UpdateTenants(context.Background(), class,
+			[]*migrate.UpdateTenantPayload{{Name: tenant, Status: status, PreviousStatus: previous}}))
+	}
+
+	putObject := func(id strfmt.UUID, tenant string) error {
+		return repo.PutObject(context.Background(), &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Tenant:     tenant,
+			Properties: map[string]interface{}{"name": tenant},
+		}, []float32{1, 2, 3}, nil)
+	}
+
+	getObject := func(id strfmt.UUID, tenant string) (*search.Result, error) {
+		return repo.ObjectByID(context.Background(), id, search.SelectProperties{},
+			additional.Properties{}, tenant)
+	}
+
+	hotID := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	coldID := strfmt.UUID("0b1e5a8c-9a1d-4c1e-a0ad-6e1a1b3a9c2d")
+
+	t.Run("cold tenants are not loaded", func(t *testing.T) {
+		assert.NotNil(t, index.shards.Load("hot"))
+		assert.Nil(t, index.shards.Load("cold"))
+	})
+
+	t.Run("requests to cold tenants are rejected", func(t *testing.T) {
+		require.Nil(t, putObject(hotID, "hot"))
+
+		err := putObject(coldID, "cold")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), errTenantNotActive.Error())
+	})
+
+	t.Run("activating a tenant loads its shard", func(t *testing.T) {
+		setStatus(t, "cold", models.TenantActivityStatusHOT)
+		assert.NotNil(t, index.shards.Load("cold"))
+
+		require.Nil(t, putObject(coldID, "cold"))
+		res, err := getObject(coldID, "cold")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+	})
+
+	t.Run("deactivating a tenant unloads its shard", func(t *testing.T) {
+		setStatus(t, "hot", models.TenantActivityStatusCOLD)
+		assert.Nil(t, index.shards.Load("hot"))
+
+		_, err := getObject(hotID, "hot")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), errTenantNotActive.Error())
+	})
+
+	t.Run("reactivating a tenant keeps its data", func(t *testing.T) {
+		setStatus(t, "hot", models.TenantActivityStatusHOT)
+		assert.NotNil(t, index.shards.Load("hot"))
+
+		res, err := getObject(hotID, "hot")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, hotID, res.ID)
+	})
+	t.Run("freezing a tenant offloads its files", func(t *testing.T) {
+		setStatus(t, "hot", models.TenantActivityStatusFROZEN)
+		assert.Nil(t, index.shards.Load("hot"))
+		assert.False(t, index.shardExistsOnDisk("hot"))
+
+		_, err := getObject(hotID, "hot")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), errTenantNotActive.Error())
+	})
+
+	t.Run("unfreezing a tenant downloads its files", func(t *testing.T) {
+		setStatus(t, "hot", models.TenantActivityStatusCOLD)
+		assert.Nil(t, index.shards.Load("hot"))
+		assert.True(t, index.shardExistsOnDisk("hot"))
+
+		setStatus(t, "hot", models.TenantActivityStatusHOT)
+		res, err := getObject(hotID, "hot")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, hotID, res.ID)
+	})
+
+	t.Run("a failed offload keeps the tenant active", func(t *testing.T) {
+		backend.putErr = errors.New("upload failed")
+		defer func() { backend.putErr = nil }()
+
+		err := migrator.UpdateTenants(context.Background(), class, []*migrate.UpdateTenantPayload{{
+			Name: "hot", Status: models.TenantActivityStatusFROZEN, PreviousStatus: models.TenantActivityStatusHOT,
+		}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "upload failed")
+
+		assert.NotNil(t, index.shards.Load("hot"))
+		res, err := getObject(hotID, "hot")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, hotID, res.ID)
+	})
+
+	t.Run("an interrupted download leaves no partial shard", func(t *testing.T) {
+		setStatus(t, "hot", models.TenantActivityStatusFROZEN)
+		assert.False(t, index.shardExistsOnDisk("hot"))
+
+		backend.writeErr = errors.New("download failed")
+		backend.writeOK = 1
+		err := migrator.UpdateTenants(context.Background(), class, []*migrate.UpdateTenantPayload{{
+			Name: "hot", Status: models.TenantActivityStatusHOT, PreviousStatus: models.TenantActivityStatusFROZEN,
+		}})
+		backend.writeErr = nil
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "download failed")
+		assert.False(t, index.shardExistsOnDisk("hot"))
+		assert.NoDirExists(t, index.shardDownloadPath("hot"))
+
+		p := shardState.Physical["hot"]
+		p.Status = models.TenantActivityStatusHOT
+		shardState.Physical["hot"] = p
+		require.Nil(t, migrator.UpdateTenants(context.Background(), class, []*migrate.UpdateTenantPayload{{
+			Name: "hot", Status: models.TenantActivityStatusHOT, PreviousStatus: models.TenantActivityStatusFROZEN,
+		}}))
+		res, err := getObject(hotID, "hot")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, hotID, res.ID)
+	})
+
+	t.Run("freezing and activating a tenant directly", func(t *testing.T) {
+		setStatus(t, "cold", models.TenantActivityStatusFROZEN)
+		assert.False(t, index.shardExistsOnDisk("cold"))
+
+		setStatus(t, "cold", models.TenantActivityStatusHOT)
+		res, err := getObject(coldID, "cold")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, coldID, res.ID)
+	})
+
+	t.Run("deleting a cold tenant removes its files", func(t *testing.T) {
+		setStatus(t, "cold", models.TenantActivityStatusCOLD)
+		assert.True(t, index.shardExistsOnDisk("cold"))
+
+		commit, err := migrator.DeleteTenants(context.Background(), class, []string{"cold"})
+		require.Nil(t, err)
+		commit(true)
+
+		// shards are dropped in the background
+		assert.Eventually(t, func() bool {
+			return !index.shardExistsOnDisk("cold")
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("deleting a frozen tenant removes its offloaded files", func(t *testing.T) {
+		setStatus(t, "hot", models.TenantActivityStatusFROZEN)
+		offloadPath := backend.HomeDir(index.offloadID("hot"))
+		require.DirExists(t, offloadPath)
+
+		commit, err := migrator.DeleteTenants(context.Background(), class, []string{"hot"})
+		require.Nil(t, err)
+		commit(true)
+		assert.NoDirExists(t, offloadPath)
+	})
+}
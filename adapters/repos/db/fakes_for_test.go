@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -59,8 +61,14 @@ func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	return ss.Physical[shard].BelongsToNodes[0], nil
 }
 
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string {
-	return tenant
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	if f.shardState == nil {
+		return tenant, models.TenantActivityStatusHOT
+	}
+	if p, ok := f.shardState.Physical[tenant]; ok {
+		return tenant, p.ActivityStatus()
+	}
+	return tenant, models.TenantActivityStatusHOT
 }
 
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
@@ -187,7 +195,7 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int,
+	shardName string, vector []float32, targetVector string, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -313,6 +321,18 @@ func (*fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (*fakeReplicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int,
+) ([]uint64, error) {
+	return nil, nil
+}
+
+func (*fakeReplicationClient) DigestObjectsInRange(ctx context.Context,
+	host, index, shard string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (*fakeReplicationClient) FetchObjects(ctx context.Context, host,
 	index, shard string, ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
@@ -324,3 +344,80 @@ func (*fakeReplicationClient) OverwriteObjects(ctx context.Context,
 ) ([]replica.RepairResponse, error) {
 	return nil, nil
 }
+
+// fakeOffloadBackend stores offloaded files in a local folder
+type fakeOffloadBackend struct {
+	dataPath    string
+	backupsPath string
+	// putErr is returned by PutFile if set
+	putErr error
+	// writeErr is returned by WriteToFile once writeOK files were written
+	writeErr error
+	writeOK  int
+}
+
+func (f *fakeOffloadBackend) IsExternal() bool { return false }
+func (f *fakeOffloadBackend) Name() string     { return "fake-offload" }
+
+func (f *fakeOffloadBackend) HomeDir(backupID string) string {
+	return path.Join(f.backupsPath, backupID)
+}
+
+func (f *fakeOffloadBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	return os.ReadFile(path.Join(f.HomeDir(backupID), key))
+}
+
+func (f *fakeOffloadBackend) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
+	if f.writeErr != nil {
+		if f.writeOK <= 0 {
+			return f.writeErr
+		}
+		f.writeOK--
+	}
+	data, err := os.ReadFile(path.Join(f.HomeDir(backupID), key))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, os.ModePerm)
+}
+
+func (f *fakeOffloadBackend) SourceDataPath() string { return f.dataPath }
+
+func (f *fakeOffloadBackend) PutFile(ctx context.Context, backupID, key, srcPath string) error {
+	if f.putErr != nil {
+		return f.putErr
+	}
+	data, err := os.ReadFile(path.Join(f.dataPath, srcPath))
+	if err != nil {
+		return err
+	}
+	return f.PutObject(ctx, backupID, key, data)
+}
+
+func (f *fakeOffloadBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	dest := path.Join(f.HomeDir(backupID), key)
+	if err := os.MkdirAll(path.Dir(dest), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, os.ModePerm)
+}
+
+func (f *fakeOffloadBackend) AllBackups(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(f.backupsPath)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Name())
+	}
+	return ids, nil
+}
+
+func (f *fakeOffloadBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	return os.RemoveAll(f.HomeDir(backupID))
+}
+
+func (f *fakeOffloadBackend) Initialize(ctx context.Context, backupID string) error {
+	return nil
+}
@@ -22,6 +22,8 @@ var (
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
 	DimensionsBucketLSM        = "dimensions"
+	VectorsBucketLSM           = "vectors"
+	ChangesBucketLSM           = "changes"
 	DocIDBucket                = []byte("doc_ids")
 )
 
@@ -31,6 +33,16 @@ func BucketFromPropName(propName string) []byte {
 	return []byte(fmt.Sprintf("property_%s", propName))
 }
 
+// VectorsBucketFromTargetVector creates the name of the bucket which stores
+// the vectors of a flat index for the given target vector. The class-level
+// vector uses VectorsBucketLSM.
+func VectorsBucketFromTargetVector(targetVector string) string {
+	if targetVector == "" {
+		return VectorsBucketLSM
+	}
+	return fmt.Sprintf("%s_%s", VectorsBucketLSM, targetVector)
+}
+
 // MetaCountProp helps create an internally used propName for meta props that
 // don't explicitly exist in the user schema, but are required for proper
 // indexing, such as the count of arrays.
@@ -71,3 +83,7 @@ func TempBucketFromBucketName(bucketName string) string {
 func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
+
+func BucketPositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_positions")
+}
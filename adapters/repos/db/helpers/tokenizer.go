@@ -23,8 +23,17 @@ var Tokenizations []string = []string{
 	models.PropertyTokenizationLowercase,
 	models.PropertyTokenizationWhitespace,
 	models.PropertyTokenizationField,
+	models.PropertyTokenizationNgram,
+	models.PropertyTokenizationCjk,
 }
 
+// default gram lengths of the ngram tokenization, unless configured
+// otherwise in the analyzer of the property
+const (
+	DefaultNGramMin = 3
+	DefaultNGramMax = 3
+)
+
 func Tokenize(tokenization string, in string) []string {
 	switch tokenization {
 	case models.PropertyTokenizationWord:
@@ -35,6 +44,10 @@ func Tokenize(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationNgram:
+		return TokenizeNGrams(in, DefaultNGramMin, DefaultNGramMax)
+	case models.PropertyTokenizationCjk:
+		return tokenizeCJK(in)
 	default:
 		return []string{}
 	}
@@ -50,6 +63,10 @@ func TokenizeWithWildcards(tokenization string, in string) []string {
 		return tokenizeWhitespace(in)
 	case models.PropertyTokenizationField:
 		return tokenizeField(in)
+	case models.PropertyTokenizationNgram:
+		return TokenizeNGramsWithWildcards(in, DefaultNGramMin, DefaultNGramMax)
+	case models.PropertyTokenizationCjk:
+		return tokenizeCJKWithWildcards(in)
 	default:
 		return []string{}
 	}
@@ -91,6 +108,159 @@ func tokenizeWordWithWildcards(in string) []string {
 	return lowercase(terms)
 }
 
+// TokenizeNGrams splits on any non-alphanumerical, lowercases the words and
+// emits all character n-grams of each word with min <= n <= max. Words
+// shorter than min are kept as they are, so they remain searchable.
+func TokenizeNGrams(in string, min, max int) []string {
+	var terms []string
+	for _, word := range tokenizeWord(in) {
+		terms = append(terms, nGrams([]rune(word), min, max)...)
+	}
+	return terms
+}
+
+// TokenizeNGramsWithWildcards tokenizes a like pattern for properties with
+// ngram tokenization. Wildcards at the edges of a word are removed and the
+// word is split into grams of the max length, so that a pattern like *abcd*
+// matches all objects containing each of its grams. Words which are too short
+// for a gram or contain wildcards within keep their wildcards and are matched
+// against the grams themselves.
+func TokenizeNGramsWithWildcards(in string, min, max int) []string {
+	var terms []string
+	for _, word := range tokenizeWordWithWildcards(in) {
+		trimmed := []rune(strings.Trim(word, "*?"))
+		if len(trimmed) < min || strings.ContainsAny(string(trimmed), "*?") {
+			terms = append(terms, word)
+			continue
+		}
+		n := max
+		if len(trimmed) < n {
+			n = len(trimmed)
+		}
+		terms = append(terms, nGrams(trimmed, n, n)...)
+	}
+	return terms
+}
+
+func nGrams(word []rune, min, max int) []string {
+	if len(word) < min {
+		return []string{string(word)}
+	}
+
+	var grams []string
+	for n := min; n <= max && n <= len(word); n++ {
+		for i := 0; i+n <= len(word); i++ {
+			grams = append(grams, string(word[i:i+n]))
+		}
+	}
+	return grams
+}
+
+// tokenizeCJK emits overlapping bigrams for runs of Chinese, Japanese and
+// Korean characters, which are not separated by white spaces, e.g. 東京都 is
+// split into 東京 and 京都. A single CJK character is kept as a unigram. Any
+// other text is tokenized the same way as with word tokenization.
+func tokenizeCJK(in string) []string {
+	var terms []string
+	var cjk, word []rune
+
+	flushCJK := func() {
+		terms = append(terms, cjkBigrams(cjk)...)
+		cjk = cjk[:0]
+	}
+	flushWord := func() {
+		if len(word) > 0 {
+			terms = append(terms, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	for _, r := range in {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushCJK()
+			flushWord()
+		}
+	}
+	flushCJK()
+	flushWord()
+
+	return terms
+}
+
+// tokenizeCJKWithWildcards tokenizes a like pattern for properties with cjk
+// tokenization. CJK characters are split into bigrams the same way as at
+// index time, so a pattern like *東京都* matches all objects containing each
+// of its bigrams. A single CJK character matches all bigrams containing it.
+// Wildcards are only kept within words of other scripts.
+func tokenizeCJKWithWildcards(in string) []string {
+	var terms []string
+	var cjk, word []rune
+
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			terms = append(terms, "*"+string(cjk)+"*")
+		} else {
+			terms = append(terms, cjkBigrams(cjk)...)
+		}
+		cjk = cjk[:0]
+	}
+	flushWord := func() {
+		if len(strings.Trim(string(word), "*?")) > 0 {
+			terms = append(terms, strings.ToLower(string(word)))
+		}
+		word = word[:0]
+	}
+
+	for _, r := range in {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || r == '*' || r == '?':
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushCJK()
+			flushWord()
+		}
+	}
+	flushCJK()
+	flushWord()
+
+	return terms
+}
+
+func cjkBigrams(run []rune) []string {
+	switch len(run) {
+	case 0:
+		return nil
+	case 1:
+		return []string{string(run)}
+	}
+
+	bigrams := make([]string, 0, len(run)-1)
+	for i := 0; i+2 <= len(run); i++ {
+		bigrams = append(bigrams, string(run[i:i+2]))
+	}
+	return bigrams
+}
+
+func isCJK(r rune) bool {
+	// the prolonged sound mark is used within katakana words, but belongs to
+	// the common script
+	if r == 'ー' || r == 'ｰ' {
+		return true
+	}
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
 func lowercase(terms []string) []string {
 	for i := range terms {
 		terms[i] = strings.ToLower(terms[i])
@@ -44,6 +44,17 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you", "beautiful", "world"},
 			},
+			{
+				tokenization: models.PropertyTokenizationNgram,
+				expected: []string{
+					"hel", "ell", "llo", "you", "bea", "eau", "aut", "uti", "tif",
+					"ifu", "ful", "wor", "orl", "rld",
+				},
+			},
+			{
+				tokenization: models.PropertyTokenizationCjk,
+				expected:     []string{"hello", "you", "beautiful", "world"},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -70,6 +81,17 @@ func TestTokenize(t *testing.T) {
 				tokenization: models.PropertyTokenizationWord,
 				expected:     []string{"hello", "you*", "beautiful", "world?"},
 			},
+			{
+				tokenization: models.PropertyTokenizationNgram,
+				expected: []string{
+					"hel", "ell", "llo", "you", "bea", "eau", "aut", "uti", "tif",
+					"ifu", "ful", "wor", "orl", "rld",
+				},
+			},
+			{
+				tokenization: models.PropertyTokenizationCjk,
+				expected:     []string{"hello", "you*", "beautiful", "world?"},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -141,3 +163,120 @@ func TestTokenizeAndCountDuplicates(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenizeNGrams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		min, max int
+		expected []string
+	}{
+		{
+			name:     "trigrams",
+			input:    "Weaviate",
+			min:      3,
+			max:      3,
+			expected: []string{"wea", "eav", "avi", "via", "iat", "ate"},
+		},
+		{
+			name:     "range of lengths",
+			input:    "abcd",
+			min:      2,
+			max:      3,
+			expected: []string{"ab", "bc", "cd", "abc", "bcd"},
+		},
+		{
+			name:     "words shorter than min are kept",
+			input:    "a cat",
+			min:      2,
+			max:      2,
+			expected: []string{"a", "ca", "at"},
+		},
+		{
+			name:     "max longer than word",
+			input:    "cat",
+			min:      2,
+			max:      5,
+			expected: []string{"ca", "at", "cat"},
+		},
+		{
+			name:     "multi byte characters",
+			input:    "Größe",
+			min:      3,
+			max:      3,
+			expected: []string{"grö", "röß", "öße"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.expected, TokenizeNGrams(tc.input, tc.min, tc.max))
+		})
+	}
+
+	t.Run("with wildcards", func(t *testing.T) {
+		testCases := []struct {
+			input    string
+			expected []string
+		}{
+			{input: "*viat*", expected: []string{"via", "iat"}},
+			{input: "weav*", expected: []string{"wea", "eav"}},
+			{input: "*via*", expected: []string{"via"}},
+			{input: "*vi*", expected: []string{"*vi*"}},
+			{input: "we*te", expected: []string{"we*te"}},
+		}
+
+		for _, tc := range testCases {
+			assert.ElementsMatch(t, tc.expected, TokenizeNGramsWithWildcards(tc.input, 3, 3), tc.input)
+		}
+	})
+}
+
+func TestTokenizeCJK(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "chinese",
+			input:    "我爱北京",
+			expected: []string{"我爱", "爱北", "北京"},
+		},
+		{
+			name:     "japanese with prolonged sound mark",
+			input:    "東京都のラーメン",
+			expected: []string{"東京", "京都", "都の", "のラ", "ラー", "ーメ", "メン"},
+		},
+		{
+			name:     "korean separated by spaces",
+			input:    "안녕하세요 세계",
+			expected: []string{"안녕", "녕하", "하세", "세요", "세계"},
+		},
+		{
+			name:     "single character is kept",
+			input:    "猫。犬",
+			expected: []string{"猫", "犬"},
+		},
+		{
+			name:     "mixed with latin",
+			input:    "Weaviateは東京にある",
+			expected: []string{"weaviate", "は東", "東京", "京に", "にあ", "ある"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Tokenize(models.PropertyTokenizationCjk, tc.input))
+		})
+	}
+
+	t.Run("with wildcards", func(t *testing.T) {
+		assert.Equal(t, []string{"東京", "京都"},
+			TokenizeWithWildcards(models.PropertyTokenizationCjk, "*東京都*"))
+		assert.Equal(t, []string{"wea*", "東京"},
+			TokenizeWithWildcards(models.PropertyTokenizationCjk, "Wea* 東京?"))
+		assert.Equal(t, []string{"*猫*"},
+			TokenizeWithWildcards(models.PropertyTokenizationCjk, "猫"))
+	})
+}
@@ -27,12 +27,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/aggregator"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/autocut"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -50,8 +52,9 @@ import (
 )
 
 var (
-	errTenantNotFound = errors.New("tenant not found")
-	_NUMCPU           = runtime.NumCPU()
+	errTenantNotFound  = errors.New("tenant not found")
+	errTenantNotActive = errors.New("tenant not active")
+	_NUMCPU            = runtime.NumCPU()
 )
 
 // shardMap is a syn.Map which specialized in storing shards
@@ -114,11 +117,15 @@ type Index struct {
 	shards                shardMap
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
-	getSchema             schemaUC.SchemaGetter
-	logger                logrus.FieldLogger
-	remote                *sharding.RemoteIndex
-	stopwords             *stopwords.Detector
-	replicator            *replica.Replicator
+	// vectorIndexUserConfigs holds the configs of the named vectors, keyed
+	// by the name of the target vector
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig
+	getSchema              schemaUC.SchemaGetter
+	logger                 logrus.FieldLogger
+	remote                 *sharding.RemoteIndex
+	stopwords              *stopwords.Detector
+	analyzers              analysis.Chains
+	replicator             *replica.Replicator
 
 	backupState     BackupState
 	backupStateLock sync.RWMutex
@@ -144,6 +151,18 @@ type Index struct {
 	centralJobQueue chan job
 
 	partitioningEnabled bool
+
+	// objectTTLCycle periodically deletes objects which have outlived the
+	// TTL configured on the class
+	objectTTLCycle cyclemanager.CycleManager
+
+	// antiEntropyCycle periodically repairs the other replicas of the local
+	// shards, it is nil if anti-entropy is disabled
+	antiEntropyCycle cyclemanager.CycleManager
+
+	// changes assigns the sequence numbers of the change stream, it is nil if
+	// the change stream is disabled
+	changes *changeLog
 }
 
 func (i *Index) ID() string {
@@ -158,7 +177,8 @@ type nodeResolver interface {
 // the shards that are local to a node
 func NewIndex(ctx context.Context, config IndexConfig,
 	shardState *sharding.State, invertedIndexConfig schema.InvertedIndexConfig,
-	vectorIndexUserConfig schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
+	vectorIndexUserConfig schema.VectorIndexConfig,
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
 	cs inverted.ClassSearcher, logger logrus.FieldLogger,
 	nodeResolver nodeResolver, remoteClient sharding.RemoteIndexClient,
 	replicaClient replica.Client,
@@ -169,18 +189,25 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		return nil, errors.Wrap(err, "failed to create new index")
 	}
 
+	analyzers, err := analysis.NewChains(class)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create new index")
+	}
+
 	repl := replica.NewReplicator(config.ClassName.String(),
 		sg, nodeResolver, replicaClient, logger)
 
 	index := &Index{
-		Config:                config,
-		getSchema:             sg,
-		logger:                logger,
-		classSearcher:         cs,
-		vectorIndexUserConfig: vectorIndexUserConfig,
-		invertedIndexConfig:   invertedIndexConfig,
-		stopwords:             sd,
-		replicator:            repl,
+		Config:                 config,
+		getSchema:              sg,
+		logger:                 logger,
+		classSearcher:          cs,
+		vectorIndexUserConfig:  vectorIndexUserConfig,
+		vectorIndexUserConfigs: vectorIndexUserConfigs,
+		invertedIndexConfig:    invertedIndexConfig,
+		stopwords:              sd,
+		analyzers:              analyzers,
+		replicator:             repl,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
 			nodeResolver, remoteClient),
 		metrics:             NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
@@ -188,6 +215,12 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		partitioningEnabled: shardState.PartitioningEnabled,
 	}
 
+	index.changes, err = newChangeLog(config.ChangeStream,
+		fmt.Sprintf("%s/%s.changes", config.RootPath, index.ID()))
+	if err != nil {
+		return nil, errors.Wrap(err, "init change log")
+	}
+
 	if err := index.checkSingleShardMigration(shardState); err != nil {
 		return nil, errors.Wrap(err, "migrating sharding state from previous version")
 	}
@@ -199,6 +232,11 @@ func NewIndex(ctx context.Context, config IndexConfig,
 			continue
 		}
 
+		if shardState.Physical[shardName].ActivityStatus() != models.TenantActivityStatusHOT {
+			// inactive tenants are only loaded once activated
+			continue
+		}
+
 		shard, err := NewShard(ctx, promMetrics, shardName, index, class, jobQueueCh)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %s of index %s", shardName, index.ID())
@@ -207,6 +245,9 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		index.shards.Store(shardName, shard)
 	}
 
+	index.initObjectTTLCycle()
+	index.initAntiEntropyCycle()
+
 	return index, nil
 }
 
@@ -290,6 +331,17 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (i *Index) updateVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.updateVectorIndexConfigs(ctx, updated); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+		return nil
+	})
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -318,6 +370,9 @@ type IndexConfig struct {
 	MemtablesMaxSizeMB        int
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
+	ObjectsCompression        string
+	ChangeStream              config.ChangeStream
+	AntiEntropy               config.AntiEntropy
 	ReplicationFactor         int64
 
 	TrackVectorDimensions bool
@@ -330,10 +385,14 @@ func indexID(class schema.ClassName) string {
 func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, error) {
 	className := i.Config.ClassName.String()
 	if tenant != "" {
-		if shard := i.getSchema.TenantShard(className, tenant); shard != "" {
-			return shard, nil
+		shard, status := i.getSchema.TenantShard(className, tenant)
+		if shard == "" {
+			return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
+		}
+		if status != models.TenantActivityStatusHOT {
+			return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotActive, tenant))
 		}
-		return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
+		return shard, nil
 	}
 
 	uuid, err := uuid.Parse(id.String())
@@ -1035,7 +1094,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
+					ctx, shardName, nil, "", limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
@@ -1120,13 +1179,13 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 }
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 	shardName string,
 ) ([]*storobj.Object, []float32, error) {
 	shard := i.shards.Load(shardName)
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1142,7 +1201,11 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 		return shardingState.AllPhysicalShards(), nil
 	}
 	if tenant != "" {
-		if shard := i.getSchema.TenantShard(className, tenant); shard != "" {
+		shard, status := i.getSchema.TenantShard(className, tenant)
+		if shard != "" {
+			if status != models.TenantActivityStatusHOT {
+				return nil, objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotActive, tenant))
+			}
 			return []string{shard}, nil
 		}
 	}
@@ -1150,7 +1213,7 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
@@ -1164,7 +1227,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 	if len(shardNames) == 1 {
 		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
+			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, targetVector, dist, limit, filters,
 				sort, groupBy, additional, shardNames[0])
 		}
 	}
@@ -1193,7 +1256,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 			if shard := i.localShard(shardName); shard != nil {
 				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+					ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
@@ -1202,7 +1265,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, limit, filters,
+					shardName, searchVector, targetVector, limit, filters,
 					nil, sort, nil, groupBy, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1256,7 +1319,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
@@ -1276,7 +1339,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, distance, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1442,6 +1505,13 @@ func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 }
 
 func (i *Index) drop() error {
+	if err := i.stopObjectTTLCycle(context.Background()); err != nil {
+		return errors.Wrap(err, "stop object ttl cycle")
+	}
+	if err := i.stopAntiEntropyCycle(context.Background()); err != nil {
+		return errors.Wrap(err, "stop anti-entropy cycle")
+	}
+
 	var eg errgroup.Group
 	eg.SetLimit(_NUMCPU * 2)
 	fields := logrus.Fields{"action": "drop_shard", "class": i.Config.ClassName}
@@ -1462,7 +1532,10 @@ func (i *Index) drop() error {
 	defer i.backupStateLock.RUnlock()
 
 	i.shards.Range(dropShard)
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return i.changes.drop()
 }
 
 // dropShards deletes shards in a transactional manner.
@@ -1520,6 +1593,13 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 }
 
 func (i *Index) Shutdown(ctx context.Context) error {
+	if err := i.stopObjectTTLCycle(ctx); err != nil {
+		return errors.Wrap(err, "stop object ttl cycle")
+	}
+	if err := i.stopAntiEntropyCycle(ctx); err != nil {
+		return errors.Wrap(err, "stop anti-entropy cycle")
+	}
+
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
 	return i.ForEachShard(func(name string, shard *Shard) error {
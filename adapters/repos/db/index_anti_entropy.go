@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func (i *Index) initAntiEntropyCycle() {
+	if !i.Config.AntiEntropy.Enabled {
+		return
+	}
+
+	i.antiEntropyCycle = cyclemanager.NewMulti(
+		cyclemanager.NewFixedIntervalTicker(i.Config.AntiEntropy.Interval))
+	i.antiEntropyCycle.Register(i.repairReplicas)
+	i.antiEntropyCycle.Start()
+}
+
+func (i *Index) stopAntiEntropyCycle(ctx context.Context) error {
+	if i.antiEntropyCycle == nil {
+		return nil
+	}
+	return i.antiEntropyCycle.StopAndWait(ctx)
+}
+
+// repairReplicas runs the anti-entropy process for all local shards. Every
+// node pushes its own more recent objects, so replicas converge once all
+// nodes have run the process.
+func (i *Index) repairReplicas(shouldBreak cyclemanager.ShouldBreakFunc) bool {
+	if !i.replicationEnabled() {
+		return false
+	}
+
+	ctx := context.Background()
+	repaired := false
+
+	i.ForEachShard(func(name string, shard *Shard) error {
+		if shard == nil || shouldBreak() {
+			return nil
+		}
+
+		start := time.Now()
+		stats, err := i.replicator.RepairShard(ctx, name, &localReplica{index: i, shard: shard})
+		i.metrics.AntiEntropy(name, start, stats)
+		if err != nil {
+			i.logger.WithField("action", "anti_entropy").
+				WithField("shard", name).
+				WithError(err).
+				Warn("failed to repair replicas")
+		}
+		if stats.Repaired > 0 || stats.Conflicts > 0 {
+			repaired = true
+			i.logger.WithField("action", "anti_entropy").
+				WithField("shard", name).
+				WithField("ranges", stats.Ranges).
+				WithField("repaired", stats.Repaired).
+				WithField("conflicts", stats.Conflicts).
+				Debug("repaired replicas")
+		}
+		return nil
+	})
+
+	return repaired
+}
+
+// localReplica gives the anti-entropy process access to a local shard
+type localReplica struct {
+	index *Index
+	shard *Shard
+}
+
+func (r *localReplica) HashTreeLevel(ctx context.Context, level int) ([]uint64, error) {
+	return r.shard.hashTreeLevel(ctx, level)
+}
+
+func (r *localReplica) DigestObjectsInRange(ctx context.Context,
+	initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	return r.shard.digestObjectsInRange(ctx, initialUUID, finalUUID, limit)
+}
+
+func (r *localReplica) FetchObjects(ctx context.Context,
+	ids []strfmt.UUID,
+) ([]objects.Replica, error) {
+	return r.index.fetchObjects(ctx, r.shard.name, ids)
+}
+
+// hashTreeLevel computes one level of the hash tree over the objects of the
+// shard with a single scan of the objects bucket
+func (s *Shard) hashTreeLevel(ctx context.Context, level int) ([]uint64, error) {
+	tree, err := replica.NewHashTree(level)
+	if err != nil {
+		return nil, err
+	}
+
+	c := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer c.Close()
+
+	n := 0
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if n++; n%1000 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		updateTime, err := storobj.UpdateTimeFromBinary(v)
+		if err != nil {
+			return nil, fmt.Errorf("object %x: %w", k, err)
+		}
+		tree.Add(k, updateTime)
+	}
+
+	return tree.Digests(), nil
+}
+
+// digestObjectsInRange returns the digests of up to limit objects whose uuids
+// lie between initialUUID and finalUUID, both inclusive, ordered by uuid
+func (s *Shard) digestObjectsInRange(ctx context.Context,
+	initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	initial, err := uuid.Parse(initialUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid initial uuid: %w", err)
+	}
+	final, err := uuid.Parse(finalUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid final uuid: %w", err)
+	}
+
+	c := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer c.Close()
+
+	var result []replica.RepairResponse
+	for k, v := c.Seek(initial[:]); k != nil && len(result) < limit; k, v = c.Next() {
+		if bytes.Compare(k, final[:]) > 0 {
+			break
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		id, err := uuid.FromBytes(k)
+		if err != nil {
+			return nil, fmt.Errorf("object key %x: %w", k, err)
+		}
+		updateTime, err := storobj.UpdateTimeFromBinary(v)
+		if err != nil {
+			return nil, fmt.Errorf("object %s: %w", id, err)
+		}
+		result = append(result, replica.RepairResponse{
+			ID:         id.String(),
+			UpdateTime: updateTime,
+		})
+	}
+
+	return result, nil
+}
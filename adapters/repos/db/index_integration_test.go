@@ -106,7 +106,7 @@ func TestIndex_DropWithDataAndRecreateWithDataIndex(t *testing.T) {
 		RootPath:  dirName,
 		ClassName: schema.ClassName(class.Class),
 	}, shardState, inverted.ConfigFromModel(class.InvertedIndexConfig),
-		hnsw.NewDefaultUserConfig(), &fakeSchemaGetter{
+		hnsw.NewDefaultUserConfig(), nil, &fakeSchemaGetter{
 			schema: fakeSchema, shardState: shardState,
 		}, nil, logger, nil, nil, nil, nil, class, nil)
 	require.Nil(t, err)
@@ -139,7 +139,7 @@ func TestIndex_DropWithDataAndRecreateWithDataIndex(t *testing.T) {
 		}
 
 		err := index.putObject(context.TODO(), storobj.FromObject(
-			&product, []float32{0.1, 0.2, 0.01, 0.2}), nil)
+			&product, []float32{0.1, 0.2, 0.01, 0.2}, nil), nil)
 		require.Nil(t, err)
 	}
 
@@ -166,7 +166,7 @@ func TestIndex_DropWithDataAndRecreateWithDataIndex(t *testing.T) {
 		RootPath:  dirName,
 		ClassName: schema.ClassName(class.Class),
 	}, shardState, inverted.ConfigFromModel(class.InvertedIndexConfig),
-		hnsw.NewDefaultUserConfig(), &fakeSchemaGetter{
+		hnsw.NewDefaultUserConfig(), nil, &fakeSchemaGetter{
 			schema:     fakeSchema,
 			shardState: shardState,
 		}, nil, logger, nil, nil, nil, nil, class, nil)
@@ -201,7 +201,7 @@ func TestIndex_DropWithDataAndRecreateWithDataIndex(t *testing.T) {
 		}
 
 		err := index.putObject(context.TODO(), storobj.FromObject(
-			&thing, []float32{0.1, 0.2, 0.01, 0.2}), nil)
+			&thing, []float32{0.1, 0.2, 0.01, 0.2}, nil), nil)
 		require.Nil(t, err)
 	}
 
@@ -265,7 +265,7 @@ func TestIndex_DropReadOnlyIndexWithData(t *testing.T) {
 		RootPath:  dirName,
 		ClassName: schema.ClassName(class.Class),
 	}, shardState, inverted.ConfigFromModel(class.InvertedIndexConfig),
-		hnsw.NewDefaultUserConfig(), &fakeSchemaGetter{
+		hnsw.NewDefaultUserConfig(), nil, &fakeSchemaGetter{
 			schema: fakeSchema, shardState: shardState,
 		}, nil, logger, nil, nil, nil, nil, class, nil)
 	require.Nil(t, err)
@@ -298,7 +298,7 @@ func TestIndex_DropReadOnlyIndexWithData(t *testing.T) {
 		}
 
 		err := index.putObject(ctx, storobj.FromObject(
-			&product, []float32{0.1, 0.2, 0.01, 0.2}), nil)
+			&product, []float32{0.1, 0.2, 0.01, 0.2}, nil), nil)
 		require.Nil(t, err)
 	}
 
@@ -320,7 +320,7 @@ func emptyIdx(t *testing.T, rootDir string, class *models.Class) *Index {
 	idx, err := NewIndex(testCtx(), IndexConfig{
 		RootPath: rootDir, ClassName: schema.ClassName(class.Class),
 	}, shardState, inverted.ConfigFromModel(invertedConfig()),
-		hnsw.NewDefaultUserConfig(), &fakeSchemaGetter{
+		hnsw.NewDefaultUserConfig(), nil, &fakeSchemaGetter{
 			shardState: shardState,
 		}, nil, logger, nil, nil, nil, nil, class, nil)
 	require.Nil(t, err)
@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// objectTTLBatchSize limits how many expired objects are deleted at once, so
+// that the backup lock is not held for too long
+const objectTTLBatchSize = 1000
+
+// objectExpiry describes which objects of a class have outlived their TTL at
+// a given point in time. A nil *objectExpiry means objects never expire.
+type objectExpiry struct {
+	deleteOn string
+	cutoff   time.Time
+}
+
+func newObjectExpiry(class *models.Class, now time.Time) *objectExpiry {
+	if class == nil || class.ObjectTTLConfig == nil || !class.ObjectTTLConfig.Enabled {
+		return nil
+	}
+
+	cfg := class.ObjectTTLConfig
+	deleteOn := cfg.DeleteOn
+	if deleteOn == "" {
+		deleteOn = filters.InternalPropCreationTimeUnix
+	}
+
+	return &objectExpiry{
+		deleteOn: deleteOn,
+		cutoff:   now.Add(-time.Duration(cfg.DefaultTTL) * time.Second),
+	}
+}
+
+func (e *objectExpiry) expired(obj *storobj.Object) bool {
+	if e == nil || obj == nil {
+		return false
+	}
+
+	if e.deleteOn == filters.InternalPropCreationTimeUnix {
+		return obj.CreationTimeUnix() < e.cutoff.UnixMilli()
+	}
+
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	switch v := props[e.deleteOn].(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return false
+		}
+		return t.Before(e.cutoff)
+	case time.Time:
+		return v.Before(e.cutoff)
+	default:
+		// objects without a value for the property never expire
+		return false
+	}
+}
+
+// removeExpired drops expired objects from search results. dists is either
+// nil or has the same length as objs and is filtered alongside.
+func (e *objectExpiry) removeExpired(objs []*storobj.Object,
+	dists []float32,
+) ([]*storobj.Object, []float32) {
+	if e == nil {
+		return objs, dists
+	}
+
+	n := 0
+	for j, obj := range objs {
+		if e.expired(obj) {
+			continue
+		}
+		objs[n] = obj
+		if dists != nil {
+			dists[n] = dists[j]
+		}
+		n++
+	}
+
+	if dists != nil {
+		dists = dists[:n]
+	}
+	return objs[:n], dists
+}
+
+// searchUnexpired runs search until it returns limit objects which have not
+// expired yet, or until there are no more results. Expired objects would
+// otherwise take up the place of valid results, so each retry doubles the
+// limit passed to search. The results are cut to limit afterwards. A limit
+// of 0 or below means search is not limited, it only runs once.
+func (e *objectExpiry) searchUnexpired(limit int,
+	search func(limit int) ([]*storobj.Object, []float32, error),
+) ([]*storobj.Object, []float32, error) {
+	if e == nil {
+		return search(limit)
+	}
+
+	fetch := limit
+	for {
+		objs, dists, err := search(fetch)
+		if err != nil {
+			return nil, nil, err
+		}
+		found := len(objs)
+		objs, dists = e.removeExpired(objs, dists)
+		if limit <= 0 || found < fetch {
+			return objs, dists, nil
+		}
+		if len(objs) >= limit {
+			if dists != nil {
+				dists = dists[:limit]
+			}
+			return objs[:limit], dists, nil
+		}
+		fetch *= 2
+	}
+}
+
+// filter matches all expired objects through the inverted index
+func (e *objectExpiry) filter(className schema.ClassName) *filters.LocalFilter {
+	value := &filters.Value{
+		Value: e.cutoff.Format(time.RFC3339Nano),
+		Type:  schema.DataTypeDate,
+	}
+	if e.deleteOn == filters.InternalPropCreationTimeUnix {
+		// timestamps are indexed in ms, which is more precise than a date
+		value = &filters.Value{
+			Value: strconv.FormatInt(e.cutoff.UnixMilli(), 10),
+			Type:  schema.DataTypeText,
+		}
+	}
+
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On: &filters.Path{
+				Class:    className,
+				Property: schema.PropertyName(e.deleteOn),
+			},
+			Value: value,
+		},
+	}
+}
+
+// expiredDocIDs returns the doc ids of all objects in the shard which have
+// outlived the TTL of the class, or nil if objects of the class never expire
+func (s *Shard) expiredDocIDs(ctx context.Context) (helpers.AllowList, error) {
+	expiry := s.index.objectExpiry()
+	if expiry == nil {
+		return nil, nil
+	}
+
+	ids, err := s.findDocIDs(ctx, expiry.filter(s.index.Config.ClassName))
+	if err != nil {
+		return nil, errors.Wrap(err, "find expired objects")
+	}
+	return helpers.NewAllowList(ids...), nil
+}
+
+func (i *Index) objectExpiry() *objectExpiry {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	return newObjectExpiry(sch.GetClass(i.Config.ClassName), time.Now())
+}
+
+func (i *Index) initObjectTTLCycle() {
+	i.objectTTLCycle = cyclemanager.NewMulti(cyclemanager.ObjectTTLCycleTicker())
+	i.objectTTLCycle.Register(i.deleteExpiredObjects)
+	i.objectTTLCycle.Start()
+}
+
+func (i *Index) stopObjectTTLCycle(ctx context.Context) error {
+	if i.objectTTLCycle == nil {
+		return nil
+	}
+	return i.objectTTLCycle.StopAndWait(ctx)
+}
+
+// deleteExpiredObjects removes all objects which have outlived the TTL of the
+// class from the local shards. Every replica expires its own copy, so there is
+// no need to coordinate with other nodes.
+func (i *Index) deleteExpiredObjects(shouldBreak cyclemanager.ShouldBreakFunc) bool {
+	expiry := i.objectExpiry()
+	if expiry == nil {
+		return false
+	}
+
+	ctx := context.Background()
+	filter := expiry.filter(i.Config.ClassName)
+	deleted := false
+
+	i.ForEachShard(func(name string, shard *Shard) error {
+		if shard == nil || shard.isReadOnly() || shouldBreak() {
+			return nil
+		}
+
+		count, err := i.deleteExpiredObjectsInShard(ctx, shard, filter, shouldBreak)
+		if err != nil {
+			i.logger.WithField("action", "delete_expired_objects").
+				WithField("shard", name).
+				WithError(err).
+				Warn("failed to delete expired objects")
+		}
+		if count > 0 {
+			deleted = true
+			i.logger.WithField("action", "delete_expired_objects").
+				WithField("shard", name).
+				Debugf("deleted %d expired objects", count)
+		}
+		return nil
+	})
+
+	return deleted
+}
+
+func (i *Index) deleteExpiredObjectsInShard(ctx context.Context, shard *Shard,
+	filter *filters.LocalFilter, shouldBreak cyclemanager.ShouldBreakFunc,
+) (int, error) {
+	docIDs, err := shard.findDocIDs(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	var firstErr error
+	count := 0
+	for start := 0; start < len(docIDs); start += objectTTLBatchSize {
+		if shouldBreak() {
+			break
+		}
+
+		end := start + objectTTLBatchSize
+		if end > len(docIDs) {
+			end = len(docIDs)
+		}
+
+		res := i.deleteObjectBatchLocked(ctx, shard, docIDs[start:end])
+		for _, obj := range res {
+			if obj.Err != nil {
+				if firstErr == nil {
+					firstErr = obj.Err
+				}
+				continue
+			}
+			count++
+		}
+	}
+
+	return count, firstErr
+}
+
+func (i *Index) deleteObjectBatchLocked(ctx context.Context, shard *Shard,
+	docIDs []uint64,
+) objects.BatchSimpleObjects {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+	return shard.deleteObjectBatch(ctx, docIDs, false)
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func Test_ObjectExpiry(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	objAt := func(created time.Time, props map[string]interface{}) *storobj.Object {
+		return storobj.FromObject(&models.Object{
+			Class:              "Article",
+			CreationTimeUnix:   created.UnixMilli(),
+			LastUpdateTimeUnix: created.UnixMilli(),
+			Properties:         props,
+		}, nil, nil)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, newObjectExpiry(nil, now))
+		assert.Nil(t, newObjectExpiry(&models.Class{}, now))
+		assert.Nil(t, newObjectExpiry(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{DefaultTTL: 60},
+		}, now))
+
+		var expiry *objectExpiry
+		assert.False(t, expiry.expired(objAt(now.Add(-time.Hour), nil)))
+	})
+
+	t.Run("by creation time", func(t *testing.T) {
+		expiry := newObjectExpiry(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60},
+		}, now)
+		require.NotNil(t, expiry)
+
+		assert.True(t, expiry.expired(objAt(now.Add(-61*time.Second), nil)))
+		assert.False(t, expiry.expired(objAt(now.Add(-59*time.Second), nil)))
+
+		filter := expiry.filter("Article")
+		assert.Equal(t, filters.OperatorLessThan, filter.Root.Operator)
+		assert.Equal(t, schema.PropertyName("_creationTimeUnix"), filter.Root.On.Property)
+		assert.Equal(t, schema.DataTypeText, filter.Root.Value.Type)
+		assert.Equal(t, "1685620740000", filter.Root.Value.Value)
+	})
+
+	t.Run("by date property", func(t *testing.T) {
+		expiry := newObjectExpiry(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt"},
+		}, now)
+		require.NotNil(t, expiry)
+
+		old := now.Add(-time.Hour)
+		assert.True(t, expiry.expired(objAt(now, map[string]interface{}{
+			"expiresAt": now.Add(-time.Minute).Format(time.RFC3339),
+		})))
+		assert.True(t, expiry.expired(objAt(now, map[string]interface{}{
+			"expiresAt": now.Add(-time.Minute),
+		})))
+		assert.False(t, expiry.expired(objAt(old, map[string]interface{}{
+			"expiresAt": now.Add(time.Minute).Format(time.RFC3339),
+		})))
+		assert.False(t, expiry.expired(objAt(old, map[string]interface{}{})))
+
+		filter := expiry.filter("Article")
+		assert.Equal(t, schema.PropertyName("expiresAt"), filter.Root.On.Property)
+		assert.Equal(t, schema.DataTypeDate, filter.Root.Value.Type)
+		assert.Equal(t, "2023-06-01T12:00:00Z", filter.Root.Value.Value)
+	})
+
+	t.Run("remove expired results", func(t *testing.T) {
+		expiry := newObjectExpiry(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60},
+		}, now)
+
+		objs := []*storobj.Object{
+			objAt(now.Add(-time.Hour), nil),
+			objAt(now, nil),
+			objAt(now.Add(-time.Hour), nil),
+			objAt(now.Add(-time.Second), nil),
+		}
+		dists := []float32{0.1, 0.2, 0.3, 0.4}
+
+		objs, dists = expiry.removeExpired(objs, dists)
+		require.Len(t, objs, 2)
+		assert.Equal(t, []float32{0.2, 0.4}, dists)
+		assert.Equal(t, now.UnixMilli(), objs[0].CreationTimeUnix())
+
+		objs, dists = expiry.removeExpired(objs, nil)
+		assert.Len(t, objs, 2)
+		assert.Nil(t, dists)
+	})
+
+	t.Run("search unexpired results", func(t *testing.T) {
+		expiry := newObjectExpiry(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60},
+		}, now)
+
+		// the first three results have expired, only the last two are valid
+		created := []time.Time{
+			now.Add(-time.Hour), now.Add(-time.Hour), now.Add(-time.Hour), now, now,
+		}
+		var limits []int
+		search := func(limit int) ([]*storobj.Object, []float32, error) {
+			limits = append(limits, limit)
+			var objs []*storobj.Object
+			var dists []float32
+			for i := 0; i < len(created) && (limit <= 0 || i < limit); i++ {
+				objs = append(objs, objAt(created[i], nil))
+				dists = append(dists, float32(i))
+			}
+			return objs, dists, nil
+		}
+
+		objs, dists, err := expiry.searchUnexpired(1, search)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, []float32{3}, dists)
+		assert.Equal(t, []int{1, 2, 4}, limits)
+
+		limits = nil
+		objs, dists, err = expiry.searchUnexpired(3, search)
+		require.NoError(t, err)
+		assert.Len(t, objs, 2)
+		assert.Equal(t, []float32{3, 4}, dists)
+		assert.Equal(t, []int{3, 6}, limits)
+
+		limits = nil
+		objs, _, err = expiry.searchUnexpired(-1, search)
+		require.NoError(t, err)
+		assert.Len(t, objs, 2)
+		assert.Equal(t, []int{-1}, limits)
+
+		var disabled *objectExpiry
+		limits = nil
+		objs, _, err = disabled.searchUnexpired(1, search)
+		require.NoError(t, err)
+		assert.Len(t, objs, 1)
+		assert.Equal(t, []int{1}, limits)
+	})
+}
@@ -64,11 +64,15 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMaxSizeMB:        db.config.MemtablesMaxSizeMB,
 				MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
+				ObjectsCompression:        db.config.ObjectsCompression,
+				ChangeStream:              db.config.ChangeStream,
+				AntiEntropy:               db.config.AntiEntropy,
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
 				ReplicationFactor:         class.ReplicationConfig.Factor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
+				convertToVectorIndexConfigs(class.VectorConfig),
 				db.schemaGetter, db, db.logger, db.nodeResolver, db.remoteIndex,
 				db.replicaClient, db.promMetrics, class, db.jobQueueCh)
 			if err != nil {
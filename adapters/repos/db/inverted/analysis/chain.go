@@ -0,0 +1,288 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Chain is the text analysis configured for a single property. Text is split
+// into tokens first, the tokens are then lowercased, stopwords are removed,
+// letters are folded to ASCII and finally each token is reduced to its stem.
+// Every step but the tokenization is optional.
+//
+// The same chain must be applied at index and at query time, otherwise the
+// terms produced for the query do not match the terms in the inverted index.
+type Chain struct {
+	tokenization string
+	ngramMin     int
+	ngramMax     int
+	lowercase    bool
+	asciiFolding bool
+	stemmer      string
+	preset       string
+	stopwords    stopwords.StopwordDetector
+	stem         stemFunc
+}
+
+// NewChain creates the chain for the given config. The tokenization of the
+// property is used, unless the config overrides it.
+func NewChain(tokenization string, cfg models.AnalyzerConfig) (*Chain, error) {
+	if err := ValidateConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	c := &Chain{
+		tokenization: tokenization,
+		lowercase:    cfg.Lowercase,
+		asciiFolding: cfg.AsciiFolding,
+		stemmer:      cfg.Stemmer,
+		preset:       cfg.StopwordPreset,
+		stem:         stemmers[cfg.Stemmer],
+	}
+	if cfg.Tokenization != "" {
+		c.tokenization = cfg.Tokenization
+	}
+	if c.tokenization == models.PropertyTokenizationNgram {
+		c.ngramMin, c.ngramMax = ngramRange(cfg)
+	}
+
+	if cfg.StopwordPreset != "" {
+		sd, err := stopwords.NewDetectorFromPreset(cfg.StopwordPreset)
+		if err != nil {
+			return nil, errors.Wrap(err, "create analyzer chain")
+		}
+		c.stopwords = sd
+	}
+
+	return c, nil
+}
+
+// ValidateConfig checks that all steps of the chain are known
+func ValidateConfig(cfg models.AnalyzerConfig) error {
+	if cfg.Tokenization != "" && !isKnownTokenization(cfg.Tokenization) {
+		return errors.Errorf("analyzer tokenization '%s' does not exist", cfg.Tokenization)
+	}
+
+	if cfg.NgramMin < 0 || cfg.NgramMax < 0 {
+		return errors.Errorf("analyzer ngramMin and ngramMax must not be negative")
+	}
+	if min, max := ngramRange(cfg); min > max {
+		return errors.Errorf("analyzer ngramMin %d must not be greater than ngramMax %d",
+			min, max)
+	} else if max > MaxNGram {
+		return errors.Errorf("analyzer ngramMax must not be greater than %d", MaxNGram)
+	}
+
+	if cfg.Stemmer != "" {
+		if _, ok := stemmers[cfg.Stemmer]; !ok {
+			return errors.Errorf("analyzer stemmer '%s' does not exist", cfg.Stemmer)
+		}
+	}
+
+	if cfg.StopwordPreset != "" {
+		if _, ok := stopwords.Presets[cfg.StopwordPreset]; !ok {
+			return errors.Errorf("analyzer stopwordPreset '%s' does not exist",
+				cfg.StopwordPreset)
+		}
+	}
+
+	return nil
+}
+
+// MaxNGram is the upper bound of the configurable n-gram length. Every
+// additional length multiplies the number of terms indexed per word.
+const MaxNGram = 10
+
+// ngramRange returns the configured n-gram lengths. A length which is not set
+// defaults to the other one if that is set, or to the default otherwise.
+func ngramRange(cfg models.AnalyzerConfig) (int, int) {
+	min, max := int(cfg.NgramMin), int(cfg.NgramMax)
+	switch {
+	case min == 0 && max == 0:
+		return helpers.DefaultNGramMin, helpers.DefaultNGramMax
+	case min == 0:
+		if max < helpers.DefaultNGramMin {
+			return max, max
+		}
+		return helpers.DefaultNGramMin, max
+	case max == 0:
+		if min > helpers.DefaultNGramMax {
+			return min, min
+		}
+		return min, helpers.DefaultNGramMax
+	}
+	return min, max
+}
+
+func isKnownTokenization(tokenization string) bool {
+	for _, t := range helpers.Tokenizations {
+		if t == tokenization {
+			return true
+		}
+	}
+	return false
+}
+
+// Tokenization returns the tokenization applied as the first step of the chain
+func (c *Chain) Tokenization() string {
+	return c.tokenization
+}
+
+// Key identifies the output of the chain. Chains with equal keys produce the
+// same terms for the same input, so the terms of a query only need to be
+// analyzed once for all properties sharing the key.
+func (c *Chain) Key() string {
+	return fmt.Sprintf("%s|%d|%d|%t|%t|%s|%s", c.tokenization, c.ngramMin,
+		c.ngramMax, c.lowercase, c.asciiFolding, c.stemmer, c.preset)
+}
+
+// Analyze runs the full chain on the input
+func (c *Chain) Analyze(in string) []string {
+	return c.analyze(c.tokenize(in), true)
+}
+
+// AnalyzeArray runs the full chain on every element of the input
+func (c *Chain) AnalyzeArray(in []string) []string {
+	var terms []string
+	for _, value := range in {
+		terms = append(terms, c.Analyze(value)...)
+	}
+	return terms
+}
+
+// AnalyzeWithWildcards analyzes a pattern of the like operator. Wildcard
+// symbols are kept, stopwords are not removed and the tokens are not stemmed,
+// as neither can be applied to a partial word.
+func (c *Chain) AnalyzeWithWildcards(in string) []string {
+	if c.tokenization == models.PropertyTokenizationNgram {
+		return c.analyze(helpers.TokenizeNGramsWithWildcards(in, c.ngramMin, c.ngramMax), false)
+	}
+	return c.analyze(helpers.TokenizeWithWildcards(c.tokenization, in), false)
+}
+
+func (c *Chain) tokenize(in string) []string {
+	if c.tokenization == models.PropertyTokenizationNgram {
+		return helpers.TokenizeNGrams(in, c.ngramMin, c.ngramMax)
+	}
+	return helpers.Tokenize(c.tokenization, in)
+}
+
+// AnalyzeAndCountDuplicates runs the full chain on the input and returns the
+// unique terms together with the number of their occurrences
+func (c *Chain) AnalyzeAndCountDuplicates(in string) ([]string, []int) {
+	counts := map[string]int{}
+	for _, term := range c.Analyze(in) {
+		counts[term]++
+	}
+
+	unique := make([]string, len(counts))
+	boosts := make([]int, len(counts))
+
+	i := 0
+	for term, boost := range counts {
+		unique[i] = term
+		boosts[i] = boost
+		i++
+	}
+
+	return unique, boosts
+}
+
+func (c *Chain) analyze(tokens []string, full bool) []string {
+	out := tokens[:0]
+	for _, token := range tokens {
+		if c.lowercase {
+			token = strings.ToLower(token)
+		}
+		if full && c.stopwords != nil && c.stopwords.IsStopword(token) {
+			continue
+		}
+		if c.asciiFolding {
+			token = FoldASCII(token)
+		}
+		if full && c.stem != nil {
+			token = c.stem(token)
+		}
+		if token == "" {
+			continue
+		}
+		out = append(out, token)
+	}
+	return out
+}
+
+// Chains holds the analyzer chains of a class by property name
+type Chains map[string]*Chain
+
+// NewChains creates the chains of all properties of the class which have an
+// analyzer configured in the inverted index config
+func NewChains(class *models.Class) (Chains, error) {
+	chains := Chains{}
+	if class == nil || class.InvertedIndexConfig == nil {
+		return chains, nil
+	}
+
+	for _, prop := range class.Properties {
+		chain, err := ChainForProperty(class, prop)
+		if err != nil {
+			return nil, err
+		}
+		if chain != nil {
+			chains[prop.Name] = chain
+		}
+	}
+
+	return chains, nil
+}
+
+// ForProperty returns the chain of the property or nil if the property has no
+// analyzer configured
+func (c Chains) ForProperty(propName string) *Chain {
+	if c == nil {
+		return nil
+	}
+	return c[propName]
+}
+
+// ChainForProperty creates the chain of a single property. It returns nil
+// without an error if the property has no analyzer configured or is not a
+// text property.
+func ChainForProperty(class *models.Class, prop *models.Property) (*Chain, error) {
+	if class == nil || class.InvertedIndexConfig == nil || prop == nil {
+		return nil, nil
+	}
+
+	cfg, ok := class.InvertedIndexConfig.Analyzers[prop.Name]
+	if !ok {
+		return nil, nil
+	}
+
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return nil, nil
+	}
+
+	chain, err := NewChain(prop.Tokenization, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "analyzer of property '%s'", prop.Name)
+	}
+	return chain, nil
+}
@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestFoldASCII(t *testing.T) {
+	tests := map[string]string{
+		"creme":        "creme",
+		"Crème Brûlée": "Creme Brulee",
+		"Straße":       "Strasse",
+		"Øresund":      "Oresund",
+		"naïve façade": "naive facade",
+		"año":          "ano",
+		"東京":           "東京",
+		"한국어":          "한국어",
+	}
+
+	for in, expected := range tests {
+		assert.Equal(t, expected, FoldASCII(in), in)
+	}
+}
+
+func TestChain(t *testing.T) {
+	t.Run("full chain", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationWhitespace, models.AnalyzerConfig{
+			Lowercase:      true,
+			AsciiFolding:   true,
+			Stemmer:        models.AnalyzerConfigStemmerGerman,
+			StopwordPreset: "de",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"haus", "stadt"}, chain.Analyze("Die Häuser der Stadt"))
+		assert.Equal(t, []string{"haus", "stadt", "katz"},
+			chain.AnalyzeArray([]string{"Die Häuser", "der Stadt", "Katzen"}))
+	})
+
+	t.Run("stopwords are removed before folding", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationWord, models.AnalyzerConfig{
+			AsciiFolding:   true,
+			StopwordPreset: "fr",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"ecole"}, chain.Analyze("Où est l'école"))
+	})
+
+	t.Run("without lowercasing", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationWhitespace, models.AnalyzerConfig{
+			AsciiFolding: true,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"Creme", "Brulee"}, chain.Analyze("Crème Brûlée"))
+	})
+
+	t.Run("tokenization of the config overrides the property", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationField, models.AnalyzerConfig{
+			Tokenization: models.PropertyTokenizationWord,
+			Stemmer:      models.AnalyzerConfigStemmerEnglish,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, models.PropertyTokenizationWord, chain.Tokenization())
+		assert.Equal(t, []string{"run", "connect"}, chain.Analyze("Running, connections!"))
+	})
+
+	t.Run("like patterns are neither stemmed nor filtered", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationWord, models.AnalyzerConfig{
+			AsciiFolding:   true,
+			Stemmer:        models.AnalyzerConfigStemmerEnglish,
+			StopwordPreset: "en",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"the", "cafe*"}, chain.AnalyzeWithWildcards("the café*"))
+	})
+
+	t.Run("duplicates are counted after analysis", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationWord, models.AnalyzerConfig{
+			Stemmer: models.AnalyzerConfigStemmerEnglish,
+		})
+		require.Nil(t, err)
+
+		terms, boosts := chain.AnalyzeAndCountDuplicates("connect connected connections run")
+		counts := map[string]int{}
+		for i := range terms {
+			counts[terms[i]] = boosts[i]
+		}
+		assert.Equal(t, map[string]int{"connect": 3, "run": 1}, counts)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		cfg := models.AnalyzerConfig{Lowercase: true, StopwordPreset: "es"}
+		a, err := NewChain(models.PropertyTokenizationWord, cfg)
+		require.Nil(t, err)
+		b, err := NewChain(models.PropertyTokenizationWord, cfg)
+		require.Nil(t, err)
+		c, err := NewChain(models.PropertyTokenizationWhitespace, cfg)
+		require.Nil(t, err)
+
+		assert.Equal(t, a.Key(), b.Key())
+		assert.NotEqual(t, a.Key(), c.Key())
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewChain(models.PropertyTokenizationWord, models.AnalyzerConfig{
+			Stemmer: "klingon",
+		})
+		assert.EqualError(t, err, "analyzer stemmer 'klingon' does not exist")
+	})
+
+	t.Run("ngram lengths", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationNgram, models.AnalyzerConfig{
+			NgramMin: 2,
+			NgramMax: 4,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"ca", "at", "ts", "cat", "ats", "cats"}, chain.Analyze("Cats"))
+		assert.Equal(t, []string{"cats"}, chain.AnalyzeWithWildcards("*cats*"))
+	})
+
+	t.Run("ngram lengths default to trigrams", func(t *testing.T) {
+		chain, err := NewChain(models.PropertyTokenizationNgram, models.AnalyzerConfig{
+			AsciiFolding: true,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"cre", "rem", "eme"}, chain.Analyze("Crème"))
+	})
+
+	t.Run("ngram lengths change the key", func(t *testing.T) {
+		a, err := NewChain(models.PropertyTokenizationNgram, models.AnalyzerConfig{NgramMax: 4})
+		require.Nil(t, err)
+		b, err := NewChain(models.PropertyTokenizationNgram, models.AnalyzerConfig{})
+		require.Nil(t, err)
+
+		assert.NotEqual(t, a.Key(), b.Key())
+	})
+
+	t.Run("invalid ngram lengths", func(t *testing.T) {
+		for _, cfg := range []models.AnalyzerConfig{
+			{NgramMin: 4, NgramMax: 2},
+			{NgramMin: -1},
+			{NgramMax: MaxNGram + 1},
+		} {
+			_, err := NewChain(models.PropertyTokenizationNgram, cfg)
+			assert.NotNil(t, err)
+		}
+	})
+}
+
+func TestChains(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"title":     {Stemmer: models.AnalyzerConfigStemmerSpanish},
+				"wordCount": {Lowercase: true},
+			},
+		},
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}, Tokenization: models.PropertyTokenizationWord},
+			{Name: "body", DataType: []string{"text"}, Tokenization: models.PropertyTokenizationWord},
+			{Name: "wordCount", DataType: []string{"int"}},
+		},
+	}
+
+	chains, err := NewChains(class)
+	require.Nil(t, err)
+
+	require.Len(t, chains, 1)
+	require.NotNil(t, chains.ForProperty("title"))
+	assert.Equal(t, []string{"libr"}, chains.ForProperty("title").Analyze("Libros"))
+	assert.Nil(t, chains.ForProperty("body"))
+	assert.Nil(t, chains.ForProperty("wordCount"))
+	assert.Nil(t, Chains(nil).ForProperty("title"))
+}
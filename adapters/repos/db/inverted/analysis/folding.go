@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// letters which do not decompose into an ASCII letter and a combining mark
+var foldingExceptions = map[rune]string{
+	'ß': "ss", 'ẞ': "SS",
+	'æ': "ae", 'Æ': "AE",
+	'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O",
+	'đ': "d", 'Đ': "D",
+	'ð': "d", 'Ð': "D",
+	'ł': "l", 'Ł': "L",
+	'þ': "th", 'Þ': "TH",
+	'ı': "i",
+}
+
+// FoldASCII replaces accented and other non-ASCII latin letters by their
+// ASCII equivalent, e.g. "Crème Brûlée" becomes "Creme Brulee". Letters
+// without an ASCII equivalent are kept as they are.
+func FoldASCII(in string) string {
+	if isASCII(in) {
+		return in
+	}
+
+	var b strings.Builder
+	b.Grow(len(in))
+	for _, r := range norm.NFD.String(in) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if replacement, ok := foldingExceptions[r]; ok {
+			b.WriteString(replacement)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String())
+}
+
+func isASCII(in string) bool {
+	for i := 0; i < len(in); i++ {
+		if in[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
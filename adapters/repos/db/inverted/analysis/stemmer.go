@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// stemFunc reduces a lowercased token to its stem
+type stemFunc func(string) string
+
+var stemmers = map[string]stemFunc{
+	models.AnalyzerConfigStemmerNone:    nil,
+	models.AnalyzerConfigStemmerEnglish: StemEnglish,
+	models.AnalyzerConfigStemmerGerman:  StemGerman,
+	models.AnalyzerConfigStemmerFrench:  StemFrench,
+	models.AnalyzerConfigStemmerSpanish: StemSpanish,
+}
+
+func hasSuffix(s []rune, suffix string) bool {
+	suf := []rune(suffix)
+	if len(suf) > len(s) {
+		return false
+	}
+	for i := range suf {
+		if s[len(s)-len(suf)+i] != suf[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import "unicode"
+
+// StemFrench reduces a French word to its stem using the light stemming
+// approach by Jacques Savoy. Plurals and the most common derivational
+// suffixes are removed before the word is normalized, e.g. "chevaux" and
+// "cheval" both become "cheval".
+func StemFrench(word string) string {
+	s := []rune(word)
+
+	if len(s) > 5 && s[len(s)-1] == 'x' {
+		n := len(s)
+		if s[n-3] == 'a' && s[n-2] == 'u' && s[n-4] != 'e' {
+			s[n-2] = 'l'
+		}
+		s = s[:n-1]
+	}
+	if len(s) > 3 && s[len(s)-1] == 'x' {
+		s = s[:len(s)-1]
+	}
+	if len(s) > 3 && s[len(s)-1] == 's' {
+		s = s[:len(s)-1]
+	}
+
+	return string(frenchNorm(frenchSuffixes(s)))
+}
+
+// replaceSuffix cuts the last n runes of s and appends the replacement
+func replaceSuffix(s []rune, n int, replacement string) []rune {
+	return append(s[:len(s)-n], []rune(replacement)...)
+}
+
+// frenchSuffixes removes or replaces derivational suffixes, e.g. "-issement"
+// becomes "-ir" and "-atrice" becomes "-er"
+func frenchSuffixes(s []rune) []rune {
+	n := len(s)
+	switch {
+	case n > 9 && hasSuffix(s, "issement"):
+		return replaceSuffix(s, 7, "r")
+	case n > 8 && hasSuffix(s, "issant"):
+		return replaceSuffix(s, 5, "r")
+	case n > 6 && hasSuffix(s, "ement"):
+		s = s[:n-4]
+		if len(s) > 3 && hasSuffix(s, "ive") {
+			s = replaceSuffix(s, 2, "f")
+		}
+		return s
+	case n > 11 && hasSuffix(s, "ficatrice"):
+		return replaceSuffix(s, 5, "er")
+	case n > 10 && hasSuffix(s, "ficateur"):
+		return replaceSuffix(s, 4, "er")
+	case n > 9 && hasSuffix(s, "catrice"):
+		return replaceSuffix(s, 7, "quer")
+	case n > 8 && hasSuffix(s, "cateur"):
+		return replaceSuffix(s, 6, "quer")
+	case n > 8 && hasSuffix(s, "atrice"):
+		return replaceSuffix(s, 6, "er")
+	case n > 7 && hasSuffix(s, "ateur"):
+		return replaceSuffix(s, 5, "er")
+	}
+
+	if n > 6 && hasSuffix(s, "trice") {
+		s = replaceSuffix(s, 4, "eur")
+		n = len(s)
+	}
+
+	switch {
+	case n > 5 && hasSuffix(s, "ième"):
+		return s[:n-4]
+	case n > 7 && hasSuffix(s, "teuse"):
+		return replaceSuffix(s, 3, "r")
+	case n > 6 && hasSuffix(s, "teur"):
+		return replaceSuffix(s, 2, "r")
+	case n > 5 && hasSuffix(s, "euse"):
+		return s[:n-2]
+	case n > 8 && hasSuffix(s, "ère"):
+		return replaceSuffix(s, 3, "er")
+	case n > 7 && hasSuffix(s, "ive"):
+		return replaceSuffix(s, 1, "f")
+	case n > 4 && (hasSuffix(s, "folle") || hasSuffix(s, "molle")):
+		return replaceSuffix(s, 3, "u")
+	case n > 9 && hasSuffix(s, "nnelle"):
+		return s[:n-5]
+	case n > 9 && hasSuffix(s, "nnel"):
+		return s[:n-3]
+	}
+
+	if n > 4 && hasSuffix(s, "ète") {
+		s = replaceSuffix(s, 3, "et")
+		n = len(s)
+	}
+	if n > 8 && hasSuffix(s, "ique") {
+		s = s[:n-4]
+		n = len(s)
+	}
+
+	switch {
+	case n > 8 && hasSuffix(s, "esse"):
+		return s[:n-3]
+	case n > 7 && hasSuffix(s, "inage"):
+		return s[:n-3]
+	case n > 9 && hasSuffix(s, "isation"):
+		s = s[:n-7]
+		if len(s) > 5 && hasSuffix(s, "ual") {
+			s[len(s)-2] = 'e'
+		}
+		return s
+	case n > 9 && hasSuffix(s, "isateur"):
+		return s[:n-7]
+	case n > 8 && (hasSuffix(s, "ation") || hasSuffix(s, "ition")):
+		return s[:n-5]
+	}
+
+	return s
+}
+
+// frenchNorm removes accents and duplicate letters of longer words as well
+// as their final -ie, -r and -e
+func frenchNorm(s []rune) []rune {
+	if len(s) > 4 {
+		for i, r := range s {
+			switch r {
+			case 'à', 'á', 'â':
+				s[i] = 'a'
+			case 'ô':
+				s[i] = 'o'
+			case 'è', 'é', 'ê':
+				s[i] = 'e'
+			case 'ù', 'û':
+				s[i] = 'u'
+			case 'î':
+				s[i] = 'i'
+			case 'ç':
+				s[i] = 'c'
+			}
+		}
+
+		deduplicated := s[:1]
+		for _, r := range s[1:] {
+			if r == deduplicated[len(deduplicated)-1] && unicode.IsLetter(r) {
+				continue
+			}
+			deduplicated = append(deduplicated, r)
+		}
+		s = deduplicated
+	}
+
+	if len(s) > 4 && hasSuffix(s, "ie") {
+		s = s[:len(s)-2]
+	}
+
+	if len(s) > 4 {
+		if s[len(s)-1] == 'r' {
+			s = s[:len(s)-1]
+		}
+		if s[len(s)-1] == 'e' {
+			s = s[:len(s)-1]
+		}
+		if s[len(s)-1] == 'e' {
+			s = s[:len(s)-1]
+		}
+		if s[len(s)-1] == s[len(s)-2] && unicode.IsLetter(s[len(s)-1]) {
+			s = s[:len(s)-1]
+		}
+	}
+
+	return s
+}
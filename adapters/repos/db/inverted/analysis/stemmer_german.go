@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+// StemGerman reduces a German word to its stem using the light stemming
+// approach by Jacques Savoy. Umlauts and accents are removed and only the
+// most common inflectional suffixes are stripped, e.g. "Häuser" and "Hauses"
+// both become "haus".
+func StemGerman(word string) string {
+	s := make([]rune, 0, len(word))
+	for _, r := range word {
+		switch r {
+		case 'ä', 'à', 'á', 'â':
+			s = append(s, 'a')
+		case 'ë', 'è', 'é', 'ê':
+			s = append(s, 'e')
+		case 'ï', 'ì', 'í', 'î':
+			s = append(s, 'i')
+		case 'ö', 'ò', 'ó', 'ô':
+			s = append(s, 'o')
+		case 'ü', 'ù', 'ú', 'û':
+			s = append(s, 'u')
+		case 'ß':
+			s = append(s, 's', 's')
+		default:
+			s = append(s, r)
+		}
+	}
+
+	s = germanStep1(s)
+	s = germanStep2(s)
+	return string(s)
+}
+
+// germanSTEnding reports whether r may precede a removable -s or -st suffix
+func germanSTEnding(r rune) bool {
+	switch r {
+	case 'b', 'd', 'f', 'g', 'h', 'k', 'l', 'm', 'n', 't':
+		return true
+	default:
+		return false
+	}
+}
+
+// germanStep1 removes noun and adjective suffixes
+func germanStep1(s []rune) []rune {
+	n := len(s)
+	if n > 5 && hasSuffix(s, "ern") {
+		return s[:n-3]
+	}
+	if n > 4 && s[n-2] == 'e' {
+		switch s[n-1] {
+		case 'm', 'n', 'r', 's':
+			return s[:n-2]
+		}
+	}
+	if n > 3 && s[n-1] == 'e' {
+		return s[:n-1]
+	}
+	if n > 3 && s[n-1] == 's' && germanSTEnding(s[n-2]) {
+		return s[:n-1]
+	}
+	return s
+}
+
+// germanStep2 removes comparative, superlative and verb suffixes
+func germanStep2(s []rune) []rune {
+	n := len(s)
+	if n > 5 && hasSuffix(s, "est") {
+		return s[:n-3]
+	}
+	if n > 4 && s[n-2] == 'e' && (s[n-1] == 'r' || s[n-1] == 'n') {
+		return s[:n-2]
+	}
+	if n > 4 && hasSuffix(s, "st") && germanSTEnding(s[n-3]) {
+		return s[:n-2]
+	}
+	return s
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+// StemSpanish reduces a Spanish word to its stem using the light stemming
+// approach by Jacques Savoy. Accents are removed as well as the final vowel
+// and plural suffixes, e.g. "libros" and "libro" both become "libr".
+func StemSpanish(word string) string {
+	s := []rune(word)
+	if len(s) < 5 {
+		return word
+	}
+
+	for i, r := range s {
+		switch r {
+		case 'à', 'á', 'â', 'ä':
+			s[i] = 'a'
+		case 'ò', 'ó', 'ô', 'ö':
+			s[i] = 'o'
+		case 'è', 'é', 'ê', 'ë':
+			s[i] = 'e'
+		case 'ù', 'ú', 'û', 'ü':
+			s[i] = 'u'
+		case 'ì', 'í', 'î', 'ï':
+			s[i] = 'i'
+		}
+	}
+
+	n := len(s)
+	switch s[n-1] {
+	case 'o', 'a', 'e':
+		s = s[:n-1]
+	case 's':
+		switch {
+		case hasSuffix(s, "eses"):
+			s = s[:n-2]
+		case hasSuffix(s, "ces"):
+			s[n-3] = 'z'
+			s = s[:n-2]
+		case s[n-2] == 'o' || s[n-2] == 'a' || s[n-2] == 'e':
+			s = s[:n-2]
+		}
+	}
+
+	return string(s)
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemmers(t *testing.T) {
+	type testCase struct {
+		word     string
+		expected string
+	}
+
+	run := func(t *testing.T, stem stemFunc, tests []testCase) {
+		for _, test := range tests {
+			assert.Equal(t, test.expected, stem(test.word), test.word)
+		}
+	}
+
+	t.Run("english", func(t *testing.T) {
+		run(t, StemEnglish, []testCase{
+			{"caresses", "caress"},
+			{"ponies", "poni"},
+			{"cats", "cat"},
+			{"feed", "feed"},
+			{"agreed", "agre"},
+			{"plastered", "plaster"},
+			{"motoring", "motor"},
+			{"sing", "sing"},
+			{"hopping", "hop"},
+			{"filing", "file"},
+			{"happy", "happi"},
+			{"relational", "relat"},
+			{"generalization", "gener"},
+			{"running", "run"},
+			{"connection", "connect"},
+			{"connections", "connect"},
+			{"connected", "connect"},
+			{"hopeful", "hope"},
+			{"goodness", "good"},
+			{"adjustment", "adjust"},
+			{"controll", "control"},
+			{"by", "by"},
+			{"café", "café"},
+			{"h2o", "h2o"},
+		})
+	})
+
+	t.Run("german", func(t *testing.T) {
+		run(t, StemGerman, []testCase{
+			{"häuser", "haus"},
+			{"hauses", "haus"},
+			{"haus", "haus"},
+			{"katzen", "katz"},
+			{"katze", "katz"},
+			{"kinder", "kind"},
+			{"kindern", "kind"},
+			{"schnellste", "schnell"},
+			{"straße", "strass"},
+		})
+	})
+
+	t.Run("french", func(t *testing.T) {
+		run(t, StemFrench, []testCase{
+			{"chevaux", "cheval"},
+			{"cheval", "cheval"},
+			{"nationale", "national"},
+			{"nationales", "national"},
+			{"maisons", "maison"},
+			{"rapidement", "rapid"},
+			{"chanteuse", "chant"},
+			{"chanteur", "chant"},
+			{"heureuse", "heureu"},
+			{"heureux", "heureu"},
+		})
+	})
+
+	t.Run("spanish", func(t *testing.T) {
+		run(t, StemSpanish, []testCase{
+			{"libros", "libr"},
+			{"libro", "libr"},
+			{"luces", "luz"},
+			{"luz", "luz"},
+			{"meses", "mes"},
+			{"canción", "cancion"},
+			{"canciones", "cancion"},
+		})
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -25,6 +26,7 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	Positions     []uint32 // only set if the property has a position index
 }
 
 type Property struct {
@@ -33,10 +35,16 @@ type Property struct {
 	Length             int
 	HasFilterableIndex bool // roaring set index
 	HasSearchableIndex bool // map index (with frequencies)
+	HasPositionIndex   bool // map index (with positions)
 }
 
+// PositionsGap is added to the position of the first token of every element
+// of a text array, so that phrases can not match across elements
+const PositionsGap = 100
+
 type Analyzer struct {
 	isFallbackToSearchable IsFallbackToSearchable
+	chains                 analysis.Chains
 }
 
 // Text tokenizes given input according to selected tokenization,
@@ -53,6 +61,59 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 		terms = append(terms, helpers.Tokenize(tokenization, in)...)
 	}
 
+	return countTerms(terms)
+}
+
+// textArray analyzes the values of a text property with the analyzer chain
+// configured for the property, falling back to its plain tokenization
+func (a *Analyzer) textArray(prop *models.Property, inArr []string) []Countable {
+	if HasPositionIndex(prop) {
+		return countTermsWithPositions(a.textTermsPerElement(prop, inArr))
+	}
+	if chain := a.chains.ForProperty(prop.Name); chain != nil {
+		return countTerms(chain.AnalyzeArray(inArr))
+	}
+	return a.TextArray(prop.Tokenization, inArr)
+}
+
+func (a *Analyzer) textTermsPerElement(prop *models.Property, inArr []string) [][]string {
+	chain := a.chains.ForProperty(prop.Name)
+	terms := make([][]string, len(inArr))
+	for i, in := range inArr {
+		if chain != nil {
+			terms[i] = chain.Analyze(in)
+		} else {
+			terms[i] = helpers.Tokenize(prop.Tokenization, in)
+		}
+	}
+	return terms
+}
+
+// countTermsWithPositions aggregates duplicates like countTerms, additionally
+// recording the position of every occurrence of a term
+func countTermsWithPositions(termsPerElement [][]string) []Countable {
+	var countable []Countable
+	indexes := map[string]int{}
+
+	pos := uint32(0)
+	for _, terms := range termsPerElement {
+		for _, term := range terms {
+			i, ok := indexes[term]
+			if !ok {
+				i = len(countable)
+				indexes[term] = i
+				countable = append(countable, Countable{Data: []byte(term)})
+			}
+			countable[i].TermFrequency++
+			countable[i].Positions = append(countable[i].Positions, pos)
+			pos++
+		}
+		pos += PositionsGap
+	}
+	return countable
+}
+
+func countTerms(terms []string) []Countable {
 	counts := map[string]uint64{}
 	for _, term := range terms {
 		counts[term]++
@@ -213,9 +274,9 @@ func (a *Analyzer) Ref(in models.MultipleRef) ([]Countable, error) {
 	return out, nil
 }
 
-func NewAnalyzer(isFallbackToSearchable IsFallbackToSearchable) *Analyzer {
+func NewAnalyzer(isFallbackToSearchable IsFallbackToSearchable, chains analysis.Chains) *Analyzer {
 	if isFallbackToSearchable == nil {
 		isFallbackToSearchable = func() bool { return false }
 	}
-	return &Analyzer{isFallbackToSearchable: isFallbackToSearchable}
+	return &Analyzer{isFallbackToSearchable: isFallbackToSearchable, chains: chains}
 }
@@ -23,7 +23,7 @@ import (
 )
 
 func TestAnalyzer(t *testing.T) {
-	a := NewAnalyzer(nil)
+	a := NewAnalyzer(nil, nil)
 
 	countable := func(data []string, freq []int) []Countable {
 		countable := make([]Countable, len(data))
@@ -329,7 +329,7 @@ func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 		return countable
 	}
 
-	a := NewAnalyzer(nil)
+	a := NewAnalyzer(nil, nil)
 	input := "Hello you-beautiful_World"
 
 	t.Run("with text", func(t *testing.T) {
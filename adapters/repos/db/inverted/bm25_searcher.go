@@ -16,16 +16,19 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/inverted"
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -82,11 +85,26 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 			return nil, nil, inverted.NewMissingSearchableIndexError(property)
 		}
 	}
+	if keywordRanking.Fuzziness < 0 || keywordRanking.Fuzziness > MaxFuzziness {
+		return nil, nil, fmt.Errorf("fuzziness must be between 0 and %d, got %d",
+			MaxFuzziness, keywordRanking.Fuzziness)
+	}
 	class, err := schema.GetClassByName(b.schema.Objects, string(className))
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// quoted phrases restrict the results to the objects containing them, the
+	// words of the phrases are still scored like any other query term
+	query, phrases := parseQueryPhrases(keywordRanking.Query)
+	if len(phrases) > 0 {
+		filterDocIds, err = b.phraseDocIDs(class, keywordRanking.Properties, phrases, filterDocIds)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "phrases")
+		}
+		keywordRanking.Query = query
+	}
+
 	objs, scores, err := b.wand(ctx, filterDocIds, class, keywordRanking, limit)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "wand")
@@ -110,7 +128,7 @@ func (b *BM25Searcher) wand(
 	}
 
 	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
+	// word, lowercase, whitespace, field, ngram and cjk.
 	// Query is tokenized and respective properties are then searched for the search terms,
 	// results at the end are combined using WAND
 	tokenizationsOrdered := []string{
@@ -118,6 +136,8 @@ func (b *BM25Searcher) wand(
 		models.PropertyTokenizationLowercase,
 		models.PropertyTokenizationWhitespace,
 		models.PropertyTokenizationField,
+		models.PropertyTokenizationNgram,
+		models.PropertyTokenizationCjk,
 	}
 
 	queryTermsByTokenization := map[string][]string{}
@@ -136,6 +156,11 @@ func (b *BM25Searcher) wand(
 		propNamesByTokenization[tokenization] = make([]string, 0)
 	}
 
+	// properties with an analyzer chain are grouped by the chain instead of
+	// their tokenization, the query is analyzed once per distinct chain
+	groupsOrdered := append([]string{}, tokenizationsOrdered...)
+	chainsByGroup := map[string]*analysis.Chain{}
+
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
@@ -160,6 +185,22 @@ func (b *BM25Searcher) wand(
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
+			chain, err := analysis.ChainForProperty(class, prop)
+			if err != nil {
+				return nil, nil, err
+			}
+			if chain != nil {
+				group := "analyzer:" + chain.Key()
+				if _, exists := propNamesByTokenization[group]; !exists {
+					queryTermsByTokenization[group], duplicateBoostsByTokenization[group] = chain.AnalyzeAndCountDuplicates(params.Query)
+					propNamesByTokenization[group] = make([]string, 0)
+					groupsOrdered = append(groupsOrdered, group)
+					chainsByGroup[group] = chain
+				}
+				propNamesByTokenization[group] = append(propNamesByTokenization[group], property)
+				continue
+			}
+
 			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
 				return nil, nil, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
@@ -172,6 +213,50 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength = averagePropLength / float64(len(params.Properties))
 
+	// fuzzy variants and synonyms of a query term are blended into the term
+	// itself with a reduced weight, synonyms are analyzed the same way as the
+	// query they are matched against
+	expansionsByGroup := map[string]map[string][]weightedTerm{}
+	for _, group := range groupsOrdered {
+		propNames := propNamesByTokenization[group]
+		if len(propNames) == 0 {
+			continue
+		}
+
+		var synonyms map[string][]weightedTerm
+		if class.InvertedIndexConfig != nil && len(class.InvertedIndexConfig.Synonyms) > 0 {
+			tokenization := group
+			analyze := func(in string) []string { return helpers.Tokenize(tokenization, in) }
+			if chain, ok := chainsByGroup[group]; ok {
+				analyze = chain.Analyze
+			}
+			synonyms = synonymsByTerm(class.InvertedIndexConfig.Synonyms, analyze)
+		}
+
+		var variants map[string][]weightedTerm
+		if params.Fuzziness > 0 {
+			var err error
+			variants, err = b.fuzzyVariants(ctx, queryTermsByTokenization[group],
+				propNames, params.Fuzziness)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if len(synonyms) == 0 && len(variants) == 0 {
+			continue
+		}
+		expansions := make(map[string][]weightedTerm, len(queryTermsByTokenization[group]))
+		for _, queryTerm := range queryTermsByTokenization[group] {
+			expanded := variants[queryTerm]
+			for _, synonym := range synonyms[queryTerm] {
+				expanded = addWeightedTerm(expanded, synonym.term, synonym.weight)
+			}
+			expansions[queryTerm] = expanded
+		}
+		expansionsByGroup[group] = expansions
+	}
+
 	// preallocate the results
 	lengthAllResults := 0
 	for tokenization, propNames := range propNamesByTokenization {
@@ -186,19 +271,20 @@ func (b *BM25Searcher) wand(
 	eg.SetLimit(_NUMCPU)
 	offset := 0
 
-	for _, tokenization := range tokenizationsOrdered {
+	for _, tokenization := range groupsOrdered {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
 			queryTerms := queryTermsByTokenization[tokenization]
 			duplicateBoosts := duplicateBoostsByTokenization[tokenization]
+			expansions := expansionsByGroup[tokenization]
 
 			for i := range queryTerms {
 				j := i
 				k := i + offset
 
 				eg.Go(func() error {
-					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
-						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
+					termResult, docIndices, err := b.createTerm(N, filterDocIds, queryTerms[j], expansions[queryTerms[j]],
+						propNames, propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
 					if err != nil {
 						return err
 					}
@@ -229,6 +315,159 @@ func (b *BM25Searcher) wand(
 	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
 }
 
+// fuzzyVariants returns the terms of the properties within the allowed edit
+// distance of each query term, weighted by their distance. The query term
+// itself is not part of its variants.
+func (b *BM25Searcher) fuzzyVariants(ctx context.Context, queryTerms []string,
+	propNames []string, fuzziness int,
+) (map[string][]weightedTerm, error) {
+	out := make(map[string][]weightedTerm, len(queryTerms))
+	for _, queryTerm := range queryTerms {
+		maxDistance := AutoFuzziness([]byte(queryTerm))
+		if maxDistance > fuzziness {
+			maxDistance = fuzziness
+		}
+		if maxDistance == 0 {
+			continue
+		}
+
+		a := newLevenshteinAutomaton([]byte(queryTerm), maxDistance)
+		distances := map[string]int{}
+		for _, propName := range propNames {
+			bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, fmt.Errorf("could not find bucket for property %v", propName)
+			}
+
+			var opts []lsmkv.MapListOption
+			if b.shardVersion < 2 {
+				opts = append(opts, lsmkv.MapListLegacySortingRequired())
+			}
+			c := bucket.MapCursorKeyOnly(opts...)
+			first := func() []byte { k, _ := c.First(); return k }
+			seek := func(key []byte) []byte { k, _ := c.Seek(key); return k }
+			next := func() []byte { k, _ := c.Next(); return k }
+
+			err := fuzzyWalk(ctx, a, first, seek, next, func(k []byte) (bool, error) {
+				if term := string(k); term != queryTerm {
+					distances[term] = a.distance(k)
+				}
+				return len(distances) < MaxFuzzyExpansions, nil
+			})
+			c.Close()
+			if err != nil {
+				return nil, fmt.Errorf("fuzzy match term %q: %w", queryTerm, err)
+			}
+		}
+
+		terms := make([]string, 0, len(distances))
+		for term := range distances {
+			terms = append(terms, term)
+		}
+		sort.Strings(terms)
+		for _, term := range terms {
+			out[queryTerm] = append(out[queryTerm], weightedTerm{
+				term:   term,
+				weight: fuzzyWeight(distances[term]),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// phraseDocIDs returns the ids of the objects containing all phrases in any
+// of the given properties that have their positions indexed
+func (b *BM25Searcher) phraseDocIDs(class *models.Class, properties []string,
+	phrases []filters.TextProximity, filterDocIds helpers.AllowList,
+) (helpers.AllowList, error) {
+	var out *sroar.Bitmap
+	for _, queryPhrase := range phrases {
+		var matches *sroar.Bitmap
+		for _, propertyWithBoost := range properties {
+			property := strings.Split(propertyWithBoost, "^")[0]
+			prop, err := schema.GetPropertyByName(class, property)
+			if err != nil {
+				return nil, err
+			}
+			if !HasPositionIndex(prop) {
+				continue
+			}
+
+			chain, err := analysis.ChainForProperty(class, prop)
+			if err != nil {
+				return nil, err
+			}
+			phrase := Phrase{
+				Terms:    AnalyzePhrase(chain, prop.Tokenization, queryPhrase.Text),
+				Distance: queryPhrase.Distance,
+			}
+			if len(phrase.Terms) == 0 {
+				continue
+			}
+
+			bucket := b.store.Bucket(helpers.BucketPositionsFromPropNameLSM(property))
+			if bucket == nil {
+				return nil, fmt.Errorf("no bucket positions for prop '%s' found", property)
+			}
+			docIDs, err := PhraseDocIDs(bucket, phrase)
+			if err != nil {
+				return nil, fmt.Errorf("phrase %q on property %q: %w", queryPhrase.Text, property, err)
+			}
+
+			if matches == nil {
+				matches = docIDs
+			} else {
+				matches.Or(docIDs)
+			}
+		}
+
+		if matches == nil {
+			return nil, fmt.Errorf("phrase %q can not be searched, none of the searched "+
+				"properties has `indexPositions: true` or the phrase has no terms", queryPhrase.Text)
+		}
+		if out == nil {
+			out = matches
+		} else {
+			out.And(matches)
+		}
+	}
+
+	if filterDocIds != nil {
+		filtered := sroar.NewBitmap()
+		for _, docID := range out.ToArray() {
+			if filterDocIds.Contains(docID) {
+				filtered.Set(docID)
+			}
+		}
+		out = filtered
+	}
+	return helpers.NewAllowListFromBitmap(out), nil
+}
+
+// queryPhraseRegexp matches phrases in double quotes, optionally followed by
+// the maximum distance between their words, e.g. "machine learning"~3
+var queryPhraseRegexp = regexp.MustCompile(`"([^"]*)"(?:~(\d+))?`)
+
+// parseQueryPhrases extracts the quoted phrases of a bm25 query. It returns
+// the query without the phrase syntax, but with the words of the phrases.
+func parseQueryPhrases(query string) (string, []filters.TextProximity) {
+	var phrases []filters.TextProximity
+	stripped := queryPhraseRegexp.ReplaceAllStringFunc(query, func(match string) string {
+		groups := queryPhraseRegexp.FindStringSubmatch(match)
+		if strings.TrimSpace(groups[1]) == "" {
+			return " "
+		}
+		distance := 0
+		if groups[2] != "" {
+			distance, _ = strconv.Atoi(groups[2])
+		}
+		phrases = append(phrases, filters.TextProximity{Text: groups[1], Distance: distance})
+		return " " + groups[1] + " "
+	})
+	return stripped, phrases
+}
+
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
 	if detector == nil || len(queryTerms) == 0 {
 		return queryTerms, duplicateBoost
@@ -327,7 +566,7 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 	}
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
+func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, expansions []weightedTerm, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, additionalExplanations bool) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
 
@@ -343,6 +582,25 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 			return termResult, nil, err
 		}
 
+		// the postings of synonyms and fuzzy variants are blended into the ones
+		// of the query term, so that a doc containing multiple of them is not
+		// over-scored
+		if len(expansions) > 0 {
+			lists := [][]lsmkv.MapPair{preM}
+			weights := []float32{1}
+			for _, expansion := range expansions {
+				expM, err := bucket.MapList([]byte(expansion.term))
+				if err != nil {
+					return termResult, nil, err
+				}
+				if len(expM) > 0 {
+					lists = append(lists, expM)
+					weights = append(weights, expansion.weight)
+				}
+			}
+			preM = mergeWeightedPairs(lists, weights)
+		}
+
 		var m []lsmkv.MapPair
 		if filterDocIds != nil {
 			m = make([]lsmkv.MapPair, 0, len(preM))
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -39,6 +40,16 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = validateAnalyzersConfig(conf.Analyzers)
+	if err != nil {
+		return err
+	}
+
+	err = validateSynonymsConfig(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -48,6 +59,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.Analyzers = iicm.Analyzers
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
@@ -85,6 +97,37 @@ func validateBM25Config(conf *models.BM25Config) error {
 	return nil
 }
 
+func validateAnalyzersConfig(conf map[string]models.AnalyzerConfig) error {
+	for propName, analyzer := range conf {
+		if err := analysis.ValidateConfig(analyzer); err != nil {
+			return errors.Wrapf(err, "analyzers.%s", propName)
+		}
+	}
+
+	return nil
+}
+
+func validateSynonymsConfig(conf []*models.SynonymSet) error {
+	for i, set := range conf {
+		if set == nil {
+			return errors.Errorf("synonyms.%d must not be empty", i)
+		}
+		if len(set.Terms) < 2 {
+			return errors.Errorf("synonyms.%d must contain at least two terms", i)
+		}
+		for _, term := range set.Terms {
+			if strings.TrimSpace(term) == "" {
+				return errors.Errorf("synonyms.%d.terms must not contain empty terms", i)
+			}
+		}
+		if set.Weight != nil && (*set.Weight <= 0 || *set.Weight > 1) {
+			return errors.Errorf("synonyms.%d.weight must be > 0 and <= 1", i)
+		}
+	}
+
+	return nil
+}
+
 func validateStopwordConfig(conf *models.StopwordConfig) error {
 	if conf == nil {
 		conf = &models.StopwordConfig{}
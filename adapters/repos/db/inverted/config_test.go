@@ -163,6 +163,101 @@ func TestValidateConfig(t *testing.T) {
 			assert.Equal(t, test.expectedLength, len(in.Stopwords.Additions))
 		}
 	})
+
+	t.Run("with valid analyzers", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"title": {
+					Lowercase:      true,
+					AsciiFolding:   true,
+					Stemmer:        models.AnalyzerConfigStemmerGerman,
+					StopwordPreset: "de",
+				},
+				"body": {Tokenization: models.PropertyTokenizationWhitespace},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with invalid analyzers", func(t *testing.T) {
+		tests := []struct {
+			analyzer    models.AnalyzerConfig
+			expectedErr string
+		}{
+			{
+				analyzer:    models.AnalyzerConfig{Stemmer: "klingon"},
+				expectedErr: "analyzers.title: analyzer stemmer 'klingon' does not exist",
+			},
+			{
+				analyzer:    models.AnalyzerConfig{StopwordPreset: "DNE"},
+				expectedErr: "analyzers.title: analyzer stopwordPreset 'DNE' does not exist",
+			},
+			{
+				analyzer:    models.AnalyzerConfig{Tokenization: "sentence"},
+				expectedErr: "analyzers.title: analyzer tokenization 'sentence' does not exist",
+			},
+		}
+
+		for _, test := range tests {
+			in := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{"title": test.analyzer},
+			}
+
+			err := ValidateConfig(in)
+			assert.EqualError(t, err, test.expectedErr)
+		}
+	})
+
+	t.Run("with valid synonyms", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Synonyms: []*models.SynonymSet{
+				{Terms: []string{"tylenol", "acetaminophen", "paracetamol"}, Weight: ptFloat32(0.8)},
+				{Terms: []string{"aspirin", "acetylsalicylic acid"}},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with invalid synonyms", func(t *testing.T) {
+		tests := []struct {
+			set         *models.SynonymSet
+			expectedErr string
+		}{
+			{
+				set:         nil,
+				expectedErr: "synonyms.0 must not be empty",
+			},
+			{
+				set:         &models.SynonymSet{Terms: []string{"tylenol"}},
+				expectedErr: "synonyms.0 must contain at least two terms",
+			},
+			{
+				set:         &models.SynonymSet{Terms: []string{"tylenol", " "}},
+				expectedErr: "synonyms.0.terms must not contain empty terms",
+			},
+			{
+				set:         &models.SynonymSet{Terms: []string{"tylenol", "acetaminophen"}, Weight: ptFloat32(1.5)},
+				expectedErr: "synonyms.0.weight must be > 0 and <= 1",
+			},
+			{
+				set:         &models.SynonymSet{Terms: []string{"tylenol", "acetaminophen"}, Weight: ptFloat32(0)},
+				expectedErr: "synonyms.0.weight must be > 0 and <= 1",
+			},
+		}
+
+		for _, test := range tests {
+			in := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{test.set},
+			}
+
+			err := ValidateConfig(in)
+			assert.EqualError(t, err, test.expectedErr)
+		}
+	})
 }
 
 func TestConfigFromModel(t *testing.T) {
@@ -36,6 +36,11 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateSynonymsConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -65,9 +70,30 @@ func validateInvertedIndexConfigUpdate(initial, updated *models.InvertedIndexCon
 		return errors.New("IndexNullState cannot be changed when updating a schema")
 	}
 
+	// the terms in the inverted index were produced by the analyzers, changing
+	// them would make existing objects unsearchable
+	if updated.Analyzers == nil {
+		updated.Analyzers = initial.Analyzers
+	}
+	if !analyzersEqual(initial.Analyzers, updated.Analyzers) {
+		return errors.New("Analyzers cannot be changed when updating a schema")
+	}
+
 	return nil
 }
 
+func analyzersEqual(a, b map[string]models.AnalyzerConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for propName, analyzer := range a {
+		if other, ok := b[propName]; !ok || other != analyzer {
+			return false
+		}
+	}
+	return true
+}
+
 func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
 	if updated.Stopwords == nil {
 		updated.Stopwords = &models.StopwordConfig{
@@ -85,3 +111,14 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+// synonyms are only applied to the query, so unlike analyzers they can be
+// changed freely without reindexing
+func validateSynonymsConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return validateSynonymsConfig(updated.Synonyms)
+}
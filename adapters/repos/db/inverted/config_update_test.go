@@ -138,4 +138,109 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		err := ValidateUserConfigUpdate(validInitial, updated)
 		require.EqualError(t, err, "IndexPropertyLength cannot be changed when updating a schema")
 	})
+
+	t.Run("with analyzers", func(t *testing.T) {
+		analyzers := map[string]models.AnalyzerConfig{
+			"title": {Lowercase: true, Stemmer: models.AnalyzerConfigStemmerEnglish},
+		}
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Analyzers: analyzers,
+		}
+
+		t.Run("missing in update", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Equal(t, analyzers, updated.Analyzers)
+		})
+
+		t.Run("unchanged", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"title": {Lowercase: true, Stemmer: models.AnalyzerConfigStemmerEnglish},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+		})
+
+		t.Run("changed", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"title": {Lowercase: true, Stemmer: models.AnalyzerConfigStemmerGerman},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, "Analyzers cannot be changed when updating a schema")
+		})
+
+		t.Run("added", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: map[string]models.AnalyzerConfig{
+					"title": {Lowercase: true, Stemmer: models.AnalyzerConfigStemmerEnglish},
+					"body":  {Lowercase: true},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(validInitial, updated)
+			require.EqualError(t, err, "Analyzers cannot be changed when updating a schema")
+		})
+	})
+
+	t.Run("with updated synonyms", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Synonyms: []*models.SynonymSet{
+				{Terms: []string{"tylenol", "acetaminophen"}},
+			},
+		}
+
+		t.Run("missing", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Equal(t, initial.Synonyms, updated.Synonyms)
+		})
+
+		t.Run("changed", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{
+					{Terms: []string{"tylenol", "acetaminophen", "paracetamol"}, Weight: ptFloat32(0.8)},
+					{Terms: []string{"aspirin", "asa"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Len(t, updated.Synonyms, 2)
+		})
+
+		t.Run("removed", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Empty(t, updated.Synonyms)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{
+					{Terms: []string{"tylenol"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, "synonyms.0 must contain at least two terms")
+		})
+	})
 }
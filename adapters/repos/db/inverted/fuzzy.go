@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+const (
+	// MaxFuzziness is the largest edit distance supported for fuzzy matching
+	MaxFuzziness = filters.MaxFuzzyDistance
+	// MaxFuzzyExpansions limits the number of index terms a single query term
+	// of a fuzzy bm25 query is expanded to
+	MaxFuzzyExpansions = 50
+)
+
+// AutoFuzziness returns the edit distance allowed for a term of the given
+// length, so that short terms do not match almost any other short term:
+// none for terms of up to 2 characters, 1 for up to 5 characters and 2 for
+// longer terms
+func AutoFuzziness(term []byte) int {
+	switch n := utf8.RuneCount(term); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return MaxFuzziness
+	}
+}
+
+// fuzzyDistance returns the explicit edit distance if set, and the one allowed
+// for the length of the term otherwise
+func fuzzyDistance(term []byte, fuzziness int) int {
+	if fuzziness > 0 {
+		return fuzziness
+	}
+	return AutoFuzziness(term)
+}
+
+// fuzzyWeight is the weight of a fuzzy bm25 match relative to an exact match
+// of the query term, so that the score decreases with the edit distance
+func fuzzyWeight(distance int) float32 {
+	return 1 - float32(distance)/float32(MaxFuzziness+1)
+}
+
+// levenshteinAutomaton accepts all inputs within a maximum Levenshtein
+// distance of a term. Its states are the rows of the dynamic programming
+// matrix, so that the state after a prefix of an input can be reused for all
+// inputs sharing that prefix.
+type levenshteinAutomaton struct {
+	term        []rune
+	maxDistance int
+}
+
+func newLevenshteinAutomaton(term []byte, maxDistance int) *levenshteinAutomaton {
+	return &levenshteinAutomaton{
+		term:        []rune(string(term)),
+		maxDistance: maxDistance,
+	}
+}
+
+func (a *levenshteinAutomaton) start() []int {
+	row := make([]int, len(a.term)+1)
+	for i := range row {
+		row[i] = i
+	}
+	return row
+}
+
+func (a *levenshteinAutomaton) step(row []int, r rune) []int {
+	next := make([]int, len(row))
+	next[0] = row[0] + 1
+	for i := 1; i < len(row); i++ {
+		cost := 1
+		if a.term[i-1] == r {
+			cost = 0
+		}
+		next[i] = minInt(row[i-1]+cost, row[i]+1, next[i-1]+1)
+	}
+	return next
+}
+
+// distance returns the edit distance between the term and the input
+func (a *levenshteinAutomaton) distance(in []byte) int {
+	row := a.start()
+	for _, r := range string(in) {
+		row = a.step(row, r)
+	}
+	return row[len(row)-1]
+}
+
+// isMatch indicates whether the input leading to the state is accepted
+func (a *levenshteinAutomaton) isMatch(row []int) bool {
+	return row[len(row)-1] <= a.maxDistance
+}
+
+// canMatch indicates whether any input starting with the input leading to the
+// state can still be accepted
+func (a *levenshteinAutomaton) canMatch(row []int) bool {
+	for _, dist := range row {
+		if dist <= a.maxDistance {
+			return true
+		}
+	}
+	return false
+}
+
+// fuzzyWalk passes all keys within the automaton's distance to readFn. The
+// keys served by first, seek and next need to be sorted, as all keys sharing a
+// prefix that can no longer match are skipped by seeking past that prefix.
+func fuzzyWalk(ctx context.Context, a *levenshteinAutomaton,
+	first func() []byte, seek func([]byte) []byte, next func() []byte,
+	readFn func(k []byte) (bool, error),
+) error {
+	// rows[i] is the state after the first i runes of the previous key, which
+	// end at byte offset ends[i-1]
+	rows := [][]int{a.start()}
+	var prevKey []byte
+	var ends []int
+
+	for k := first(); k != nil; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		common := commonRunePrefix(prevKey, k, ends)
+		rows, ends = rows[:common+1], ends[:common]
+
+		offset := 0
+		if len(ends) > 0 {
+			offset = ends[len(ends)-1]
+		}
+		dead := false
+		for offset < len(k) {
+			r, size := utf8.DecodeRune(k[offset:])
+			offset += size
+			rows = append(rows, a.step(rows[len(rows)-1], r))
+			ends = append(ends, offset)
+			if !a.canMatch(rows[len(rows)-1]) {
+				dead = true
+				break
+			}
+		}
+		prevKey = append(prevKey[:0], k...)
+
+		if dead {
+			skipTo := prefixSuccessor(k[:ends[len(ends)-1]])
+			if skipTo == nil {
+				return nil
+			}
+			k = seek(skipTo)
+			continue
+		}
+
+		if a.isMatch(rows[len(rows)-1]) {
+			if continueReading, err := readFn(k); err != nil {
+				return err
+			} else if !continueReading {
+				return nil
+			}
+		}
+		k = next()
+	}
+
+	return nil
+}
+
+// commonRunePrefix returns the number of complete runes of prev, as delimited
+// by ends, which are shared with k
+func commonRunePrefix(prev, k []byte, ends []int) int {
+	shared := 0
+	for shared < len(prev) && shared < len(k) && prev[shared] == k[shared] {
+		shared++
+	}
+
+	n := 0
+	for n < len(ends) && ends[n] <= shared {
+		n++
+	}
+	return n
+}
+
+// prefixSuccessor returns the smallest key which is larger than all keys
+// starting with prefix, or nil if there is none
+func prefixSuccessor(prefix []byte) []byte {
+	out := make([]byte, len(prefix))
+	copy(out, prefix)
+	for i := len(out) - 1; i >= 0; i-- {
+		if out[i] < 0xff {
+			out[i]++
+			return out[:i+1]
+		}
+	}
+	return nil
+}
+
+func minInt(values ...int) int {
+	out := values[0]
+	for _, v := range values[1:] {
+		if v < out {
+			out = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoFuzziness(t *testing.T) {
+	assert.Equal(t, 0, AutoFuzziness([]byte("ab")))
+	assert.Equal(t, 1, AutoFuzziness([]byte("abc")))
+	assert.Equal(t, 1, AutoFuzziness([]byte("apple")))
+	assert.Equal(t, 2, AutoFuzziness([]byte("iphone")))
+	// runes, not bytes, are counted
+	assert.Equal(t, 0, AutoFuzziness([]byte("äö")))
+}
+
+func TestFuzzyDistanceAndWeight(t *testing.T) {
+	// an explicit distance takes precedence over the length of the term
+	assert.Equal(t, 2, fuzzyDistance([]byte("apple"), 2))
+	assert.Equal(t, 1, fuzzyDistance([]byte("apple"), 0))
+	assert.Equal(t, 1, fuzzyDistance([]byte("ab"), 1))
+
+	assert.Equal(t, float32(1), fuzzyWeight(0))
+	assert.Greater(t, fuzzyWeight(1), fuzzyWeight(2))
+	assert.Greater(t, fuzzyWeight(MaxFuzziness), float32(0))
+
+	a := newLevenshteinAutomaton([]byte("iphone"), 2)
+	assert.Equal(t, 0, a.distance([]byte("iphone")))
+	assert.Equal(t, 1, a.distance([]byte("iphones")))
+	assert.Equal(t, 2, a.distance([]byte("iphnoe")))
+}
+
+func TestLevenshteinAutomaton(t *testing.T) {
+	distance := func(a *levenshteinAutomaton, term string) ([]int, bool) {
+		row := a.start()
+		for _, r := range term {
+			row = a.step(row, r)
+		}
+		return row, a.isMatch(row)
+	}
+
+	tests := []struct {
+		term     string
+		distance int
+		expected bool
+	}{
+		{"iphone", 0, true},
+		{"iphnoe", 2, true},
+		{"iphnoe", 1, false},
+		{"iphon", 1, true},
+		{"iphones", 1, true},
+		{"phone", 1, true},
+		{"ipad", 2, false},
+		{"", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			a := newLevenshteinAutomaton([]byte("iphone"), tt.distance)
+			_, ok := distance(a, tt.term)
+			assert.Equal(t, tt.expected, ok)
+		})
+	}
+
+	t.Run("dead prefixes cannot match", func(t *testing.T) {
+		a := newLevenshteinAutomaton([]byte("iphone"), 1)
+		row, _ := distance(a, "xy")
+		assert.False(t, a.canMatch(row))
+		row, _ = distance(a, "ip")
+		assert.True(t, a.canMatch(row))
+	})
+}
+
+func TestFuzzyWalk(t *testing.T) {
+	keys := [][]byte{
+		[]byte("apple"), []byte("iphnoe"), []byte("iphone"), []byte("iphones"),
+		[]byte("iphoneeee"), []byte("ipod"), []byte("xylophone"), []byte("zebra"),
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+
+	pos := 0
+	seeks := 0
+	visited := 0
+	at := func() []byte {
+		if pos >= len(keys) {
+			return nil
+		}
+		visited++
+		return keys[pos]
+	}
+	first := func() []byte { pos = 0; return at() }
+	next := func() []byte { pos++; return at() }
+	seek := func(key []byte) []byte {
+		seeks++
+		pos = sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], key) >= 0 })
+		return at()
+	}
+
+	t.Run("collects matching terms", func(t *testing.T) {
+		var matches []string
+		a := newLevenshteinAutomaton([]byte("iphone"), 2)
+		err := fuzzyWalk(context.Background(), a, first, seek, next, func(k []byte) (bool, error) {
+			matches = append(matches, string(k))
+			return true, nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"iphnoe", "iphone", "iphones"}, matches)
+		// dead prefixes such as "a" and "x" are skipped with a seek rather
+		// than visiting every key
+		assert.Greater(t, seeks, 0)
+		assert.Less(t, visited, len(keys)+seeks)
+	})
+
+	t.Run("stops when readFn returns false", func(t *testing.T) {
+		var matches []string
+		a := newLevenshteinAutomaton([]byte("iphone"), 2)
+		err := fuzzyWalk(context.Background(), a, first, seek, next, func(k []byte) (bool, error) {
+			matches = append(matches, string(k))
+			return false, nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"iphnoe"}, matches)
+	})
+}
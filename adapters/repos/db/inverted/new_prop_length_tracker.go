@@ -208,6 +208,27 @@ func (t *JsonPropertyLengthTracker) UnTrackProperty(propName string, value float
 	return nil
 }
 
+// Replaces all values of the property, given as the number of values per length
+func (t *JsonPropertyLengthTracker) ReplaceProperty(propName string, countsByLength map[int]int) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.data == nil {
+		t.data = &PropLenData{make(map[string]map[int]int), make(map[string]int), make(map[string]int)}
+	}
+
+	sum, count := 0, 0
+	buckets := make(map[int]int, 64+1)
+	for length, n := range countsByLength {
+		sum += length * n
+		count += n
+		buckets[t.bucketFromValue(float32(length))] += n
+	}
+	t.data.SumData[propName] = sum
+	t.data.CountData[propName] = count
+	t.data.BucketedData[propName] = buckets
+}
+
 // Returns the bucket that the given value belongs to
 func (t *JsonPropertyLengthTracker) bucketFromValue(value float32) int {
 	if t.UnlimitedBuckets {
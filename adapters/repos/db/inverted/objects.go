@@ -207,6 +207,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 	var items []Countable
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
+	hasPositionIndex := HasPositionIndex(prop)
 
 	switch dt := schema.DataType(prop.DataType[0]); dt {
 	case schema.DataTypeTextArray:
@@ -215,7 +216,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		items = a.textArray(prop, in)
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -327,6 +328,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		Length:             len(values),
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
+		HasPositionIndex:   hasPositionIndex,
 	}, nil
 }
 
@@ -347,6 +349,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 	propertyLength := -1 // will be overwritten for string/text, signals not to add the other types.
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
+	hasPositionIndex := HasPositionIndex(prop)
 
 	switch dt := schema.DataType(prop.DataType[0]); dt {
 	case schema.DataTypeText:
@@ -355,7 +358,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		items = a.textArray(prop, []string{asString})
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -450,6 +453,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		Length:             propertyLength,
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
+		HasPositionIndex:   hasPositionIndex,
 	}, nil
 }
 
@@ -593,8 +597,21 @@ func HasFilterableIndex(prop *models.Property) bool {
 	return *prop.IndexFilterable
 }
 
+// Indicates whether property should be indexed
+// Index holds document ids with positions of particular value within property
+// (index created using bucket of StrategyMapCollection)
+func HasPositionIndex(prop *models.Property) bool {
+	switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		// by default property has no position index
+		return prop.IndexPositions != nil && *prop.IndexPositions
+	default:
+		return false
+	}
+}
+
 func HasInvertedIndex(prop *models.Property) bool {
-	return HasFilterableIndex(prop) || HasSearchableIndex(prop)
+	return HasFilterableIndex(prop) || HasSearchableIndex(prop) || HasPositionIndex(prop)
 }
 
 const (
@@ -22,12 +22,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
 func TestAnalyzeObject(t *testing.T) {
-	a := NewAnalyzer(nil)
+	a := NewAnalyzer(nil, nil)
 
 	t.Run("with multiple properties", func(t *testing.T) {
 		id1 := uuid.New()
@@ -929,3 +930,126 @@ func mustGetByteFloatNumber(in float64) []byte {
 	}
 	return out
 }
+
+func TestAnalyzeObjectWithAnalyzerChains(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Analyzers: map[string]models.AnalyzerConfig{
+				"title": {
+					AsciiFolding:   true,
+					Stemmer:        models.AnalyzerConfigStemmerSpanish,
+					StopwordPreset: "es",
+				},
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	chains, err := analysis.NewChains(class)
+	require.Nil(t, err)
+
+	props, err := NewAnalyzer(nil, chains).Object(map[string]any{
+		"title": "Las canciones y la canción",
+		"tags":  []any{"canciones"},
+	}, class.Properties, "2609f1bc-7693-48f3-b531-6ddc52cd2501")
+	require.Nil(t, err)
+
+	byName := map[string]Property{}
+	for _, prop := range props {
+		byName[prop.Name] = prop
+	}
+
+	t.Run("property with analyzer", func(t *testing.T) {
+		title := byName["title"]
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("cancion"), TermFrequency: 2},
+		}, title.Items)
+		assert.Equal(t, 26, title.Length)
+	})
+
+	t.Run("property without analyzer", func(t *testing.T) {
+		tags := byName["tags"]
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("canciones"), TermFrequency: 1},
+		}, tags.Items)
+	})
+}
+
+func TestAnalyzeObjectWithPositions(t *testing.T) {
+	vTrue := true
+	class := &models.Class{
+		Class: "Contract",
+		Properties: []*models.Property{
+			{
+				Name:           "body",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "clauses",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+
+	props, err := NewAnalyzer(nil, nil).Object(map[string]any{
+		"body":    "The party of the first part",
+		"clauses": []any{"force majeure", "majeure event"},
+		"title":   "Lease of the party",
+	}, class.Properties, "2609f1bc-7693-48f3-b531-6ddc52cd2501")
+	require.Nil(t, err)
+
+	byName := map[string]Property{}
+	for _, prop := range props {
+		byName[prop.Name] = prop
+	}
+
+	t.Run("text property", func(t *testing.T) {
+		body := byName["body"]
+		assert.True(t, body.HasPositionIndex)
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("the"), TermFrequency: 2, Positions: []uint32{0, 3}},
+			{Data: []byte("party"), TermFrequency: 1, Positions: []uint32{1}},
+			{Data: []byte("of"), TermFrequency: 1, Positions: []uint32{2}},
+			{Data: []byte("first"), TermFrequency: 1, Positions: []uint32{4}},
+			{Data: []byte("part"), TermFrequency: 1, Positions: []uint32{5}},
+		}, body.Items)
+	})
+
+	t.Run("text array property", func(t *testing.T) {
+		clauses := byName["clauses"]
+		assert.True(t, clauses.HasPositionIndex)
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("force"), TermFrequency: 1, Positions: []uint32{0}},
+			{Data: []byte("majeure"), TermFrequency: 2, Positions: []uint32{1, 2 + PositionsGap}},
+			{Data: []byte("event"), TermFrequency: 1, Positions: []uint32{3 + PositionsGap}},
+		}, clauses.Items)
+	})
+
+	t.Run("property without positions", func(t *testing.T) {
+		title := byName["title"]
+		assert.False(t, title.HasPositionIndex)
+		for _, item := range title.Items {
+			assert.Nil(t, item.Positions)
+		}
+	})
+}
@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// MaxPhraseDistance is the largest distance accepted for proximity queries.
+// It is smaller than PositionsGap, so that the terms of a proximity query can
+// never match across the elements of a text array
+const MaxPhraseDistance = PositionsGap - 1
+
+// Phrase is a sequence of terms that need to occur close to each other within
+// a property. It is served by the position index of the property.
+type Phrase struct {
+	Terms []string
+	// Distance is the maximum number of positions between the first and the
+	// last matched term, which may then occur in any order. If 0, the terms
+	// need to occur adjacent and in the given order.
+	Distance int
+}
+
+func (p Phrase) Validate() error {
+	if len(p.Terms) == 0 {
+		return fmt.Errorf("phrase has no terms")
+	}
+	if p.Distance < 0 || p.Distance > MaxPhraseDistance {
+		return fmt.Errorf("phrase distance must be between 0 and %d, got %d",
+			MaxPhraseDistance, p.Distance)
+	}
+	return nil
+}
+
+// AnalyzePhrase splits the text of a phrase into terms the same way the
+// values of the property were split when their positions were indexed
+func AnalyzePhrase(chain *analysis.Chain, tokenization, text string) []string {
+	if chain != nil {
+		return chain.Analyze(text)
+	}
+	return helpers.Tokenize(tokenization, text)
+}
+
+// EncodePositions serializes the positions of a term within a property as
+// consecutive little-endian uint32 values
+func EncodePositions(positions []uint32) []byte {
+	out := make([]byte, 4*len(positions))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint32(out[i*4:], pos)
+	}
+	return out
+}
+
+// DecodePositions is the inverse of EncodePositions
+func DecodePositions(in []byte) ([]uint32, error) {
+	if len(in)%4 != 0 {
+		return nil, fmt.Errorf("positions have invalid length %d", len(in))
+	}
+	out := make([]uint32, len(in)/4)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint32(in[i*4:])
+	}
+	return out, nil
+}
+
+// PhraseDocIDs returns the ids of all documents containing the phrase, read
+// from a bucket of the position index
+func PhraseDocIDs(b *lsmkv.Bucket, phrase Phrase) (*sroar.Bitmap, error) {
+	if err := phrase.Validate(); err != nil {
+		return nil, err
+	}
+
+	terms := phrase.Terms
+	if phrase.Distance > 0 {
+		terms = uniqueTerms(terms)
+	}
+
+	// positions of every distinct term, per document containing all terms
+	var candidates map[uint64]map[string][]uint32
+	read := map[string]struct{}{}
+	for _, term := range terms {
+		if _, ok := read[term]; ok {
+			continue
+		}
+		read[term] = struct{}{}
+
+		pairs, err := b.MapList([]byte(term))
+		if err != nil {
+			return nil, fmt.Errorf("read positions of term %q: %w", term, err)
+		}
+
+		next := make(map[uint64]map[string][]uint32, len(pairs))
+		for _, pair := range pairs {
+			docID := binary.BigEndian.Uint64(pair.Key)
+			var byTerm map[string][]uint32
+			if candidates == nil {
+				byTerm = map[string][]uint32{}
+			} else if byTerm = candidates[docID]; byTerm == nil {
+				continue
+			}
+
+			positions, err := DecodePositions(pair.Value)
+			if err != nil {
+				return nil, fmt.Errorf("term %q of doc %d: %w", term, docID, err)
+			}
+			byTerm[term] = positions
+			next[docID] = byTerm
+		}
+
+		candidates = next
+		if len(candidates) == 0 {
+			break
+		}
+	}
+
+	out := sroar.NewBitmap()
+docs:
+	for docID, byTerm := range candidates {
+		positions := make([][]uint32, len(terms))
+		for i, term := range terms {
+			if positions[i] = byTerm[term]; len(positions[i]) == 0 {
+				continue docs
+			}
+		}
+
+		if phrase.Distance == 0 && matchesExact(positions) ||
+			phrase.Distance > 0 && matchesWithin(positions, phrase.Distance) {
+			out.Set(docID)
+		}
+	}
+	return out, nil
+}
+
+// matchesExact checks whether there is a position p, so that the i-th term
+// occurs at p+i. Positions are sorted in ascending order.
+func matchesExact(positions [][]uint32) bool {
+outer:
+	for _, start := range positions[0] {
+		for i := 1; i < len(positions); i++ {
+			if !containsPosition(positions[i], start+uint32(i)) {
+				continue outer
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func containsPosition(positions []uint32, needle uint32) bool {
+	lo, hi := 0, len(positions)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if positions[mid] < needle {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo < len(positions) && positions[lo] == needle
+}
+
+// matchesWithin checks whether one position of every term can be picked, so
+// that the distance between the smallest and the largest of them does not
+// exceed the given distance. Positions are sorted in ascending order.
+func matchesWithin(positions [][]uint32, distance int) bool {
+	cursors := make([]int, len(positions))
+	for {
+		minI := 0
+		minPos, maxPos := positions[0][cursors[0]], positions[0][cursors[0]]
+		for i := 1; i < len(positions); i++ {
+			pos := positions[i][cursors[i]]
+			if pos < minPos {
+				minI, minPos = i, pos
+			}
+			if pos > maxPos {
+				maxPos = pos
+			}
+		}
+
+		if int(maxPos-minPos) <= distance {
+			return true
+		}
+
+		// the window can only shrink by moving past the smallest position
+		cursors[minI]++
+		if cursors[minI] == len(positions[minI]) {
+			return false
+		}
+	}
+}
+
+func uniqueTerms(terms []string) []string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		out = append(out, term)
+	}
+	return out
+}
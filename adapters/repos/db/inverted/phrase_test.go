@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestPhraseMatching(t *testing.T) {
+	t.Run("exact", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			positions [][]uint32
+			expected  bool
+		}{
+			{"adjacent in order", [][]uint32{{3}, {4}}, true},
+			{"adjacent in reverse order", [][]uint32{{4}, {3}}, false},
+			{"not adjacent", [][]uint32{{3}, {5}}, false},
+			{"second occurrence matches", [][]uint32{{1, 7, 20}, {3, 8}}, true},
+			{"three terms", [][]uint32{{2, 10}, {11}, {5, 12}}, true},
+			{"repeated term", [][]uint32{{4, 5}, {4, 5}}, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, matchesExact(tt.positions))
+			})
+		}
+	})
+
+	t.Run("within", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			positions [][]uint32
+			distance  int
+			expected  bool
+		}{
+			{"adjacent", [][]uint32{{3}, {4}}, 1, true},
+			{"reverse order", [][]uint32{{9}, {6}}, 3, true},
+			{"too far apart", [][]uint32{{1}, {6}}, 4, false},
+			{"exactly at distance", [][]uint32{{1}, {5}}, 4, true},
+			{"later window matches", [][]uint32{{1, 30}, {10, 32}, {20, 31}}, 2, true},
+			{"no window matches", [][]uint32{{1, 30}, {10, 40}, {20, 50}}, 5, false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, matchesWithin(tt.positions, tt.distance))
+			})
+		}
+	})
+}
+
+func TestPositionsSerialization(t *testing.T) {
+	positions := []uint32{0, 1, 17, 1 << 20}
+
+	decoded, err := DecodePositions(EncodePositions(positions))
+	require.Nil(t, err)
+	assert.Equal(t, positions, decoded)
+
+	_, err = DecodePositions([]byte{1, 2, 3})
+	assert.NotNil(t, err)
+}
+
+func TestPhraseValidate(t *testing.T) {
+	assert.Nil(t, Phrase{Terms: []string{"a", "b"}}.Validate())
+	assert.Nil(t, Phrase{Terms: []string{"a", "b"}, Distance: MaxPhraseDistance}.Validate())
+	assert.NotNil(t, Phrase{}.Validate())
+	assert.NotNil(t, Phrase{Terms: []string{"a"}, Distance: -1}.Validate())
+	assert.NotNil(t, Phrase{Terms: []string{"a"}, Distance: PositionsGap}.Validate())
+}
+
+func TestParseQueryPhrases(t *testing.T) {
+	tests := []struct {
+		query           string
+		expectedQuery   string
+		expectedPhrases []filters.TextProximity
+	}{
+		{
+			query:         "machine learning",
+			expectedQuery: "machine learning",
+		},
+		{
+			query:           `"machine learning" models`,
+			expectedQuery:   " machine learning  models",
+			expectedPhrases: []filters.TextProximity{{Text: "machine learning"}},
+		},
+		{
+			query:         `"statute of limitations"~5 contract "force majeure"`,
+			expectedQuery: " statute of limitations  contract  force majeure ",
+			expectedPhrases: []filters.TextProximity{
+				{Text: "statute of limitations", Distance: 5},
+				{Text: "force majeure"},
+			},
+		},
+		{
+			query:         `"" empty`,
+			expectedQuery: "  empty",
+		},
+		{
+			query:         `unbalanced "quote`,
+			expectedQuery: `unbalanced "quote`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, phrases := parseQueryPhrases(tt.query)
+			assert.Equal(t, tt.expectedQuery, query)
+			assert.Equal(t, tt.expectedPhrases, phrases)
+		})
+	}
+}
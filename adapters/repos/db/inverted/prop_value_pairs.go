@@ -33,7 +33,13 @@ type propValuePair struct {
 
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
+	valueGeoRange *filters.GeoRange
+	// only set if operator=OperatorContainsPhrase or OperatorWithinWords, as
+	// those are served by the position index of the property
+	phrase *Phrase
+	// only set if operator=OperatorFuzzy with an explicit edit distance,
+	// otherwise the distance is derived from the length of the value
+	fuzziness          int
 	docIDs             docBitmap
 	children           []*propValuePair
 	hasFilterableIndex bool
@@ -47,7 +53,9 @@ func newPropValuePair() propValuePair {
 func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 	if pv.operator.OnValue() {
 		var bucketName string
-		if pv.hasFilterableIndex {
+		if pv.phrase != nil {
+			bucketName = helpers.BucketPositionsFromPropNameLSM(pv.prop)
+		} else if pv.hasFilterableIndex {
 			bucketName = helpers.BucketFromPropNameLSM(pv.prop)
 		} else if pv.hasSearchableIndex {
 			bucketName = helpers.BucketSearchableFromPropNameLSM(pv.prop)
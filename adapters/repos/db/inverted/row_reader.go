@@ -26,6 +26,8 @@ type RowReader struct {
 	value    []byte
 	bucket   *lsmkv.Bucket
 	operator filters.Operator
+	// edit distance of the Fuzzy operator, 0 derives it from the value
+	fuzziness int
 
 	keyOnly bool
 }
@@ -79,6 +81,8 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
 	default:
@@ -246,6 +250,23 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 	return nil
 }
 
+// fuzzy reads all rows whose key is within the edit distance set for the
+// reader, or allowed for the length of the value if none is set
+func (rr *RowReader) fuzzy(ctx context.Context, readFn ReadFn) error {
+	c := rr.newCursor()
+	defer c.Close()
+
+	var v [][]byte
+	first := func() (k []byte) { k, v = c.First(); return }
+	seek := func(key []byte) (k []byte) { k, v = c.Seek(key); return }
+	next := func() (k []byte) { k, v = c.Next(); return }
+
+	a := newLevenshteinAutomaton(rr.value, fuzzyDistance(rr.value, rr.fuzziness))
+	return fuzzyWalk(ctx, a, first, seek, next, func(k []byte) (bool, error) {
+		return readFn(k, v)
+	})
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReader) newCursor() *lsmkv.CursorSet {
@@ -28,6 +28,8 @@ type RowReaderFrequency struct {
 	operator     filters.Operator
 	keyOnly      bool
 	shardVersion uint16
+	// edit distance of the Fuzzy operator, 0 derives it from the value
+	fuzziness int
 }
 
 func NewRowReaderFrequency(bucket *lsmkv.Bucket, value []byte,
@@ -74,6 +76,8 @@ func (rr *RowReaderFrequency) Read(ctx context.Context, readFn ReadFnFrequency)
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v supported", rr.operator)
 	}
@@ -253,6 +257,23 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 	return nil
 }
 
+// fuzzy reads all rows whose key is within the edit distance set for the
+// reader, or allowed for the length of the value if none is set
+func (rr *RowReaderFrequency) fuzzy(ctx context.Context, readFn ReadFnFrequency) error {
+	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
+	defer c.Close()
+
+	var v []lsmkv.MapPair
+	first := func() (k []byte) { k, v = c.First(); return }
+	seek := func(key []byte) (k []byte) { k, v = c.Seek(key); return }
+	next := func() (k []byte) { k, v = c.Next(); return }
+
+	a := newLevenshteinAutomaton(rr.value, fuzzyDistance(rr.value, rr.fuzziness))
+	return fuzzyWalk(ctx, a, first, seek, next, func(k []byte) (bool, error) {
+		return readFn(k, v)
+	})
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReaderFrequency) newCursor(
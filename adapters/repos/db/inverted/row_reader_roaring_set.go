@@ -29,6 +29,8 @@ type RowReaderRoaringSet struct {
 	operator  filters.Operator
 	newCursor func() lsmkv.CursorRoaringSet
 	getter    func(key []byte) (*sroar.Bitmap, error)
+	// edit distance of the Fuzzy operator, 0 derives it from the value
+	fuzziness int
 }
 
 // If keyOnly is set, the RowReaderRoaringSet will request key-only cursors
@@ -85,6 +87,8 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn RoaringSetReadFn
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
 	}
@@ -244,3 +248,22 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 
 	return nil
 }
+
+// fuzzy reads all rows whose key is within the edit distance set for the
+// reader, or allowed for the length of the value if none is set
+func (rr *RowReaderRoaringSet) fuzzy(ctx context.Context,
+	readFn RoaringSetReadFn,
+) error {
+	c := rr.newCursor()
+	defer c.Close()
+
+	var v *sroar.Bitmap
+	first := func() (k []byte) { k, v = c.First(); return }
+	seek := func(key []byte) (k []byte) { k, v = c.Seek(key); return }
+	next := func() (k []byte) { k, v = c.Next(); return }
+
+	a := newLevenshteinAutomaton(rr.value, fuzzyDistance(rr.value, rr.fuzziness))
+	return fuzzyWalk(ctx, a, first, seek, next, func(k []byte) (bool, error) {
+		return readFn(k, v)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
@@ -261,7 +262,7 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 
 	if s.onTokenizableProp(property) {
 		return s.extractTokenizableProp(property, filter.Value.Type, filter.Value.Value,
-			filter.Operator)
+			filter.Operator, className)
 	}
 
 	return s.extractPrimitiveProp(property, filter.Value.Type, filter.Value.Value,
@@ -482,17 +483,40 @@ func (s *Searcher) extractTimestampProp(propName string, propType schema.DataTyp
 }
 
 func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema.DataType,
-	value interface{}, operator filters.Operator,
+	value interface{}, operator filters.Operator, className schema.ClassName,
 ) (*propValuePair, error) {
+	// the value needs to go through the same analyzer chain as the property
+	// values at index time
+	chain, err := analysis.ChainForProperty(s.schema.GetClass(className), prop)
+	if err != nil {
+		return nil, err
+	}
+
+	if operator == filters.OperatorContainsPhrase || operator == filters.OperatorWithinWords {
+		return s.extractPhrase(prop, chain, value, operator)
+	}
+
+	// the edit distance of a fuzzy value is derived from the length of each
+	// term, unless it is set explicitly
+	fuzziness := 0
+	if fuzzy, ok := value.(filters.TextProximity); ok && operator == filters.OperatorFuzzy {
+		value, fuzziness = fuzzy.Text, fuzzy.Distance
+	}
+
 	var terms []string
 
 	switch propType {
 	case schema.DataTypeText:
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
-		if operator == filters.OperatorLike {
+		switch {
+		case chain != nil && operator == filters.OperatorLike:
+			terms = chain.AnalyzeWithWildcards(value.(string))
+		case chain != nil:
+			terms = chain.Analyze(value.(string))
+		case operator == filters.OperatorLike:
 			terms = helpers.TokenizeWithWildcards(prop.Tokenization, value.(string))
-		} else {
+		default:
 			terms = helpers.Tokenize(prop.Tokenization, value.(string))
 		}
 	default:
@@ -508,13 +532,15 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	propValuePairs := make([]*propValuePair, 0, len(terms))
 	for _, term := range terms {
-		if s.stopwords.IsStopword(term) {
+		// stopwords of an analyzer chain are already removed by the chain
+		if chain == nil && s.stopwords.IsStopword(term) {
 			continue
 		}
 		propValuePairs = append(propValuePairs, &propValuePair{
 			value:              []byte(term),
 			prop:               prop.Name,
 			operator:           operator,
+			fuzziness:          fuzziness,
 			hasFilterableIndex: hasFilterableIndex,
 			hasSearchableIndex: hasSearchableIndex,
 		})
@@ -529,6 +555,40 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 	return nil, errors.Errorf("invalid search term, only stopwords provided. Stopwords can be configured in class.invertedIndexConfig.stopwords")
 }
 
+func (s *Searcher) extractPhrase(prop *models.Property, chain *analysis.Chain,
+	value interface{}, operator filters.Operator,
+) (*propValuePair, error) {
+	if !HasPositionIndex(prop) {
+		return nil, fmt.Errorf("operator %s requires property %q to have "+
+			"its positions indexed, set `indexPositions: true` on the property",
+			operator.Name(), prop.Name)
+	}
+
+	var phrase Phrase
+	switch typed := value.(type) {
+	case string:
+		phrase.Terms = AnalyzePhrase(chain, prop.Tokenization, typed)
+	case filters.TextProximity:
+		phrase.Terms = AnalyzePhrase(chain, prop.Tokenization, typed.Text)
+		phrase.Distance = typed.Distance
+	default:
+		return nil, fmt.Errorf("unsupported value %T for operator %s", value, operator.Name())
+	}
+
+	if len(phrase.Terms) == 0 {
+		return nil, errors.Errorf("invalid search phrase, it has no terms")
+	}
+	if err := phrase.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		prop:     prop.Name,
+		operator: operator,
+		phrase:   &phrase,
+	}, nil
+}
+
 func (s *Searcher) extractPropertyLength(prop *models.Property, propType schema.DataType,
 	value interface{}, operator filters.Operator,
 ) (*propValuePair, error) {
@@ -34,6 +34,12 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// all other operators perform operations on the inverted index which we
 	// can serve directly
 
+	if pv.phrase != nil {
+		// bucket with strategy map serves docIds and the positions of the
+		// phrase's terms within them
+		return s.docBitmapPhrase(ctx, b, pv)
+	}
+
 	if pv.hasFilterableIndex {
 		// bucket with strategy roaring set serves bitmaps directly
 		if b.Strategy() == lsmkv.StrategyRoaringSet {
@@ -53,6 +59,16 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	return docBitmap{}, fmt.Errorf("property '%s' is neither filterable nor searchable", pv.prop)
 }
 
+func (s *Searcher) docBitmapPhrase(ctx context.Context, b *lsmkv.Bucket,
+	pv *propValuePair,
+) (docBitmap, error) {
+	docIDs, err := PhraseDocIDs(b, *pv.phrase)
+	if err != nil {
+		return docBitmap{}, errors.Wrap(err, "read phrase")
+	}
+	return docBitmap{docIDs: docIDs}, nil
+}
+
 func (s *Searcher) docBitmapInvertedRoaringSet(ctx context.Context, b *lsmkv.Bucket,
 	limit int, pv *propValuePair,
 ) (docBitmap, error) {
@@ -73,6 +89,7 @@ func (s *Searcher) docBitmapInvertedRoaringSet(ctx context.Context, b *lsmkv.Buc
 	}
 
 	rr := NewRowReaderRoaringSet(b, pv.value, pv.operator, false)
+	rr.fuzziness = pv.fuzziness
 	if err := rr.Read(ctx, readFn); err != nil {
 		return out, errors.Wrap(err, "read row")
 	}
@@ -99,6 +116,7 @@ func (s *Searcher) docBitmapInvertedSet(ctx context.Context, b *lsmkv.Bucket,
 	}
 
 	rr := NewRowReader(b, pv.value, pv.operator, false)
+	rr.fuzziness = pv.fuzziness
 	if err := rr.Read(ctx, readFn); err != nil {
 		return out, errors.Wrap(err, "read row")
 	}
@@ -128,6 +146,7 @@ func (s *Searcher) docBitmapInvertedMap(ctx context.Context, b *lsmkv.Bucket,
 	}
 
 	rr := NewRowReaderFrequency(b, pv.value, pv.operator, false, s.shardVersion)
+	rr.fuzziness = pv.fuzziness
 	if err := rr.Read(ctx, readFn); err != nil {
 		return out, errors.Wrap(err, "read row")
 	}
@@ -13,6 +13,9 @@ package stopwords
 
 const (
 	EnglishPreset = "en"
+	GermanPreset  = "de"
+	FrenchPreset  = "fr"
+	SpanishPreset = "es"
 	NoPreset      = "none"
 )
 
@@ -23,5 +26,39 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "alle", "allem", "allen", "aller", "alles", "als", "also", "am", "an",
+		"ander", "andere", "anderem", "anderen", "anderer", "anderes", "auch", "auf",
+		"aus", "bei", "bin", "bis", "bist", "da", "damit", "dann", "das", "dass",
+		"dein", "deine", "dem", "den", "der", "des", "dich", "die", "dies", "diese",
+		"dieser", "dieses", "dir", "doch", "dort", "du", "durch", "ein", "eine",
+		"einem", "einen", "einer", "eines", "er", "es", "euer", "eure", "für",
+		"hat", "hatte", "hier", "ich", "ihr", "ihre", "im", "in", "ist", "jede",
+		"jeder", "jedes", "kein", "keine", "man", "mein", "meine", "mich", "mir",
+		"mit", "nach", "nicht", "noch", "nun", "nur", "ob", "oder", "ohne", "sehr",
+		"sein", "seine", "sich", "sie", "sind", "so", "über", "um", "und", "uns",
+		"unser", "unter", "vom", "von", "vor", "war", "waren", "was", "weil",
+		"wenn", "wer", "wie", "wir", "wird", "zu", "zum", "zur",
+	},
+	FrenchPreset: {
+		"à", "au", "aux", "avec", "ce", "ces", "cette", "dans", "de", "des", "du",
+		"elle", "elles", "en", "est", "et", "eux", "il", "ils", "je", "la", "le",
+		"les", "leur", "leurs", "lui", "ma", "mais", "me", "même", "mes", "moi",
+		"mon", "ne", "nos", "notre", "nous", "on", "ou", "où", "par", "pas", "pour",
+		"qu", "que", "qui", "sa", "se", "ses", "son", "sont", "sur", "ta", "te",
+		"tes", "toi", "ton", "tu", "un", "une", "vos", "votre", "vous", "c", "d",
+		"j", "l", "m", "n", "s", "t", "y", "été", "être", "avoir", "était",
+	},
+	SpanishPreset: {
+		"a", "al", "algo", "algunas", "algunos", "ante", "antes", "como", "con",
+		"contra", "cual", "cuando", "de", "del", "desde", "donde", "durante", "e",
+		"el", "él", "ella", "ellas", "ellos", "en", "entre", "era", "es", "esa",
+		"esas", "ese", "eso", "esos", "esta", "está", "estas", "este", "esto",
+		"estos", "fue", "ha", "hay", "la", "las", "le", "les", "lo", "los", "me",
+		"mi", "mis", "mucho", "muy", "más", "nada", "ni", "no", "nos", "nosotros",
+		"o", "otra", "otros", "para", "pero", "poco", "por", "porque", "que", "qué",
+		"quien", "se", "sea", "ser", "si", "sí", "sin", "sobre", "son", "su", "sus",
+		"también", "te", "tu", "tus", "un", "una", "uno", "unos", "y", "ya", "yo",
+	},
 	NoPreset: {},
 }
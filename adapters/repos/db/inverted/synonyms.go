@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// weightedTerm is a term searched in addition to a query term, such as a
+// synonym or a fuzzy variant, its frequency is scaled down by its weight
+type weightedTerm struct {
+	term   string
+	weight float32
+}
+
+// synonymsByTerm maps every analyzed term of the synonym sets to all other
+// terms of its sets. The terms are analyzed the same way as the query, entries
+// which do not result in exactly one term (e.g. multiple words with word
+// tokenization) can not be matched and are skipped.
+func synonymsByTerm(sets []*models.SynonymSet, analyze func(string) []string) map[string][]weightedTerm {
+	if len(sets) == 0 {
+		return nil
+	}
+
+	synonyms := map[string][]weightedTerm{}
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		weight := float32(1)
+		if set.Weight != nil {
+			weight = *set.Weight
+		}
+
+		terms := make([]string, 0, len(set.Terms))
+		seen := make(map[string]struct{}, len(set.Terms))
+		for _, entry := range set.Terms {
+			analyzed := analyze(entry)
+			if len(analyzed) != 1 {
+				continue
+			}
+			if _, ok := seen[analyzed[0]]; ok {
+				continue
+			}
+			seen[analyzed[0]] = struct{}{}
+			terms = append(terms, analyzed[0])
+		}
+
+		for _, term := range terms {
+			for _, other := range terms {
+				if other != term {
+					synonyms[term] = addWeightedTerm(synonyms[term], other, weight)
+				}
+			}
+		}
+	}
+
+	return synonyms
+}
+
+// addWeightedTerm adds the term unless it is already present, a term added
+// multiple times keeps the highest weight
+func addWeightedTerm(terms []weightedTerm, term string, weight float32) []weightedTerm {
+	for i := range terms {
+		if terms[i].term == term {
+			if weight > terms[i].weight {
+				terms[i].weight = weight
+			}
+			return terms
+		}
+	}
+	return append(terms, weightedTerm{term: term, weight: weight})
+}
+
+// mergeWeightedPairs combines the postings of a query term and its weighted
+// terms into a single posting list sorted by docID. The weighted frequencies of a
+// doc are summed up, the property length is taken once.
+func mergeWeightedPairs(lists [][]lsmkv.MapPair, weights []float32) []lsmkv.MapPair {
+	if len(lists) == 1 {
+		return lists[0]
+	}
+
+	type posting struct {
+		id         uint64
+		frequency  float32
+		propLength float32
+	}
+
+	postings := []posting{}
+	indices := map[uint64]int{}
+	for i, pairs := range lists {
+		for _, pair := range pairs {
+			id := binary.BigEndian.Uint64(pair.Key)
+			frequency := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[0:4])) * weights[i]
+			if ind, ok := indices[id]; ok {
+				postings[ind].frequency += frequency
+				continue
+			}
+			indices[id] = len(postings)
+			postings = append(postings, posting{
+				id:         id,
+				frequency:  frequency,
+				propLength: math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:8])),
+			})
+		}
+	}
+
+	sort.Slice(postings, func(a, b int) bool { return postings[a].id < postings[b].id })
+
+	merged := make([]lsmkv.MapPair, len(postings))
+	for i, p := range postings {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, p.id)
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(p.frequency))
+		binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(p.propLength))
+		merged[i] = lsmkv.MapPair{Key: key, Value: value}
+	}
+
+	return merged
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSynonymsByTerm(t *testing.T) {
+	analyze := func(in string) []string {
+		return helpers.Tokenize(models.PropertyTokenizationWord, in)
+	}
+
+	t.Run("without sets", func(t *testing.T) {
+		assert.Nil(t, synonymsByTerm(nil, analyze))
+	})
+
+	t.Run("sets are symmetric and weighted", func(t *testing.T) {
+		synonyms := synonymsByTerm([]*models.SynonymSet{
+			{Terms: []string{"Tylenol", "acetaminophen", "paracetamol"}, Weight: ptFloat32(0.8)},
+			{Terms: []string{"aspirin", "ASA"}},
+		}, analyze)
+
+		assert.ElementsMatch(t, []weightedTerm{
+			{term: "acetaminophen", weight: 0.8},
+			{term: "paracetamol", weight: 0.8},
+		}, synonyms["tylenol"])
+		assert.ElementsMatch(t, []weightedTerm{
+			{term: "tylenol", weight: 0.8},
+			{term: "acetaminophen", weight: 0.8},
+		}, synonyms["paracetamol"])
+		// weight defaults to 1
+		assert.Equal(t, []weightedTerm{{term: "asa", weight: 1}}, synonyms["aspirin"])
+		assert.Nil(t, synonyms["ibuprofen"])
+	})
+
+	t.Run("terms in multiple sets keep the highest weight", func(t *testing.T) {
+		synonyms := synonymsByTerm([]*models.SynonymSet{
+			{Terms: []string{"tylenol", "paracetamol"}, Weight: ptFloat32(0.5)},
+			{Terms: []string{"tylenol", "paracetamol", "acetaminophen"}, Weight: ptFloat32(0.9)},
+		}, analyze)
+
+		assert.ElementsMatch(t, []weightedTerm{
+			{term: "paracetamol", weight: 0.9},
+			{term: "acetaminophen", weight: 0.9},
+		}, synonyms["tylenol"])
+	})
+
+	t.Run("entries analyzed into multiple terms are skipped", func(t *testing.T) {
+		synonyms := synonymsByTerm([]*models.SynonymSet{
+			{Terms: []string{"aspirin", "acetylsalicylic acid", "ASA", "asa"}},
+		}, analyze)
+
+		assert.Equal(t, []weightedTerm{{term: "asa", weight: 1}}, synonyms["aspirin"])
+		assert.Nil(t, synonyms["acetylsalicylic"])
+	})
+}
+
+func TestMergeSynonymPairs(t *testing.T) {
+	pair := func(id uint64, frequency, propLength float32) lsmkv.MapPair {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, id)
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(frequency))
+		binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(propLength))
+		return lsmkv.MapPair{Key: key, Value: value}
+	}
+
+	t.Run("single list is returned as is", func(t *testing.T) {
+		list := []lsmkv.MapPair{pair(3, 1, 10)}
+		assert.Equal(t, list, mergeWeightedPairs([][]lsmkv.MapPair{list}, []float32{1}))
+	})
+
+	t.Run("postings are weighted and merged by doc", func(t *testing.T) {
+		merged := mergeWeightedPairs([][]lsmkv.MapPair{
+			{pair(2, 1, 10), pair(7, 2, 20)},
+			{pair(1, 2, 5), pair(7, 1, 20)},
+			{pair(7, 4, 20), pair(9, 2, 8)},
+		}, []float32{1, 0.5, 0.25})
+
+		require.Len(t, merged, 4)
+		assert.Equal(t, []lsmkv.MapPair{
+			pair(1, 1, 5),
+			pair(2, 1, 10),
+			pair(7, 3.5, 20),
+			pair(9, 0.5, 8),
+		}, merged)
+	})
+}
+
+func ptFloat32(in float32) *float32 {
+	return &in
+}
@@ -49,6 +49,8 @@ type ShardInvertedReindexer struct {
 
 	tasks []ShardInvertedReindexTask
 	class *models.Class
+	// number of objects by length of the reindexed searchable properties
+	propLengths map[string]map[int]int
 }
 
 func NewShardInvertedReindexer(shard *Shard, logger logrus.FieldLogger) *ShardInvertedReindexer {
@@ -63,6 +65,19 @@ func NewShardInvertedReindexer(shard *Shard, logger logrus.FieldLogger) *ShardIn
 	}
 }
 
+// newShardInvertedReindexerForClass analyzes the objects using the properties
+// of the given class instead of the class in the schema
+func newShardInvertedReindexerForClass(shard *Shard, class *models.Class,
+	logger logrus.FieldLogger,
+) *ShardInvertedReindexer {
+	return &ShardInvertedReindexer{
+		logger: logger,
+		shard:  shard,
+		tasks:  []ShardInvertedReindexTask{},
+		class:  class,
+	}
+}
+
 func (r *ShardInvertedReindexer) AddTask(task ShardInvertedReindexTask) {
 	r.tasks = append(r.tasks, task)
 }
@@ -80,10 +95,52 @@ func (r *ShardInvertedReindexer) Do(ctx context.Context) error {
 }
 
 func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedReindexTask) error {
+	reindexProperties, err := r.prepareTask(ctx, task)
+	if err != nil || len(reindexProperties) == 0 {
+		return err
+	}
+	return r.commitTask(ctx, task, reindexProperties)
+}
+
+// PrepareTask populates the temporary buckets of the task, but leaves the
+// existing buckets in place until commit is called, which allows replacing
+// them together with a change of the schema. The store stays paused in
+// between. If preparing fails, the temporary buckets are dropped and the
+// store is resumed.
+func (r *ShardInvertedReindexer) PrepareTask(ctx context.Context, task ShardInvertedReindexTask,
+) (commit func(success bool) error, err error) {
+	reindexProperties, err := r.prepareTask(ctx, task)
+	if err != nil {
+		if reindexProperties == nil {
+			// the store has not been paused yet
+			return nil, err
+		}
+		if abortErr := r.abortTask(context.Background(), task, reindexProperties); abortErr != nil {
+			r.logError(abortErr, "failed aborting reindex")
+		}
+		return nil, err
+	}
+
+	return func(success bool) error {
+		if len(reindexProperties) == 0 {
+			return nil
+		}
+		// the request context might be gone by the time the task is committed
+		if !success {
+			return r.abortTask(context.Background(), task, reindexProperties)
+		}
+		return r.commitTask(context.Background(), task, reindexProperties)
+	}, nil
+}
+
+// prepareTask returns the properties for which temporary buckets were
+// created. They are nil as long as the store has not been paused.
+func (r *ShardInvertedReindexer) prepareTask(ctx context.Context, task ShardInvertedReindexTask,
+) ([]ReindexableProperty, error) {
 	reindexProperties, err := task.GetPropertiesToReindex(ctx, r.shard)
 	if err != nil {
 		r.logError(err, "failed getting reindex properties")
-		return errors.Wrapf(err, "failed getting reindex properties")
+		return nil, errors.Wrapf(err, "failed getting reindex properties")
 	}
 	if len(reindexProperties) == 0 {
 		r.logger.
@@ -91,38 +148,37 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 			WithField("index", r.shard.index.ID()).
 			WithField("shard", r.shard.ID()).
 			Debug("no properties to reindex")
-		return nil
+		return nil, nil
 	}
 
 	if err := r.checkContextExpired(ctx, "pausing store stopped due to context canceled"); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := r.pauseStoreActivity(ctx); err != nil {
 		r.logError(err, "failed pausing store activity")
-		return err
+		return reindexProperties[:0], err
 	}
 
-	bucketsToReindex := make([]string, len(reindexProperties))
 	for i, reindexProperty := range reindexProperties {
 		if err := r.checkContextExpired(ctx, "creating temp buckets stopped due to context canceled"); err != nil {
-			return err
+			return reindexProperties[:i], err
 		}
 
 		if !isIndexTypeSupportedByStrategy(reindexProperty.IndexType, reindexProperty.DesiredStrategy) {
 			err := fmt.Errorf("strategy '%s' is not supported for given index type '%d",
 				reindexProperty.DesiredStrategy, reindexProperty.IndexType)
 			r.logError(err, "invalid strategy")
-			return err
+			return reindexProperties[:i], err
 		}
 
 		// TODO verify if property indeed need reindex before creating buckets
 		// (is filterable / is searchable / null or prop length index enabled)
-		bucketsToReindex[i] = r.bucketName(reindexProperty.PropertyName, reindexProperty.IndexType)
-		if err := r.createTempBucket(ctx, bucketsToReindex[i], reindexProperty.DesiredStrategy,
+		bucketName := r.bucketName(reindexProperty.PropertyName, reindexProperty.IndexType)
+		if err := r.createTempBucket(ctx, bucketName, reindexProperty.DesiredStrategy,
 			reindexProperty.BucketOptions...); err != nil {
 			r.logError(err, "failed creating temporary bucket")
-			return err
+			return reindexProperties[:i], err
 		}
 		r.logger.
 			WithField("action", "inverted reindex").
@@ -133,22 +189,30 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 			Debug("created temporary bucket")
 	}
 
+	r.propLengths = map[string]map[int]int{}
 	if err := r.reindexProperties(ctx, reindexProperties); err != nil {
 		r.logError(err, "failed reindexing properties")
-		return errors.Wrapf(err, "failed reindexing properties on shard '%s'", r.shard.name)
+		return reindexProperties, errors.Wrapf(err, "failed reindexing properties on shard '%s'", r.shard.name)
 	}
 
-	for i := range bucketsToReindex {
+	return reindexProperties, nil
+}
+
+func (r *ShardInvertedReindexer) commitTask(ctx context.Context, task ShardInvertedReindexTask,
+	reindexProperties []ReindexableProperty,
+) error {
+	for _, reindexProperty := range reindexProperties {
 		if err := r.checkContextExpired(ctx, "replacing buckets stopped due to context canceled"); err != nil {
 			return err
 		}
-		tempBucketName := helpers.TempBucketFromBucketName(bucketsToReindex[i])
+		bucketName := r.bucketName(reindexProperty.PropertyName, reindexProperty.IndexType)
+		tempBucketName := helpers.TempBucketFromBucketName(bucketName)
 		tempBucket := r.shard.store.Bucket(tempBucketName)
 		tempBucket.FlushMemtable()
 		tempBucket.UpdateStatus(storagestate.StatusReadOnly)
 
-		if reindexProperties[i].NewIndex {
-			if err := r.shard.store.RenameBucket(ctx, tempBucketName, bucketsToReindex[i]); err != nil {
+		if reindexProperty.NewIndex {
+			if err := r.shard.store.RenameBucket(ctx, tempBucketName, bucketName); err != nil {
 				r.logError(err, "failed renaming buckets")
 				return err
 			}
@@ -156,11 +220,11 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 			r.logger.
 				WithField("action", "inverted reindex").
 				WithField("shard", r.shard.name).
-				WithField("bucket", bucketsToReindex[i]).
+				WithField("bucket", bucketName).
 				WithField("temp_bucket", tempBucketName).
 				Debug("renamed bucket")
 		} else {
-			if err := r.shard.store.ReplaceBuckets(ctx, bucketsToReindex[i], tempBucketName); err != nil {
+			if err := r.shard.store.ReplaceBuckets(ctx, bucketName, tempBucketName); err != nil {
 				r.logError(err, "failed replacing buckets")
 				return err
 			}
@@ -168,12 +232,23 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 			r.logger.
 				WithField("action", "inverted reindex").
 				WithField("shard", r.shard.name).
-				WithField("bucket", bucketsToReindex[i]).
+				WithField("bucket", bucketName).
 				WithField("temp_bucket", tempBucketName).
 				Debug("replaced buckets")
 		}
 	}
 
+	// the lengths used for BM25 scoring depend on the tokenization as well
+	for propName, countsByLength := range r.propLengths {
+		r.shard.propLengths.ReplaceProperty(propName, countsByLength)
+	}
+	if len(r.propLengths) > 0 {
+		if err := r.shard.propLengths.Flush(false); err != nil {
+			r.logError(err, "failed flushing property lengths")
+			return err
+		}
+	}
+
 	if err := r.checkContextExpired(ctx, "resuming store stopped due to context canceled"); err != nil {
 		return err
 	}
@@ -186,6 +261,31 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 	return nil
 }
 
+// abortTask drops the temporary buckets created for the given properties and
+// resumes the store with its buckets unchanged
+func (r *ShardInvertedReindexer) abortTask(ctx context.Context, task ShardInvertedReindexTask,
+	reindexProperties []ReindexableProperty,
+) error {
+	for _, reindexProperty := range reindexProperties {
+		tempBucketName := helpers.TempBucketFromBucketName(
+			r.bucketName(reindexProperty.PropertyName, reindexProperty.IndexType))
+		if r.shard.store.Bucket(tempBucketName) == nil {
+			continue
+		}
+		if err := r.shard.store.DropBucket(ctx, tempBucketName); err != nil {
+			r.logError(err, "failed dropping temporary bucket")
+			return err
+		}
+	}
+
+	if err := r.resumeStoreActivity(ctx, task); err != nil {
+		r.logError(err, "failed resuming store activity")
+		return err
+	}
+
+	return nil
+}
+
 func (r *ShardInvertedReindexer) pauseStoreActivity(ctx context.Context) error {
 	if err := r.shard.store.PauseCompaction(ctx); err != nil {
 		return errors.Wrapf(err, "failed pausing compaction for shard '%s'", r.shard.name)
@@ -233,6 +333,9 @@ func (r *ShardInvertedReindexer) createTempBucket(ctx context.Context, name stri
 }
 
 func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexableProperties []ReindexableProperty) error {
+	if r.class == nil {
+		return fmt.Errorf("class '%s' not found", r.shard.index.Config.ClassName)
+	}
 	checker := newReindexablePropertyChecker(reindexableProperties, r.class)
 	objectsBucket := r.shard.store.Bucket(helpers.ObjectsBucketLSM)
 
@@ -254,7 +357,7 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 				Debugf("iterating through objects: %d done", i)
 		}
 		docID := object.DocID()
-		properties, nilProperties, err := r.shard.analyzeObject(object)
+		properties, nilProperties, err := r.shard.analyzeObjectOfClass(r.class, object)
 		if err != nil {
 			return errors.Wrapf(err, "failed analyzying object")
 		}
@@ -289,11 +392,12 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 ) error {
 	reindexablePropValue := checker.isReindexable(property.Name, IndexTypePropValue)
 	reindexablePropSearchableValue := checker.isReindexable(property.Name, IndexTypePropSearchableValue)
+	reindexablePropPositions := checker.isReindexable(property.Name, IndexTypePropPositions)
 
-	if reindexablePropValue || reindexablePropSearchableValue {
+	if reindexablePropValue || reindexablePropSearchableValue || reindexablePropPositions {
 		schemaProp := checker.getSchemaProp(property.Name)
 
-		var bucketValue, bucketSearchableValue *lsmkv.Bucket
+		var bucketValue, bucketSearchableValue, bucketPositions *lsmkv.Bucket
 
 		if reindexablePropValue {
 			bucketValue = r.tempBucket(property.Name, IndexTypePropValue)
@@ -307,6 +411,19 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 				return fmt.Errorf("no bucket searchable for prop '%s' value found", property.Name)
 			}
 		}
+		if reindexablePropPositions {
+			bucketPositions = r.tempBucket(property.Name, IndexTypePropPositions)
+			if bucketPositions == nil {
+				return fmt.Errorf("no bucket positions for prop '%s' found", property.Name)
+			}
+		}
+
+		if reindexablePropSearchableValue && inverted.HasSearchableIndex(schemaProp) {
+			if r.propLengths[property.Name] == nil {
+				r.propLengths[property.Name] = map[int]int{}
+			}
+			r.propLengths[property.Name][len(property.Items)]++
+		}
 
 		propLen := float32(len(property.Items))
 		for _, item := range property.Items {
@@ -322,6 +439,12 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 				}
 			}
+			if reindexablePropPositions && inverted.HasPositionIndex(schemaProp) {
+				pair := r.shard.pairPropertyWithPositions(docID, item.Positions)
+				if err := r.shard.addToPropertyMapBucket(bucketPositions, pair, key); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+				}
+			}
 		}
 	}
 
@@ -413,6 +536,8 @@ func (r *ShardInvertedReindexer) bucketName(propName string, indexType PropertyI
 		return helpers.BucketFromPropNameLSM(propName)
 	case IndexTypePropSearchableValue:
 		return helpers.BucketSearchableFromPropNameLSM(propName)
+	case IndexTypePropPositions:
+		return helpers.BucketPositionsFromPropNameLSM(propName)
 	case IndexTypePropLength:
 		return helpers.BucketFromPropNameLengthLSM(propName)
 	case IndexTypePropNull:
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ShardInvertedReindexTaskTokenization rebuilds the filterable, searchable
+// and position indexes of text properties using their current tokenization.
+// It is meant to be run when the stored tokens no longer match what the
+// tokenizer produces, e.g. after upgrading from a version which tokenized the
+// property differently. It runs before the shard serves any queries.
+//
+// It is also used when the tokenization of a property is changed through a
+// schema update. In that case the indexes are rebuilt with the updated class
+// before it replaces the class in the schema, see
+// Migrator.UpdatePropertyTokenization.
+type ShardInvertedReindexTaskTokenization struct {
+	// properties to reindex by class name
+	class2Props map[string][]string
+	// class to take the properties from instead of the schema, if set
+	class *models.Class
+}
+
+func NewShardInvertedReindexTaskTokenization(class2Props map[string][]string,
+) *ShardInvertedReindexTaskTokenization {
+	return &ShardInvertedReindexTaskTokenization{class2Props: class2Props}
+}
+
+// newShardInvertedReindexTaskTokenizationForClass reindexes the given
+// properties using their tokenization in class rather than in the schema
+func newShardInvertedReindexTaskTokenizationForClass(class *models.Class, propNames []string,
+) *ShardInvertedReindexTaskTokenization {
+	return &ShardInvertedReindexTaskTokenization{
+		class2Props: map[string][]string{class.Class: propNames},
+		class:       class,
+	}
+}
+
+func (t *ShardInvertedReindexTaskTokenization) GetPropertiesToReindex(ctx context.Context,
+	shard *Shard,
+) ([]ReindexableProperty, error) {
+	reindexableProperties := []ReindexableProperty{}
+
+	className := shard.index.Config.ClassName.String()
+	propNames, ok := t.class2Props[className]
+	if !ok || len(propNames) == 0 {
+		return reindexableProperties, nil
+	}
+
+	class := t.class
+	if class == nil {
+		var err error
+		class, err = schema.GetClassByName(shard.index.getSchema.GetSchemaSkipAuth().Objects,
+			className)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bucketOptions := []lsmkv.BucketOption{
+		lsmkv.WithIdleThreshold(time.Duration(shard.index.Config.MemtablesFlushIdleAfter) * time.Second),
+	}
+	searchableBucketOptions := bucketOptions
+	if shard.versioner.Version() < 2 {
+		searchableBucketOptions = append(searchableBucketOptions, lsmkv.WithLegacyMapSorting())
+	}
+
+	for _, propName := range propNames {
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+
+		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+		default:
+			// only text properties are tokenized
+			continue
+		}
+
+		if bucket := shard.store.Bucket(helpers.BucketFromPropNameLSM(propName)); bucket != nil &&
+			inverted.HasFilterableIndex(prop) {
+			reindexableProperties = append(reindexableProperties, ReindexableProperty{
+				PropertyName:    propName,
+				IndexType:       IndexTypePropValue,
+				DesiredStrategy: bucket.Strategy(),
+				BucketOptions:   bucketOptions,
+			})
+		}
+		if bucket := shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName)); bucket != nil &&
+			inverted.HasSearchableIndex(prop) {
+			reindexableProperties = append(reindexableProperties, ReindexableProperty{
+				PropertyName:    propName,
+				IndexType:       IndexTypePropSearchableValue,
+				DesiredStrategy: lsmkv.StrategyMapCollection,
+				BucketOptions:   searchableBucketOptions,
+			})
+		}
+		if bucket := shard.store.Bucket(helpers.BucketPositionsFromPropNameLSM(propName)); bucket != nil &&
+			inverted.HasPositionIndex(prop) {
+			reindexableProperties = append(reindexableProperties, ReindexableProperty{
+				PropertyName:    propName,
+				IndexType:       IndexTypePropPositions,
+				DesiredStrategy: lsmkv.StrategyMapCollection,
+				BucketOptions:   bucketOptions,
+			})
+		}
+	}
+
+	return reindexableProperties, nil
+}
+
+func (t *ShardInvertedReindexTaskTokenization) OnPostResumeStore(ctx context.Context, shard *Shard) error {
+	return nil
+}
@@ -0,0 +1,298 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestInvertedReindexTokenization(t *testing.T) {
+	dirName := t.TempDir()
+	className := "Article"
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		ReindexTokenization:       map[string][]string{className: {"title", "code"}},
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "code",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := []strfmt.UUID{
+		"b1000000-0000-0000-0000-000000000001",
+		"b1000000-0000-0000-0000-000000000002",
+		"b1000000-0000-0000-0000-000000000003",
+	}
+	objects := []map[string]interface{}{
+		{"title": "東京都に住んでいます", "code": "XK-2048-A"},
+		{"title": "京都の寺", "code": "XK-1024-B"},
+		{"title": "Weaviate in Tokyo", "code": "ZZ-2048-C"},
+	}
+	for i, props := range objects {
+		obj := &models.Object{Class: className, ID: ids[i], Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	bm25 := func(t *testing.T, query string, properties ...string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+		res, _, err := idx.objectSearch(context.Background(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID()
+		}
+		return found
+	}
+
+	like := func(t *testing.T, prop, value string) []strfmt.UUID {
+		f := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorLike,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    f,
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("before changing the tokenization", func(t *testing.T) {
+		assert.Empty(t, bm25(t, "東京", "title"))
+		assert.Empty(t, like(t, "title", "*東京"))
+		assert.Empty(t, bm25(t, "2048", "code"))
+	})
+
+	t.Run("change tokenization and reindex", func(t *testing.T) {
+		class.Properties[0].Tokenization = models.PropertyTokenizationCjk
+		class.Properties[1].Tokenization = models.PropertyTokenizationNgram
+
+		require.Nil(t, migrator.InvertedReindex(context.Background(),
+			"ShardInvertedReindexTaskTokenization"))
+	})
+
+	t.Run("after changing the tokenization", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, bm25(t, "東京", "title"))
+		assert.ElementsMatch(t, ids[:2], bm25(t, "京都", "title"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, bm25(t, "tokyo", "title"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, like(t, "title", "*東京都*"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, like(t, "title", "*寺*"))
+
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[2]}, bm25(t, "2048", "code"))
+		assert.ElementsMatch(t, ids[:2], like(t, "code", "*xk-*"))
+	})
+}
+
+func TestUpdatePropertyTokenization(t *testing.T) {
+	dirName := t.TempDir()
+	className := "Article"
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	newClass := func(tokenization string) *models.Class {
+		return &models.Class{
+			Class:               className,
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+			Properties: []*models.Property{
+				{
+					Name:           "title",
+					DataType:       schema.DataTypeText.PropString(),
+					Tokenization:   tokenization,
+					IndexPositions: func() *bool { b := true; return &b }(),
+				},
+			},
+		}
+	}
+	class := newClass(models.PropertyTokenizationWord)
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := []strfmt.UUID{
+		"b2000000-0000-0000-0000-000000000001",
+		"b2000000-0000-0000-0000-000000000002",
+		"b2000000-0000-0000-0000-000000000003",
+		"b2000000-0000-0000-0000-000000000004",
+	}
+	put := func(id strfmt.UUID, title string) error {
+		obj := &models.Object{Class: className, ID: id, Properties: map[string]interface{}{"title": title}}
+		return repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil)
+	}
+	require.Nil(t, put(ids[0], "東京都に住んでいます"))
+	require.Nil(t, put(ids[1], "京都の寺"))
+	require.Nil(t, put(ids[2], "Weaviate in Tokyo"))
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	bm25 := func(t *testing.T, query string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title"}, Query: query}
+		res, _, err := idx.objectSearch(context.Background(), 10, nil, kwr, nil, nil,
+			additional.Properties{}, nil, "", 0)
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID()
+		}
+		return found
+	}
+
+	like := func(t *testing.T, value string) []strfmt.UUID {
+		f := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorLike,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: "title"},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    f,
+		})
+		require.Nil(t, err)
+
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	assertWordTokenization := func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, bm25(t, "東京都に住んでいます"))
+		assert.Empty(t, bm25(t, "東京"))
+		assert.Empty(t, like(t, "*東京"))
+	}
+
+	t.Run("before changing the tokenization", assertWordTokenization)
+
+	t.Run("aborting the update keeps the existing indexes", func(t *testing.T) {
+		commit, err := migrator.UpdatePropertyTokenization(context.Background(),
+			newClass(models.PropertyTokenizationCjk), []string{"title"})
+		require.Nil(t, err)
+		commit(false)
+
+		assertWordTokenization(t)
+		require.Nil(t, put(ids[3], "大阪"))
+		require.Nil(t, repo.DeleteObject(context.Background(), className, ids[3], nil, ""))
+	})
+
+	t.Run("update the tokenization from word to cjk", func(t *testing.T) {
+		updated := newClass(models.PropertyTokenizationCjk)
+		commit, err := migrator.UpdatePropertyTokenization(context.Background(),
+			updated, []string{"title"})
+		require.Nil(t, err)
+
+		t.Run("the class is read-only until committed", func(t *testing.T) {
+			assert.NotNil(t, put(ids[3], "大阪の京都"))
+			assertWordTokenization(t)
+		})
+
+		commit(true)
+		*class = *updated
+	})
+
+	t.Run("after changing the tokenization", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, bm25(t, "東京"))
+		assert.ElementsMatch(t, ids[:2], bm25(t, "京都"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[2]}, bm25(t, "tokyo"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0]}, like(t, "*東京都*"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[1]}, like(t, "*寺*"))
+
+		idx.ForEachShard(func(name string, shard *Shard) error {
+			positions, err := shard.store.Bucket(helpers.BucketPositionsFromPropNameLSM("title")).
+				MapList([]byte("京都"))
+			require.Nil(t, err)
+			assert.Len(t, positions, 2)
+			return nil
+		})
+	})
+
+	t.Run("objects written after the update use the new tokenization", func(t *testing.T) {
+		require.Nil(t, put(ids[3], "大阪の京都"))
+
+		assert.ElementsMatch(t, []strfmt.UUID{ids[0], ids[1], ids[3]}, bm25(t, "京都"))
+		assert.ElementsMatch(t, []strfmt.UUID{ids[3]}, bm25(t, "大阪"))
+	})
+}
@@ -72,6 +72,12 @@ type Bucket struct {
 	// is that of the bucket that holds objects
 	monitorCount bool
 
+	compactionPolicy compactionPolicy
+
+	// compression of the values in segments, only supported by "replace"
+	// buckets
+	compression segmentindex.Compression
+
 	pauseTimer *prometheus.Timer // Times the pause
 }
 
@@ -102,6 +108,7 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 		walThreshold:      defaultWalThreshold,
 		flushAfterIdle:    defaultFlushAfterIdle,
 		strategy:          defaultStrategy,
+		compactionPolicy:  pairwiseCompaction{},
 		logger:            logger,
 		metrics:           metrics,
 	}
@@ -117,7 +124,8 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	}
 
 	sg, err := newSegmentGroup(dir, logger, b.legacyMapSortingBeforeCompaction,
-		metrics, b.strategy, b.monitorCount, compactionCycle)
+		metrics, b.strategy, b.monitorCount, b.compactionPolicy, b.compression,
+		compactionCycle)
 	if err != nil {
 		return nil, errors.Wrap(err, "init disk segments")
 	}
@@ -646,7 +654,8 @@ func (b *Bucket) Delete(key []byte, opts ...SecondaryKeyOption) error {
 // lock on its own
 func (b *Bucket) setNewActiveMemtable() error {
 	mt, err := newMemtable(filepath.Join(b.dir, fmt.Sprintf("segment-%d",
-		time.Now().UnixNano())), b.strategy, b.secondaryIndices, b.metrics)
+		time.Now().UnixNano())), b.strategy, b.secondaryIndices, b.compression,
+		b.metrics)
 	if err != nil {
 		return err
 	}
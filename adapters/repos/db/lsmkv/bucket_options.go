@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 )
 
 type BucketOption func(b *Bucket) error
@@ -111,3 +112,56 @@ func WithMonitorCount() BucketOption {
 		return nil
 	}
 }
+
+// WithSizeTieredCompaction makes the bucket compact neighboring segments
+// whose sizes differ by no more than the given ratio, smallest pairs first.
+func WithSizeTieredCompaction(ratio float64) BucketOption {
+	return func(b *Bucket) error {
+		if ratio < 1 {
+			return errors.Errorf("size-tiered compaction ratio must be at least 1, got %v",
+				ratio)
+		}
+
+		b.compactionPolicy = sizeTieredCompaction{ratio: ratio}
+		return nil
+	}
+}
+
+// WithLeveledCompaction makes the bucket compact a level into the next one
+// once its segments exceed baseSize*multiplier^level bytes.
+func WithLeveledCompaction(baseSize, multiplier int) BucketOption {
+	return func(b *Bucket) error {
+		if baseSize <= 0 {
+			return errors.Errorf("leveled compaction base size must be positive, got %d",
+				baseSize)
+		}
+
+		if multiplier < 2 {
+			return errors.Errorf("leveled compaction multiplier must be at least 2, got %d",
+				multiplier)
+		}
+
+		b.compactionPolicy = leveledCompaction{baseSize: baseSize, multiplier: multiplier}
+		return nil
+	}
+}
+
+// WithCompression compresses the values of all segments written from now on
+// with the given algorithm, existing segments are read as they are and only
+// compressed once they are compacted. Compression is only supported on
+// "replace" buckets.
+func WithCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		c, err := SegmentCompressionFromString(compression)
+		if err != nil {
+			return err
+		}
+
+		if c != segmentindex.CompressionNone && b.strategy != StrategyReplace {
+			return errors.Errorf("compression only supported on 'replace' buckets")
+		}
+
+		b.compression = c
+		return nil
+	}
+}
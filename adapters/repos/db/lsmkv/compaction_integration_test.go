@@ -1848,3 +1848,56 @@ func copyByteSlice(src []byte) []byte {
 	copy(dst, src)
 	return dst
 }
+
+func Test_CompactionPolicies(t *testing.T) {
+	policies := []struct {
+		name   string
+		option BucketOption
+	}{
+		{name: "pairwise", option: WithStrategy(StrategyReplace)},
+		{name: "size-tiered", option: WithSizeTieredCompaction(2)},
+		{name: "leveled", option: WithLeveledCompaction(1024, 4)},
+	}
+
+	for _, policy := range policies {
+		t.Run(policy.name, func(t *testing.T) {
+			segments := 12
+			keysPerSegment := 50
+
+			b, err := NewBucket(testCtx(), t.TempDir(), "", nullLogger(), nil,
+				cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+				WithStrategy(StrategyReplace), policy.option)
+			require.Nil(t, err)
+			defer b.Shutdown(testCtx())
+
+			// so big it effectively never triggers as part of this test
+			b.SetMemtableThreshold(1e9)
+
+			// every segment overwrites half of the keys of the previous one, so
+			// the compacted result is only correct if the precedence of the
+			// segments is preserved
+			expected := map[string]string{}
+			for i := 0; i < segments; i++ {
+				for j := 0; j < keysPerSegment; j++ {
+					key := fmt.Sprintf("key-%03d", i*keysPerSegment/2+j)
+					value := fmt.Sprintf("value-%03d", i)
+					require.Nil(t, b.Put([]byte(key), []byte(value)))
+					expected[key] = value
+				}
+				require.Nil(t, b.FlushAndSwitch())
+			}
+
+			require.True(t, b.disk.eligibleForCompaction())
+			for b.disk.eligibleForCompaction() {
+				require.Nil(t, b.disk.compactOnce())
+			}
+			assert.Less(t, b.disk.Len(), segments)
+
+			for key, value := range expected {
+				actual, err := b.Get([]byte(key))
+				require.Nil(t, err)
+				assert.Equal(t, value, string(actual), key)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import "math"
+
+// compactionPolicy decides which segments of a SegmentGroup are compacted
+// next. The 2-way compactors can only merge two segments and the result
+// replaces both in place, so a policy may only ever pick two neighboring
+// segments, otherwise the precedence of the segments in between would be
+// violated.
+type compactionPolicy interface {
+	// pick returns the next pair to compact or nil if nothing is eligible.
+	// The segments are ordered from oldest to newest.
+	pick(segments []segmentStats) *compactionCandidate
+}
+
+type segmentStats struct {
+	level uint16
+	size  int
+}
+
+type compactionCandidate struct {
+	// left is the position of the older segment, the newer one is left+1 for
+	// all policies except the pairwise one which predates this abstraction
+	left, right int
+
+	// level is the level the compacted segment is written at
+	level uint16
+}
+
+// pairwiseCompaction is the original policy. It picks the first two segments
+// of the lowest level that has at least two segments and writes the result
+// one level higher. It is used unless a bucket explicitly chooses another
+// policy.
+type pairwiseCompaction struct{}
+
+func (pairwiseCompaction) pick(segments []segmentStats) *compactionCandidate {
+	// first determine the lowest level with candidates
+	levels := map[uint16]int{}
+
+	for _, segment := range segments {
+		levels[segment.level]++
+	}
+
+	currLowestLevel := uint16(math.MaxUint16)
+	found := false
+	for level, count := range levels {
+		if count < 2 {
+			continue
+		}
+
+		if level < currLowestLevel {
+			currLowestLevel = level
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	// now pick any two segments which match the level
+	var res []int
+
+	for i, segment := range segments {
+		if len(res) >= 2 {
+			break
+		}
+
+		if segment.level == currLowestLevel {
+			res = append(res, i)
+		}
+	}
+
+	return &compactionCandidate{
+		left:  res[0],
+		right: res[1],
+		level: currLowestLevel + 1,
+	}
+}
+
+// sizeTieredCompaction merges neighboring segments of similar size,
+// regardless of their level. Among all neighbors whose sizes differ by at
+// most the configured ratio, the pair with the smallest combined size is
+// picked, so small and recently flushed segments are merged first and large
+// segments are only rewritten once a peer of similar size exists.
+type sizeTieredCompaction struct {
+	ratio float64
+}
+
+func (p sizeTieredCompaction) pick(segments []segmentStats) *compactionCandidate {
+	var best *compactionCandidate
+	bestSize := 0
+
+	for i := 0; i < len(segments)-1; i++ {
+		left, right := segments[i], segments[i+1]
+
+		small, large := left.size, right.size
+		if small > large {
+			small, large = large, small
+		}
+
+		if float64(large) > float64(small)*p.ratio {
+			continue
+		}
+
+		if best != nil && left.size+right.size >= bestSize {
+			continue
+		}
+
+		level := left.level
+		if right.level > level {
+			level = right.level
+		}
+
+		best = &compactionCandidate{left: i, right: i + 1, level: level + 1}
+		bestSize = left.size + right.size
+	}
+
+	return best
+}
+
+// leveledCompaction assigns every level a size budget that grows by the
+// multiplier per level, starting at baseSize for level 0. As long as a level
+// exceeds its budget, its oldest segment with a suitable neighbor is merged
+// into the older neighbor if that one is on a higher level, or with the next
+// segment of the same level otherwise. Lower levels are checked first. Compared to the pairwise
+// policy, this keeps the number of segments per level small at the cost of
+// rewriting the higher levels more often.
+type leveledCompaction struct {
+	baseSize   int
+	multiplier int
+}
+
+func (p leveledCompaction) pick(segments []segmentStats) *compactionCandidate {
+	sizes := map[uint16]int{}
+	maxLevel := uint16(0)
+	for _, segment := range segments {
+		sizes[segment.level] += segment.size
+		if segment.level > maxLevel {
+			maxLevel = segment.level
+		}
+	}
+
+	budget := p.baseSize
+	for level := uint16(0); level <= maxLevel; level++ {
+		if sizes[level] > budget {
+			if c := p.pickInLevel(segments, level); c != nil {
+				return c
+			}
+		}
+
+		if budget > math.MaxInt/p.multiplier {
+			// any higher budget is unreachable anyway
+			break
+		}
+		budget *= p.multiplier
+	}
+
+	return nil
+}
+
+func (p leveledCompaction) pickInLevel(segments []segmentStats,
+	level uint16,
+) *compactionCandidate {
+	for i, segment := range segments {
+		if segment.level != level {
+			continue
+		}
+
+		if i > 0 && segments[i-1].level > level {
+			return &compactionCandidate{
+				left:  i - 1,
+				right: i,
+				level: segments[i-1].level,
+			}
+		}
+
+		if i < len(segments)-1 && segments[i+1].level == level {
+			return &compactionCandidate{
+				left:  i,
+				right: i + 1,
+				level: level + 1,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairwiseCompaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []segmentStats
+		expected *compactionCandidate
+	}{
+		{
+			name:     "no segments",
+			segments: nil,
+			expected: nil,
+		},
+		{
+			name:     "single segment per level",
+			segments: []segmentStats{{level: 2}, {level: 1}, {level: 0}},
+			expected: nil,
+		},
+		{
+			name:     "lowest level with two segments",
+			segments: []segmentStats{{level: 1}, {level: 1}, {level: 0}, {level: 0}},
+			expected: &compactionCandidate{left: 2, right: 3, level: 1},
+		},
+		{
+			name:     "higher level",
+			segments: []segmentStats{{level: 3}, {level: 3}, {level: 0}},
+			expected: &compactionCandidate{left: 0, right: 1, level: 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, pairwiseCompaction{}.pick(test.segments))
+		})
+	}
+}
+
+func TestSizeTieredCompaction(t *testing.T) {
+	policy := sizeTieredCompaction{ratio: 2}
+
+	tests := []struct {
+		name     string
+		segments []segmentStats
+		expected *compactionCandidate
+	}{
+		{
+			name:     "single segment",
+			segments: []segmentStats{{size: 100}},
+			expected: nil,
+		},
+		{
+			name:     "sizes too far apart",
+			segments: []segmentStats{{size: 1000}, {size: 100}, {size: 10}},
+			expected: nil,
+		},
+		{
+			name: "smallest similar pair",
+			segments: []segmentStats{
+				{level: 1, size: 1000}, {level: 1, size: 900},
+				{level: 0, size: 100}, {level: 0, size: 60},
+			},
+			expected: &compactionCandidate{left: 2, right: 3, level: 1},
+		},
+		{
+			name: "levels are ignored for the choice",
+			segments: []segmentStats{
+				{level: 4, size: 100}, {level: 0, size: 150}, {level: 0, size: 5000},
+			},
+			expected: &compactionCandidate{left: 0, right: 1, level: 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, policy.pick(test.segments))
+		})
+	}
+}
+
+func TestLeveledCompaction(t *testing.T) {
+	policy := leveledCompaction{baseSize: 100, multiplier: 10}
+
+	tests := []struct {
+		name     string
+		segments []segmentStats
+		expected *compactionCandidate
+	}{
+		{
+			name:     "all levels within budget",
+			segments: []segmentStats{{level: 1, size: 900}, {level: 0, size: 50}, {level: 0, size: 50}},
+			expected: nil,
+		},
+		{
+			name:     "level 0 over budget without a higher level",
+			segments: []segmentStats{{level: 0, size: 60}, {level: 0, size: 60}},
+			expected: &compactionCandidate{left: 0, right: 1, level: 1},
+		},
+		{
+			name: "level 0 over budget is merged into level 1",
+			segments: []segmentStats{
+				{level: 1, size: 500}, {level: 0, size: 60}, {level: 0, size: 60},
+			},
+			expected: &compactionCandidate{left: 0, right: 1, level: 1},
+		},
+		{
+			name: "level 1 over budget is merged into level 2",
+			segments: []segmentStats{
+				{level: 2, size: 5000}, {level: 1, size: 1100}, {level: 0, size: 10},
+			},
+			expected: &compactionCandidate{left: 0, right: 1, level: 2},
+		},
+		{
+			name: "lower levels take precedence",
+			segments: []segmentStats{
+				{level: 2, size: 5000}, {level: 1, size: 1100},
+				{level: 0, size: 60}, {level: 0, size: 60},
+			},
+			expected: &compactionCandidate{left: 1, right: 2, level: 1},
+		},
+		{
+			name:     "single segment over budget has no partner",
+			segments: []segmentStats{{level: 0, size: 1000}},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, policy.pick(test.segments))
+		})
+	}
+}
+
+func TestCompactionPolicyOptions(t *testing.T) {
+	t.Run("size-tiered", func(t *testing.T) {
+		b := &Bucket{}
+		require.Nil(t, WithSizeTieredCompaction(1.5)(b))
+		assert.Equal(t, sizeTieredCompaction{ratio: 1.5}, b.compactionPolicy)
+
+		assert.NotNil(t, WithSizeTieredCompaction(0.5)(b))
+	})
+
+	t.Run("leveled", func(t *testing.T) {
+		b := &Bucket{}
+		require.Nil(t, WithLeveledCompaction(1024, 10)(b))
+		assert.Equal(t, leveledCompaction{baseSize: 1024, multiplier: 10},
+			b.compactionPolicy)
+
+		assert.NotNil(t, WithLeveledCompaction(0, 10)(b))
+		assert.NotNil(t, WithLeveledCompaction(1024, 1)(b))
+	})
+}
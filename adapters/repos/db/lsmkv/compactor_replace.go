@@ -32,6 +32,10 @@ type compactorReplace struct {
 
 	secondaryIndexCount uint16
 
+	// compression of the values in the resulting segment, independent of the
+	// compression of the input segments
+	compression segmentindex.Compression
+
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
@@ -39,7 +43,7 @@ type compactorReplace struct {
 
 func newCompactorReplace(w io.WriteSeeker,
 	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
-	scratchSpacePath string,
+	scratchSpacePath string, compression segmentindex.Compression,
 ) *compactorReplace {
 	return &compactorReplace{
 		c1:                  c1,
@@ -49,6 +53,7 @@ func newCompactorReplace(w io.WriteSeeker,
 		currentLevel:        level,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 	}
 }
 
@@ -73,7 +78,7 @@ func (c *compactorReplace) do() error {
 
 	dataEnd := uint64(kis[len(kis)-1].ValueEnd)
 
-	if err := c.writeHeader(c.currentLevel+1, 0, c.secondaryIndexCount, dataEnd); err != nil {
+	if err := c.writeHeader(c.currentLevel+1, c.secondaryIndexCount, dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -85,7 +90,7 @@ func (c *compactorReplace) init() error {
 	// we will seek to the beginning and overwrite the actual header at the very
 	// end
 
-	if _, err := c.bufw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
+	if _, err := c.bufw.Write(make([]byte, c.header(0, 0, 0).Size())); err != nil {
 		return errors.Wrap(err, "write empty header")
 	}
 
@@ -97,7 +102,7 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 	res2, err2 := c.c2.firstWithAllKeys()
 
 	// the (dummy) header was already written, this is our initial offset
-	offset := segmentindex.HeaderSize
+	offset := c.header(0, 0, 0).Size()
 
 	var kis []segmentindex.Key
 
@@ -153,6 +158,14 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 	secondaryKeys [][]byte, tombstone bool,
 ) (segmentindex.Key, error) {
+	if !tombstone {
+		compressed, err := compressValue(c.compression, value)
+		if err != nil {
+			return segmentindex.Key{}, errors.Wrap(err, "compress value")
+		}
+		value = compressed
+	}
+
 	segNode := segmentReplaceNode{
 		offset:              offset,
 		tombstone:           tombstone,
@@ -179,24 +192,39 @@ func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
 // writeHeader assumes that everything has been written to the underlying
 // writer and it is now safe to seek to the beginning and override the initial
 // header
-func (c *compactorReplace) writeHeader(level, version, secondaryIndices uint16,
+func (c *compactorReplace) writeHeader(level, secondaryIndices uint16,
 	startOfIndex uint64,
 ) error {
 	if _, err := c.w.Seek(0, io.SeekStart); err != nil {
 		return errors.Wrap(err, "seek to beginning to write header")
 	}
 
+	h := c.header(level, secondaryIndices, startOfIndex)
+
+	if _, err := h.WriteTo(c.w); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// header uses version 0 for uncompressed segments, so that they stay readable
+// by versions which do not support compression yet
+func (c *compactorReplace) header(level, secondaryIndices uint16,
+	startOfIndex uint64,
+) *segmentindex.Header {
 	h := &segmentindex.Header{
 		Level:            level,
-		Version:          version,
+		Version:          0,
 		SecondaryIndices: secondaryIndices,
 		Strategy:         segmentindex.StrategyReplace,
 		IndexStart:       startOfIndex,
 	}
 
-	if _, err := h.WriteTo(c.w); err != nil {
-		return err
+	if c.compression != segmentindex.CompressionNone {
+		h.Version = 1
+		h.Compression = c.compression
 	}
 
-	return nil
+	return h
 }
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -33,6 +34,7 @@ type Memtable struct {
 	path               string
 	strategy           string
 	secondaryIndices   uint16
+	compression        segmentindex.Compression
 	secondaryToPrimary []map[string][]byte
 	lastWrite          time.Time
 	createdAt          time.Time
@@ -40,7 +42,8 @@ type Memtable struct {
 }
 
 func newMemtable(path string, strategy string,
-	secondaryIndices uint16, metrics *Metrics,
+	secondaryIndices uint16, compression segmentindex.Compression,
+	metrics *Metrics,
 ) (*Memtable, error) {
 	cl, err := newCommitLogger(path)
 	if err != nil {
@@ -57,6 +60,7 @@ func newMemtable(path string, strategy string,
 		path:             path,
 		strategy:         strategy,
 		secondaryIndices: secondaryIndices,
+		compression:      compression,
 		lastWrite:        time.Now(),
 		createdAt:        time.Now(),
 		metrics:          newMemtableMetrics(metrics, filepath.Dir(path), strategy),
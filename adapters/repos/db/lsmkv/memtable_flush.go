@@ -102,16 +102,37 @@ func (m *Memtable) flush() error {
 func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 	flat := m.key.flattenInOrder()
 
-	totalDataLength := totalKeyAndValueSize(flat)
-	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(m.secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
-	headerSize := segmentindex.HeaderSize
+	// the values need to be compressed upfront, as the header contains the
+	// position of the index and therefore depends on their final size
+	values := make([][]byte, len(flat))
+	for i, node := range flat {
+		if node.tombstone {
+			values[i] = node.value
+			continue
+		}
+
+		compressed, err := compressValue(m.compression, node.value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compress value of node %d", i)
+		}
+		values[i] = compressed
+	}
+
 	header := segmentindex.Header{
-		IndexStart:       uint64(totalDataLength + perObjectAdditions + headerSize),
 		Level:            0, // always level zero on a new one
-		Version:          0, // always version 0 for now
+		Version:          0, // version 0 unless values are compressed
 		SecondaryIndices: m.secondaryIndices,
 		Strategy:         SegmentStrategyFromString(m.strategy),
 	}
+	if m.compression != segmentindex.CompressionNone {
+		header.Version = 1
+		header.Compression = m.compression
+	}
+
+	totalDataLength := totalKeyAndValueSize(flat, values)
+	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(m.secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
+	headerSize := header.Size()
+	header.IndexStart = uint64(totalDataLength + perObjectAdditions + headerSize)
 
 	n, err := header.WriteTo(f)
 	if err != nil {
@@ -125,7 +146,7 @@ func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 		segNode := &segmentReplaceNode{
 			offset:              totalWritten,
 			tombstone:           node.tombstone,
-			value:               node.value,
+			value:               values[i],
 			primaryKey:          node.key,
 			secondaryKeys:       node.secondaryKeys,
 			secondaryIndexCount: m.secondaryIndices,
@@ -215,10 +236,10 @@ func (m *Memtable) flushDataCollection(f io.Writer,
 	return keys, nil
 }
 
-func totalKeyAndValueSize(in []*binarySearchNode) int {
+func totalKeyAndValueSize(in []*binarySearchNode, values [][]byte) int {
 	var sum int
-	for _, n := range in {
-		sum += len(n.value)
+	for i, n := range in {
+		sum += len(values[i])
 		sum += len(n.key)
 		for _, sec := range n.secondaryKeys {
 			sum += len(sec)
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 )
 
 func TestMemtableRoaringSet(t *testing.T) {
@@ -26,7 +27,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	}
 
 	t.Run("inserting individual entries", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -53,7 +55,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting lists", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -78,7 +81,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting bitmaps", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -105,7 +109,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing individual entries", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -126,7 +131,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing lists", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -151,7 +157,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing bitmaps", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -176,7 +183,8 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("adding/removing bitmaps", func(t *testing.T) {
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, nil)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0,
+			segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -24,7 +25,8 @@ import (
 // https://www.youtube.com/watch?v=OS8taasZl8k
 func Test_MemtableSecondaryKeyBug(t *testing.T) {
 	dir := t.TempDir()
-	m, err := newMemtable(path.Join(dir, "will-never-flush"), StrategyReplace, 1, nil)
+	m, err := newMemtable(path.Join(dir, "will-never-flush"), StrategyReplace, 1,
+		segmentindex.CompressionNone, nil)
 	require.Nil(t, err)
 
 	t.Run("add initial value", func(t *testing.T) {
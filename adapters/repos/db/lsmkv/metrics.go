@@ -41,6 +41,9 @@ type Metrics struct {
 	memtableSize         *prometheus.GaugeVec
 	DimensionSum         *prometheus.GaugeVec
 
+	// bytes written by compactions, labeled by the level of the result
+	CompactionBytesRewritten *prometheus.CounterVec
+
 	groupClasses bool
 }
 
@@ -102,6 +105,10 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		CompactionBytesRewritten: promMetrics.LSMCompactionBytesRewritten.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 		startupDiskIO: promMetrics.StartupDiskIO.MustCurryWith(prometheus.Labels{
 			"class_name": className,
 			"shard_name": shardName,
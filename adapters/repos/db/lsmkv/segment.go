@@ -28,6 +28,7 @@ type segment struct {
 	level                 uint16
 	secondaryIndexCount   uint16
 	version               uint16
+	compression           segmentindex.Compression
 	segmentStartPos       uint64
 	segmentEndPos         uint64
 	dataStartPos          uint64
@@ -81,7 +82,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		return nil, errors.Wrap(err, "mmap file")
 	}
 
-	header, err := segmentindex.ParseHeader(bytes.NewReader(content[:segmentindex.HeaderSize]))
+	header, err := segmentindex.ParseHeader(bytes.NewReader(content))
 	if err != nil {
 		return nil, errors.Wrap(err, "parse header")
 	}
@@ -109,7 +110,8 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		segmentStartPos:     header.IndexStart,
 		segmentEndPos:       uint64(len(content)),
 		strategy:            header.Strategy,
-		dataStartPos:        segmentindex.HeaderSize, // fixed value that's the same for all strategies
+		compression:         header.Compression,
+		dataStartPos:        uint64(header.Size()),
 		dataEndPos:          header.IndexStart,
 		index:               primaryDiskIndex,
 		logger:              logger,
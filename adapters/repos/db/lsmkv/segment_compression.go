@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+const (
+	// CompressionNone stores values as they are, this is the default
+	CompressionNone = "none"
+	// CompressionSnappy compresses values with snappy, which is cheap on CPU
+	CompressionSnappy = "snappy"
+	// CompressionZstd compresses values with zstd, which achieves better
+	// ratios than snappy at a higher CPU cost
+	CompressionZstd = "zstd"
+)
+
+func SegmentCompressionFromString(in string) (segmentindex.Compression, error) {
+	switch in {
+	case "", CompressionNone:
+		return segmentindex.CompressionNone, nil
+	case CompressionSnappy:
+		return segmentindex.CompressionSnappy, nil
+	case CompressionZstd:
+		return segmentindex.CompressionZstd, nil
+	default:
+		return 0, fmt.Errorf("unrecognized compression %q", in)
+	}
+}
+
+// EncodeAll and DecodeAll are safe for concurrent use, so a single encoder
+// and decoder are shared by all segments
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// compressValue compresses a single value. Values are compressed one by one
+// rather than in larger blocks, so that the index can keep pointing to
+// individual nodes and a point lookup only ever decompresses the value it
+// returns. BenchmarkCompressValueStorObj compares the savings on objects with
+// those of block compression.
+func compressValue(compression segmentindex.Compression, in []byte) ([]byte, error) {
+	switch compression {
+	case segmentindex.CompressionNone:
+		return in, nil
+	case segmentindex.CompressionSnappy:
+		return s2.EncodeSnappy(nil, in), nil
+	case segmentindex.CompressionZstd:
+		return zstdEncoder.EncodeAll(in, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", compression)
+	}
+}
+
+// decompressValue reverses compressValue. The result never shares memory with
+// the input unless the values are not compressed at all.
+func decompressValue(compression segmentindex.Compression, in []byte) ([]byte, error) {
+	switch compression {
+	case segmentindex.CompressionNone:
+		return in, nil
+	case segmentindex.CompressionSnappy:
+		out, err := s2.Decode(nil, in)
+		if err != nil {
+			return nil, fmt.Errorf("decompress snappy value: %w", err)
+		}
+		return nonNil(out), nil
+	case segmentindex.CompressionZstd:
+		out, err := zstdDecoder.DecodeAll(in, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decompress zstd value: %w", err)
+		}
+		return nonNil(out), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", compression)
+	}
+}
+
+// nonNil makes sure an empty value is not mistaken for a missing one
+func nonNil(in []byte) []byte {
+	if in == nil {
+		return []byte{}
+	}
+	return in
+}
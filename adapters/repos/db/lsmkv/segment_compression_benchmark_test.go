@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// BenchmarkCompressValueStorObj reports the size savings of compressing the
+// values of an objects bucket one by one. For comparison, blockRatio is the
+// ratio which compressing 64KB blocks of the same values would achieve.
+//
+//	go test -run - -bench CompressValueStorObj ./adapters/repos/db/lsmkv
+func BenchmarkCompressValueStorObj(b *testing.B) {
+	for _, dims := range []int{0, 384} {
+		values := storObjPayloads(b, 1000, dims)
+
+		for _, c := range []struct {
+			name        string
+			compression segmentindex.Compression
+		}{
+			{CompressionSnappy, segmentindex.CompressionSnappy},
+			{CompressionZstd, segmentindex.CompressionZstd},
+		} {
+			b.Run(fmt.Sprintf("%s dims=%d", c.name, dims), func(b *testing.B) {
+				var raw, compressed int
+				for i := 0; i < b.N; i++ {
+					raw, compressed = 0, 0
+					for _, value := range values {
+						out, err := compressValue(c.compression, value)
+						require.Nil(b, err)
+						raw += len(value)
+						compressed += len(out)
+					}
+				}
+
+				b.ReportMetric(float64(raw)/float64(len(values)), "raw-B/value")
+				b.ReportMetric(float64(compressed)/float64(len(values)), "compressed-B/value")
+				b.ReportMetric(float64(raw)/float64(compressed), "ratio")
+				b.ReportMetric(blockCompressionRatio(b, c.compression, values, 64*1024), "blockRatio")
+			})
+		}
+	}
+}
+
+func blockCompressionRatio(b *testing.B, compression segmentindex.Compression,
+	values [][]byte, blockSize int,
+) float64 {
+	var raw, compressed int
+	block := make([]byte, 0, blockSize)
+	flush := func() {
+		out, err := compressValue(compression, block)
+		require.Nil(b, err)
+		raw += len(block)
+		compressed += len(out)
+		block = block[:0]
+	}
+
+	for _, value := range values {
+		block = append(block, value...)
+		if len(block) >= blockSize {
+			flush()
+		}
+	}
+	if len(block) > 0 {
+		flush()
+	}
+
+	return float64(raw) / float64(compressed)
+}
+
+// storObjPayloads marshals objects which resemble a typical text dataset,
+// vectors are random and therefore hardly compressible
+func storObjPayloads(b *testing.B, count, dims int) [][]byte {
+	words := strings.Fields(`the quick brown fox jumps over a lazy dog while
+		some people read articles about databases vectors search engines and
+		other topics which are interesting to them on a sunny afternoon`)
+	sentence := func(r *rand.Rand, n int) string {
+		out := make([]string, n)
+		for i := range out {
+			out[i] = words[r.Intn(len(words))]
+		}
+		return strings.Join(out, " ")
+	}
+
+	r := rand.New(rand.NewSource(7))
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	values := make([][]byte, count)
+	for i := range values {
+		var vector []float32
+		if dims > 0 {
+			vector = make([]float32, dims)
+			for j := range vector {
+				vector[j] = r.Float32()
+			}
+		}
+
+		obj := storobj.FromObject(&models.Object{
+			ID:                 strfmt.UUID(uuid.New().String()),
+			Class:              "Article",
+			CreationTimeUnix:   created.Add(time.Duration(i) * time.Minute).UnixMilli(),
+			LastUpdateTimeUnix: created.Add(time.Duration(i) * time.Minute).UnixMilli(),
+			Properties: map[string]interface{}{
+				"title":     sentence(r, 8),
+				"body":      sentence(r, 200),
+				"wordCount": float64(200),
+				"published": created.Format(time.RFC3339),
+			},
+		}, vector, nil)
+		obj.SetDocID(uint64(i))
+
+		value, err := obj.MarshalBinary()
+		require.Nil(b, err)
+		values[i] = value
+	}
+
+	return values
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCompressValue(t *testing.T) {
+	values := [][]byte{
+		{},
+		[]byte("a"),
+		bytes.Repeat([]byte(`{"name":"value"}`), 100),
+	}
+
+	for _, name := range []string{CompressionNone, CompressionSnappy, CompressionZstd} {
+		t.Run(name, func(t *testing.T) {
+			compression, err := SegmentCompressionFromString(name)
+			require.Nil(t, err)
+
+			for _, value := range values {
+				compressed, err := compressValue(compression, value)
+				require.Nil(t, err)
+
+				decompressed, err := decompressValue(compression, compressed)
+				require.Nil(t, err)
+				require.NotNil(t, decompressed)
+				assert.Equal(t, value, decompressed)
+			}
+		})
+	}
+
+	t.Run("compressed values are smaller", func(t *testing.T) {
+		value := bytes.Repeat([]byte(`{"name":"value"}`), 100)
+		for _, c := range []segmentindex.Compression{
+			segmentindex.CompressionSnappy, segmentindex.CompressionZstd,
+		} {
+			compressed, err := compressValue(c, value)
+			require.Nil(t, err)
+			assert.Less(t, len(compressed), len(value)/4)
+		}
+	})
+
+	t.Run("corrupt value", func(t *testing.T) {
+		_, err := decompressValue(segmentindex.CompressionZstd, []byte("garbage"))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestWithCompression(t *testing.T) {
+	b := &Bucket{strategy: StrategyReplace}
+	require.Nil(t, WithCompression(CompressionSnappy)(b))
+	assert.Equal(t, segmentindex.CompressionSnappy, b.compression)
+
+	assert.NotNil(t, WithCompression("lz4")(b))
+
+	b = &Bucket{strategy: StrategyMapCollection}
+	assert.NotNil(t, WithCompression(CompressionZstd)(b))
+	assert.Nil(t, WithCompression(CompressionNone)(b))
+}
+
+func TestBucketCompression(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	value := func(i, version int) []byte {
+		return []byte(fmt.Sprintf(`{"id":%d,"version":%d,"props":"%s"}`,
+			i, version, bytes.Repeat([]byte("abc"), 20)))
+	}
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("key-%03d", i))
+	}
+	secondary := func(i int) []byte {
+		return []byte(fmt.Sprintf("secondary-%03d", i))
+	}
+
+	t.Run("write an uncompressed segment", func(t *testing.T) {
+		b, err := NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyReplace), WithSecondaryIndices(1))
+		require.Nil(t, err)
+
+		for i := 0; i < 100; i++ {
+			require.Nil(t, b.Put(key(i), value(i, 0),
+				WithSecondaryKey(0, secondary(i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Shutdown(ctx))
+	})
+
+	var b *Bucket
+	t.Run("write a compressed segment on top", func(t *testing.T) {
+		var err error
+		b, err = NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewNoop(), cyclemanager.NewNoop(),
+			WithStrategy(StrategyReplace), WithSecondaryIndices(1),
+			WithCompression(CompressionZstd))
+		require.Nil(t, err)
+
+		for i := 50; i < 150; i++ {
+			require.Nil(t, b.Put(key(i), value(i, 1),
+				WithSecondaryKey(0, secondary(i))))
+		}
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.Delete(key(i), WithSecondaryKey(0, secondary(i))))
+		}
+		require.Nil(t, b.Put(key(200), []byte{}))
+		require.Nil(t, b.FlushAndSwitch())
+
+		require.Equal(t, 2, b.disk.Len())
+		assert.Equal(t, segmentindex.CompressionNone, b.disk.segments[0].compression)
+		assert.Equal(t, segmentindex.CompressionZstd, b.disk.segments[1].compression)
+	})
+
+	expected := func(i int) []byte {
+		switch {
+		case i < 10:
+			return nil
+		case i < 50:
+			return value(i, 0)
+		default:
+			return value(i, 1)
+		}
+	}
+
+	verify := func(t *testing.T) {
+		for i := 0; i < 150; i++ {
+			actual, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected(i), actual)
+
+			actual, err = b.GetBySecondary(0, secondary(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected(i), actual)
+		}
+
+		empty, err := b.Get(key(200))
+		require.Nil(t, err)
+		assert.Equal(t, []byte{}, empty)
+
+		c := b.Cursor()
+		defer c.Close()
+
+		i := 10
+		for k, v := c.First(); k != nil && i < 150; k, v = c.Next() {
+			assert.Equal(t, key(i), k)
+			assert.Equal(t, expected(i), v)
+			i++
+		}
+		assert.Equal(t, 150, i)
+
+		k, v := c.Seek(key(60))
+		assert.Equal(t, key(60), k)
+		assert.Equal(t, expected(60), v)
+	}
+
+	t.Run("verify before compaction", verify)
+
+	t.Run("compact", func(t *testing.T) {
+		for b.disk.eligibleForCompaction() {
+			require.Nil(t, b.disk.compactOnce())
+		}
+
+		require.Equal(t, 1, b.disk.Len())
+		assert.Equal(t, segmentindex.CompressionZstd, b.disk.segments[0].compression)
+	})
+
+	t.Run("verify after compaction", verify)
+
+	require.Nil(t, b.Shutdown(ctx))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -54,11 +55,19 @@ type SegmentGroup struct {
 	// produce a meaningful count. Typically, the only count we're interested in
 	// is that of the bucket that holds objects
 	monitorCount bool
+
+	// decides which segments are compacted next
+	policy compactionPolicy
+
+	// compression of the values in segments written by compactions
+	compression segmentindex.Compression
 }
 
 func newSegmentGroup(dir string, logger logrus.FieldLogger,
 	mapRequiresSorting bool, metrics *Metrics, strategy string,
-	monitorCount bool, compactionCycleManager cyclemanager.CycleManager,
+	monitorCount bool, policy compactionPolicy,
+	compression segmentindex.Compression,
+	compactionCycleManager cyclemanager.CycleManager,
 ) (*SegmentGroup, error) {
 	list, err := os.ReadDir(dir)
 	if err != nil {
@@ -73,6 +82,8 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 		monitorCount:       monitorCount,
 		mapRequiresSorting: mapRequiresSorting,
 		strategy:           strategy,
+		policy:             policy,
+		compression:        compression,
 	}
 
 	segmentIndex := 0
@@ -13,7 +13,6 @@ package lsmkv
 
 import (
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 
@@ -36,63 +35,25 @@ func (sg *SegmentGroup) eligibleForCompaction() bool {
 		return false
 	}
 
-	// if there are at least two segments of the same level a regular compaction
-	// can be performed
-
-	levels := map[uint16]int{}
-
-	for _, segment := range sg.segments {
-		levels[segment.level]++
-		if levels[segment.level] > 1 {
-			return true
-		}
-	}
-
-	return false
+	return sg.policy.pick(sg.segmentStats()) != nil
 }
 
-func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
+func (sg *SegmentGroup) bestCompactionCandidate() *compactionCandidate {
 	sg.maintenanceLock.RLock()
 	defer sg.maintenanceLock.RUnlock()
 
-	// first determine the lowest level with candidates
-	levels := map[uint16]int{}
-
-	for _, segment := range sg.segments {
-		levels[segment.level]++
-	}
-
-	currLowestLevel := uint16(math.MaxUint16)
-	found := false
-	for level, count := range levels {
-		if count < 2 {
-			continue
-		}
-
-		if level < currLowestLevel {
-			currLowestLevel = level
-			found = true
-		}
-	}
-
-	if !found {
-		return nil
-	}
-
-	// now pick any two segments which match the level
-	var res []int
-
-	for i, segment := range sg.segments {
-		if len(res) >= 2 {
-			break
-		}
+	return sg.policy.pick(sg.segmentStats())
+}
 
-		if segment.level == currLowestLevel {
-			res = append(res, i)
-		}
+// segmentStats summarizes the segments for the compaction policy, the caller
+// must hold the maintenanceLock
+func (sg *SegmentGroup) segmentStats() []segmentStats {
+	stats := make([]segmentStats, len(sg.segments))
+	for i, seg := range sg.segments {
+		stats[i] = segmentStats{level: seg.level, size: seg.Size()}
 	}
 
-	return res
+	return stats
 }
 
 // segmentAtPos retrieves the segment for the given position using a read-lock
@@ -110,11 +71,12 @@ func (sg *SegmentGroup) compactOnce() error {
 	// that the array contents stay stable over the duration of an entire
 	// compaction. We do however need to protect against a read-while-write (race
 	// condition) on the array. Thus any read from sg.segments need to protected
-	pair := sg.bestCompactionCandidatePair()
-	if pair == nil {
+	candidate := sg.bestCompactionCandidate()
+	if candidate == nil {
 		// nothing to do
 		return nil
 	}
+	pair := []int{candidate.left, candidate.right}
 
 	path := fmt.Sprintf("%s.tmp", sg.segmentAtPos(pair[1]).path)
 	f, err := os.Create(path)
@@ -124,10 +86,10 @@ func (sg *SegmentGroup) compactOnce() error {
 
 	scratchSpacePath := sg.segmentAtPos(pair[1]).path + "compaction.scratch.d"
 
-	// the assumption is that both pairs are of the same level, so we can just
-	// take either value. If we want to support asymmetric compaction, then we
-	// might have to choose this value more intelligently
-	level := sg.segmentAtPos(pair[0]).level
+	// the compactors write the result one level above the level they are
+	// given, the policy however decides on the target level, so that
+	// asymmetric compactions are possible
+	level := candidate.level - 1
 	secondaryIndices := sg.segmentAtPos(pair[0]).secondaryIndexCount
 
 	strategy := sg.segmentAtPos(pair[0]).strategy
@@ -142,7 +104,8 @@ func (sg *SegmentGroup) compactOnce() error {
 
 	case segmentindex.StrategyReplace:
 		c := newCompactorReplace(f, sg.segmentAtPos(pair[0]).newCursor(),
-			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath)
+			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath,
+			sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -206,6 +169,19 @@ func (sg *SegmentGroup) compactOnce() error {
 		return errors.Wrap(err, "close compacted segment file")
 	}
 
+	if sg.metrics != nil {
+		info, err := os.Stat(path)
+		if err != nil {
+			return errors.Wrap(err, "stat compacted segment file")
+		}
+
+		sg.metrics.CompactionBytesRewritten.With(prometheus.Labels{
+			"strategy": sg.strategy,
+			"path":     pathLabel,
+			"level":    fmt.Sprint(candidate.level),
+		}).Add(float64(info.Size()))
+	}
+
 	if err := sg.replaceCompactedSegments(pair[0], pair[1], path); err != nil {
 		return errors.Wrap(err, "replace compacted segments")
 	}
@@ -59,7 +59,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 
 	defer syscall.Munmap(content)
 
-	header, err := segmentindex.ParseHeader(bytes.NewReader(content[:segmentindex.HeaderSize]))
+	header, err := segmentindex.ParseHeader(bytes.NewReader(content))
 	if err != nil {
 		return nil, fmt.Errorf("parse header: %w", err)
 	}
@@ -92,7 +92,8 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		segmentStartPos:     header.IndexStart,
 		segmentEndPos:       uint64(len(content)),
 		strategy:            header.Strategy,
-		dataStartPos:        segmentindex.HeaderSize, // fixed value that's the same for all strategies
+		compression:         header.Compression,
+		dataStartPos:        uint64(header.Size()),
 		dataEndPos:          header.IndexStart,
 		index:               primaryDiskIndex,
 		logger:              logger,
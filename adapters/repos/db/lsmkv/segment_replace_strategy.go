@@ -119,7 +119,7 @@ func (s *segment) replaceStratParseData(in []byte) ([]byte, error) {
 
 	valueLength := binary.LittleEndian.Uint64(in[1:9])
 
-	return in[9 : 9+valueLength], nil
+	return decompressValue(s.compression, in[9:9+valueLength])
 }
 
 func (s *segment) replaceStratParseDataWithKey(in []byte) (segmentReplaceNode, error) {
@@ -138,6 +138,11 @@ func (s *segment) replaceStratParseDataWithKey(in []byte) (segmentReplaceNode, e
 		return out, lsmkv.Deleted
 	}
 
+	out.value, err = decompressValue(s.compression, out.value)
+	if err != nil {
+		return out, err
+	}
+
 	return out, nil
 }
 
@@ -159,5 +164,6 @@ func (s *segment) replaceStratParseDataWithKeyInto(in []byte,
 		return lsmkv.Deleted
 	}
 
-	return nil
+	node.value, err = decompressValue(s.compression, node.value)
+	return err
 }
@@ -25,12 +25,29 @@ import (
 // for the pointer to the index part
 const HeaderSize = 16
 
+// HeaderSizeV1 is the size of a version 1 header, it extends the version 0
+// header by 2 bytes for the compression of the values
+const HeaderSizeV1 = HeaderSize + 2
+
 type Header struct {
 	Level            uint16
 	Version          uint16
 	SecondaryIndices uint16
 	Strategy         Strategy
 	IndexStart       uint64
+
+	// Compression is only persisted from Version 1 onwards
+	Compression Compression
+}
+
+// Size returns the number of bytes the header occupies on disk, the data
+// starts right after it
+func (h *Header) Size() int {
+	if h.Version >= 1 {
+		return HeaderSizeV1
+	}
+
+	return HeaderSize
 }
 
 func (h *Header) WriteTo(w io.Writer) (int64, error) {
@@ -49,8 +66,13 @@ func (h *Header) WriteTo(w io.Writer) (int64, error) {
 	if err := binary.Write(w, binary.LittleEndian, &h.IndexStart); err != nil {
 		return -1, err
 	}
+	if h.Version >= 1 {
+		if err := binary.Write(w, binary.LittleEndian, h.Compression); err != nil {
+			return -1, err
+		}
+	}
 
-	return int64(HeaderSize), nil
+	return int64(h.Size()), nil
 }
 
 func (h *Header) PrimaryIndex(source []byte) ([]byte, error) {
@@ -121,7 +143,7 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
-	if out.Version != 0 {
+	if out.Version > 1 {
 		return nil, errors.Errorf("unsupported version %d", out.Version)
 	}
 
@@ -133,5 +155,11 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
+	if out.Version >= 1 {
+		if err := binary.Read(r, binary.LittleEndian, &out.Compression); err != nil {
+			return nil, err
+		}
+	}
+
 	return out, nil
 }
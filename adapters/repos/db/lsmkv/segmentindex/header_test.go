@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderRoundTrip(t *testing.T) {
+	headers := []Header{
+		{
+			Level:            3,
+			Version:          0,
+			SecondaryIndices: 1,
+			Strategy:         StrategyReplace,
+			IndexStart:       1234,
+		},
+		{
+			Level:            1,
+			Version:          1,
+			SecondaryIndices: 2,
+			Strategy:         StrategyReplace,
+			IndexStart:       5678,
+			Compression:      CompressionZstd,
+		},
+	}
+
+	for _, h := range headers {
+		buf := &bytes.Buffer{}
+		n, err := h.WriteTo(buf)
+		require.Nil(t, err)
+		assert.Equal(t, int64(h.Size()), n)
+		assert.Equal(t, h.Size(), buf.Len())
+
+		parsed, err := ParseHeader(buf)
+		require.Nil(t, err)
+		assert.Equal(t, h, *parsed)
+	}
+
+	assert.Equal(t, HeaderSize, (&Header{Version: 0}).Size())
+	assert.Equal(t, HeaderSizeV1, (&Header{Version: 1}).Size())
+}
+
+func TestParseHeaderUnsupportedVersion(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := Header{Version: 2}
+	_, err := h.WriteTo(buf)
+	require.Nil(t, err)
+
+	_, err = ParseHeader(buf)
+	assert.NotNil(t, err)
+}
@@ -367,6 +367,26 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+// Shuts down the bucket, removes it from bucketsByName and deletes its files
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	bucket := s.bucketsByName[bucketName]
+	if bucket == nil {
+		return fmt.Errorf("bucket '%s' not found", bucketName)
+	}
+	delete(s.bucketsByName, bucketName)
+
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
+	}
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
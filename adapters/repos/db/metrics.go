@@ -17,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 type Metrics struct {
@@ -30,6 +31,10 @@ type Metrics struct {
 	filteredVectorVector  prometheus.Observer
 	filteredVectorObjects prometheus.Observer
 	filteredVectorSort    prometheus.Observer
+	grouped               bool
+	antiEntropyObjects    *prometheus.CounterVec
+	antiEntropyRanges     *prometheus.CounterVec
+	antiEntropyDurations  prometheus.ObserverVec
 }
 
 func NewMetrics(
@@ -50,6 +55,7 @@ func NewMetrics(
 	}
 
 	m.monitoring = true
+	m.grouped = prom.Group
 	m.batchTime = prom.BatchTime.MustCurryWith(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -91,6 +97,17 @@ func NewMetrics(
 		"operation":  "sort",
 	})
 
+	// anti-entropy runs per shard, even if the metrics are created per index
+	m.antiEntropyObjects = prom.AntiEntropyObjects.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+	})
+	m.antiEntropyRanges = prom.AntiEntropyRanges.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+	})
+	m.antiEntropyDurations = prom.AntiEntropyDurations.MustCurryWith(prometheus.Labels{
+		"class_name": className,
+	})
+
 	return m
 }
 
@@ -286,3 +303,31 @@ func (m *Metrics) FilteredVectorSort(dur time.Duration) {
 
 	m.filteredVectorSort.Observe(float64(dur) / float64(time.Millisecond))
 }
+
+func (m *Metrics) AntiEntropy(shardName string, start time.Time,
+	stats replica.AntiEntropyStats,
+) {
+	if !m.monitoring {
+		return
+	}
+
+	if m.grouped {
+		shardName = "n/a"
+	}
+
+	took := time.Since(start)
+	m.antiEntropyDurations.With(prometheus.Labels{
+		"shard_name": shardName,
+	}).Observe(float64(took) / float64(time.Millisecond))
+	m.antiEntropyRanges.With(prometheus.Labels{
+		"shard_name": shardName,
+	}).Add(float64(stats.Ranges))
+	m.antiEntropyObjects.With(prometheus.Labels{
+		"shard_name": shardName,
+		"result":     "repaired",
+	}).Add(float64(stats.Repaired))
+	m.antiEntropyObjects.With(prometheus.Labels{
+		"shard_name": shardName,
+		"result":     "conflict",
+	}).Add(float64(stats.Conflicts))
+}
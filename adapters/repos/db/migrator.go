@@ -14,17 +14,22 @@ package db
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
@@ -52,6 +57,9 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMaxSizeMB:        m.db.config.MemtablesMaxSizeMB,
 			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
+			ObjectsCompression:        m.db.config.ObjectsCompression,
+			ChangeStream:              m.db.config.ChangeStream,
+			AntiEntropy:               m.db.config.AntiEntropy,
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
 			ReplicationFactor:         class.ReplicationConfig.Factor,
 		},
@@ -60,6 +68,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		// always have the field set
 		inverted.ConfigFromModel(class.InvertedIndexConfig),
 		class.VectorIndexConfig.(schema.VectorIndexConfig),
+		convertToVectorIndexConfigs(class.VectorConfig),
 		m.db.schemaGetter, m.db, m.logger, m.db.nodeResolver, m.db.remoteIndex,
 		m.db.replicaClient, m.db.promMetrics, class, m.db.jobQueueCh)
 	if err != nil {
@@ -193,6 +202,105 @@ func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, tenants
 	return commit, nil
 }
 
+// UpdateTenants changes the activity status of local tenants. Shards of
+// tenants becoming HOT are loaded, shards of tenants becoming COLD are shut
+// down and released from memory while their files are kept on disk. Shards
+// of tenants becoming FROZEN are additionally uploaded to the offload
+// backend and removed from disk. They are downloaded again once the tenant
+// is activated.
+func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", class.Class)
+	}
+
+	idx.backupStateLock.RLock()
+	defer idx.backupStateLock.RUnlock()
+	if idx.backupState.InProgress {
+		return fmt.Errorf("cannot update tenants of %q while backup %q is in progress",
+			class.Class, idx.backupState.BackupID)
+	}
+
+	failed := map[string]error{}
+	for _, update := range updates {
+		if err := m.updateTenant(ctx, idx, class, update); err != nil {
+			failed[update.Name] = err
+		}
+	}
+	if len(failed) > 0 {
+		return &migrate.UpdateTenantsError{Failed: failed}
+	}
+	return nil
+}
+
+func (m *Migrator) updateTenant(ctx context.Context, idx *Index, class *models.Class,
+	update *migrate.UpdateTenantPayload,
+) error {
+	backend := m.db.offloadBackend
+	frozen := update.PreviousStatus == models.TenantActivityStatusFROZEN
+	if (frozen || update.Status == models.TenantActivityStatusFROZEN) && backend == nil {
+		return fmt.Errorf("no tenant offload backend configured")
+	}
+
+	switch update.Status {
+	case models.TenantActivityStatusHOT:
+		if shard := idx.shards.Load(update.Name); shard != nil {
+			return nil
+		}
+		if frozen {
+			if err := idx.downloadShard(ctx, backend, update.Name); err != nil {
+				return fmt.Errorf("download: %w", err)
+			}
+		}
+		shard, err := NewShard(ctx, m.db.promMetrics, update.Name, idx, class, idx.centralJobQueue)
+		if err != nil {
+			return fmt.Errorf("activate: %w", err)
+		}
+		idx.shards.Store(update.Name, shard)
+	case models.TenantActivityStatusCOLD:
+		if frozen {
+			if err := idx.downloadShard(ctx, backend, update.Name); err != nil {
+				return fmt.Errorf("download: %w", err)
+			}
+			return nil
+		}
+		shard, _ := idx.shards.LoadAndDelete(update.Name)
+		if shard == nil {
+			return nil
+		}
+		if err := shard.shutdown(ctx); err != nil {
+			return fmt.Errorf("deactivate: %w", err)
+		}
+	case models.TenantActivityStatusFROZEN:
+		shard, _ := idx.shards.LoadAndDelete(update.Name)
+		active := shard != nil
+		if shard == nil {
+			// the files of COLD shards can only be listed by a loaded shard
+			var err error
+			shard, err = NewShard(ctx, m.db.promMetrics, update.Name, idx, class, idx.centralJobQueue)
+			if err != nil {
+				return fmt.Errorf("load: %w", err)
+			}
+		}
+		if err := idx.offloadShard(ctx, backend, shard); err != nil {
+			err = fmt.Errorf("offload: %w", err)
+			// the tenant keeps its previous status, a COLD shard is not loaded
+			// but its files need to be present on disk
+			if active {
+				if err2 := idx.restoreShard(ctx, backend, update.Name, class, m.db.promMetrics); err2 != nil {
+					return fmt.Errorf("%w: restore: %v", err, err2)
+				}
+			} else if err2 := idx.downloadShard(ctx, backend, update.Name); err2 != nil {
+				return fmt.Errorf("%w: restore: %v", err, err2)
+			}
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid activity status %q", update.Status)
+	}
+	return nil
+}
+
 // DeleteTenants deletes tenants and returns a commit func
 // that can be used to either commit or rollback deletion
 func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error) {
@@ -200,7 +308,60 @@ func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenan
 	if idx == nil {
 		return func(bool) {}, nil
 	}
-	return idx.dropShards(tenants)
+
+	// shards of inactive tenants are not loaded, load the ones present on disk
+	// so that their files are removed as well. Tenants which are neither
+	// loaded nor present on disk are FROZEN, their files are removed from the
+	// offload backend.
+	var offloaded []string
+	for _, name := range tenants {
+		if idx.shards.Load(name) != nil {
+			continue
+		}
+		if !idx.shardExistsOnDisk(name) {
+			offloaded = append(offloaded, name)
+			continue
+		}
+		shard, err := NewShard(ctx, m.db.promMetrics, name, idx, class, idx.centralJobQueue)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load inactive tenant %q: %w", name, err)
+		}
+		idx.shards.Store(name, shard)
+	}
+
+	drop, err := idx.dropShards(tenants)
+	backend := m.db.offloadBackend
+	if err != nil || backend == nil || len(offloaded) == 0 {
+		return drop, err
+	}
+	return func(success bool) {
+		drop(success)
+		if !success {
+			return
+		}
+		for _, name := range offloaded {
+			// the request context might be gone by the time the deletion is committed
+			if err := idx.deleteOffloadedShard(context.Background(), backend, name); err != nil {
+				m.logger.WithField("action", "delete_tenant").
+					WithField("tenant", name).Errorf("delete offloaded files: %v", err)
+			}
+		}
+	}, nil
+}
+
+// DropShards deletes local shards which no longer belong to this node,
+// e.g. after the replication factor of a class has been decreased
+func (m *Migrator) DropShards(ctx context.Context, className string, shards []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+	commit, err := idx.dropShards(shards)
+	if err != nil {
+		return err
+	}
+	commit(true)
+	return nil
 }
 
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
@@ -218,13 +379,26 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 	return idx.updateVectorIndexConfig(ctx, updated)
 }
 
+func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update vector index configs of non-existing index for %s", className)
+	}
+
+	return idx.updateVectorIndexConfigs(ctx, updated)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
-	return hnsw.ValidateUserConfigUpdate(old, updated)
+	switch old.IndexType() {
+	case vectorindex.VectorIndexTypeFlat:
+		return flat.ValidateUserConfigUpdate(old, updated)
+	default:
+		return hnsw.ValidateUserConfigUpdate(old, updated)
+	}
 }
 
 func (m *Migrator) ValidateInvertedIndexConfigUpdate(ctx context.Context,
@@ -246,6 +420,68 @@ func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className stri
 	return idx.updateInvertedIndexConfig(ctx, conf)
 }
 
+// UpdatePropertyTokenization rebuilds the indexes of the given properties in
+// all local shards using their tokenization in the updated class. The shards
+// stay read-only until commit is called. Committing replaces the indexes and
+// has to happen together with replacing the class in the schema, so that
+// queries are never tokenized differently than the objects they run against.
+func (m *Migrator) UpdatePropertyTokenization(ctx context.Context, updated *models.Class,
+	propNames []string,
+) (commit func(success bool), err error) {
+	idx := m.db.GetIndex(schema.ClassName(updated.Class))
+	if idx == nil {
+		return nil, errors.Errorf("cannot update tokenization of non-existing index for %s", updated.Class)
+	}
+
+	var (
+		mu      sync.Mutex
+		commits []func(success bool) error
+	)
+	eg := &errgroup.Group{}
+	eg.SetLimit(_NUMCPU)
+	idx.ForEachShard(func(name string, shard *Shard) error {
+		eg.Go(func() error {
+			m.logInvertedReindexShard(shard).
+				WithField("properties", propNames).
+				Info("About to reindex properties with updated tokenization, this may take a while")
+
+			// reject writes, they would neither be tokenized with the updated
+			// class nor necessarily be seen by the reindex
+			status := shard.swapStatus(storagestate.StatusReadOnly)
+			reindexer := newShardInvertedReindexerForClass(shard, updated, m.logger)
+			commit, err := reindexer.PrepareTask(ctx,
+				newShardInvertedReindexTaskTokenizationForClass(updated, propNames))
+			if err != nil {
+				shard.updateStatus(status.String())
+				return errors.Wrapf(err, "failed reindexing shard '%s'", shard.ID())
+			}
+			mu.Lock()
+			commits = append(commits, func(success bool) error {
+				defer shard.updateStatus(status.String())
+				return commit(success)
+			})
+			mu.Unlock()
+			return nil
+		})
+		return nil
+	})
+
+	commit = func(success bool) {
+		for _, c := range commits {
+			if err := c(success); err != nil {
+				m.logger.WithField("action", "update_tokenization").
+					WithField("class", updated.Class).
+					WithError(err).Error("failed finishing reindex")
+			}
+		}
+	}
+	if err := eg.Wait(); err != nil {
+		commit(false)
+		return nil, err
+	}
+	return commit, nil
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
@@ -344,6 +580,9 @@ func (m *Migrator) doInvertedReindex(ctx context.Context, taskNames ...string) e
 		"ShardInvertedReindexTaskSetToRoaringSet": func() ShardInvertedReindexTask {
 			return &ShardInvertedReindexTaskSetToRoaringSet{}
 		},
+		"ShardInvertedReindexTaskTokenization": func() ShardInvertedReindexTask {
+			return NewShardInvertedReindexTaskTokenization(m.db.config.ReindexTokenization)
+		},
 	}
 
 	tasks := map[string]ShardInvertedReindexTask{}
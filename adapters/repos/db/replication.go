@@ -20,12 +20,10 @@ import (
 	"path/filepath"
 
 	"github.com/go-openapi/strfmt"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
-	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -288,26 +286,15 @@ func (s *Shard) reinit(ctx context.Context) error {
 		return fmt.Errorf("shutdown shard: %w", err)
 	}
 
-	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return fmt.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			s.index.vectorIndexUserConfig)
-	}
-
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return fmt.Errorf("init vector index: %w", err)
-		}
-
-		defer s.vectorIndex.PostStartup()
-	}
-
 	if err := s.initNonVector(ctx, nil); err != nil {
 		return fmt.Errorf("init non-vector: %w", err)
 	}
 
+	if err := s.initVectorIndexes(ctx); err != nil {
+		return err
+	}
+	defer s.postStartupVectorIndexes()
+
 	return nil
 }
 
@@ -350,7 +337,9 @@ func (i *Index) overwriteObjects(ctx context.Context,
 		case curUpdateTime == u.StaleUpdateTime:
 			// the stored object is not the most recent version. in
 			// this case, we overwrite it with the more recent one.
-			err := s.putObject(ctx, storobj.FromObject(data, u.Vector))
+			obj := storobj.FromObject(data, u.Vector, nil)
+			obj.Vectors = u.Vectors
+			err := s.putObject(ctx, obj)
 			if err != nil {
 				r.Err = fmt.Sprintf("overwrite stale object: %v", err)
 			}
@@ -432,6 +421,46 @@ func (i *Index) IncomingDigestObjects(ctx context.Context,
 	return i.digestObjects(ctx, shardName, ids)
 }
 
+func (db *DB) HashTreeLevel(ctx context.Context,
+	class, shardName string, level int,
+) ([]uint64, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("index %q not found", class)
+	}
+	return index.hashTreeLevel(ctx, shardName, level)
+}
+
+func (i *Index) hashTreeLevel(ctx context.Context,
+	shardName string, level int,
+) ([]uint64, error) {
+	s := i.shards.Load(shardName)
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	return s.hashTreeLevel(ctx, level)
+}
+
+func (db *DB) DigestObjectsInRange(ctx context.Context,
+	class, shardName string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("index %q not found", class)
+	}
+	return index.digestObjectsInRange(ctx, shardName, initialUUID, finalUUID, limit)
+}
+
+func (i *Index) digestObjectsInRange(ctx context.Context,
+	shardName string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	s := i.shards.Load(shardName)
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	return s.digestObjectsInRange(ctx, initialUUID, finalUUID, limit)
+}
+
 func (db *DB) FetchObject(ctx context.Context,
 	class, shardName string, id strfmt.UUID,
 ) (objects.Replica, error) {
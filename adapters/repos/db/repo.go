@@ -18,6 +18,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/storobj"
 
 	"github.com/pkg/errors"
@@ -71,6 +72,9 @@ type DB struct {
 	jobQueueCh          chan job
 	shutDownWg          sync.WaitGroup
 	maxNumberGoroutines int
+
+	// offloadBackend stores the files of FROZEN tenants
+	offloadBackend modulecapabilities.BackupBackend
 }
 
 func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
@@ -132,7 +136,11 @@ type Config struct {
 	MemtablesMaxSizeMB        int
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
+	ObjectsCompression        string
+	ChangeStream              config.ChangeStream
+	AntiEntropy               config.AntiEntropy
 	TrackVectorDimensions     bool
+	ReindexTokenization       map[string][]string
 	ServerVersion             string
 	GitHash                   string
 }
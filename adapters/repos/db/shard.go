@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
@@ -35,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/sync/errgroup"
@@ -46,11 +48,15 @@ const IdLockPoolSize = 128
 // database files for all the objects it owns. How a shard is determined for a
 // target object (e.g. Murmur hash, etc.) is still open at this point
 type Shard struct {
-	index           *Index // a reference to the underlying index, which in turn contains schema information
-	name            string
-	store           *lsmkv.Store
-	counter         *indexcounter.Counter
-	vectorIndex     VectorIndex
+	index       *Index // a reference to the underlying index, which in turn contains schema information
+	name        string
+	store       *lsmkv.Store
+	counter     *indexcounter.Counter
+	vectorIndex VectorIndex
+	// vectorIndexes holds one index per named vector of the class, keyed by
+	// the name of the target vector. It is empty for classes without named
+	// vectors.
+	vectorIndexes   map[string]VectorIndex
 	metrics         *Metrics
 	promMetrics     *monitoring.PrometheusMetrics
 	propertyIndices propertyspecific.Indices
@@ -82,6 +88,10 @@ type Shard struct {
 
 	vectorCycles   *hnsw.MaintenanceCycles
 	geoPropsCycles *hnsw.MaintenanceCycles
+
+	// changes holds the change events of this shard, it is nil if the change
+	// stream is disabled
+	changes *shardChanges
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -107,48 +117,177 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			index.vectorIndexUserConfig)
+	// the non-vector part needs to be initialized first, as the flat vector
+	// index stores its vectors in the shard's lsmkv store
+	if err := s.initNonVector(ctx, class); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return nil, fmt.Errorf("init vector index: %w", err)
+	if err := s.initVectorIndexes(ctx); err != nil {
+		return nil, err
+	}
+	defer s.postStartupVectorIndexes()
+
+	return s, nil
+}
+
+// initVectorIndexes initializes the class-level vector index as well as the
+// indexes of all named vectors
+func (s *Shard) initVectorIndexes(ctx context.Context) error {
+	vi, err := s.initVectorIndex(ctx, "", s.index.vectorIndexUserConfig)
+	if err != nil {
+		return fmt.Errorf("init vector index: %w", err)
+	}
+	s.vectorIndex = vi
+
+	s.vectorIndexes = make(map[string]VectorIndex, len(s.index.vectorIndexUserConfigs))
+	for targetVector, userConfig := range s.index.vectorIndexUserConfigs {
+		vi, err := s.initVectorIndex(ctx, targetVector, userConfig)
+		if err != nil {
+			return fmt.Errorf("init vector index for target vector %q: %w",
+				targetVector, err)
 		}
+		s.vectorIndexes[targetVector] = vi
+	}
 
-		defer s.vectorIndex.PostStartup()
+	return nil
+}
+
+func (s *Shard) postStartupVectorIndexes() {
+	s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		index.PostStartup()
+		return nil
+	})
+}
+
+func (s *Shard) initVectorIndex(ctx context.Context, targetVector string,
+	vectorIndexUserConfig schema.VectorIndexConfig,
+) (VectorIndex, error) {
+	switch userConfig := vectorIndexUserConfig.(type) {
+	case hnswent.UserConfig:
+		if userConfig.Skip {
+			return noop.NewIndex(), nil
+		}
+		return s.initHnswVectorIndex(ctx, targetVector, userConfig)
+	case flatent.UserConfig:
+		return s.initFlatVectorIndex(ctx, targetVector, userConfig)
+	default:
+		return nil, errors.Errorf("unsupported vector index config: %T",
+			vectorIndexUserConfig)
 	}
+}
 
-	if err := s.initNonVector(ctx, class); err != nil {
-		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+// vectorIndexID is the id of the vector index of the given target vector.
+// The class-level vector index keeps the id of the shard, so that existing
+// indexes are still found after an upgrade.
+func (s *Shard) vectorIndexID(targetVector string) string {
+	if targetVector == "" {
+		return s.ID()
 	}
+	return fmt.Sprintf("%s_vectors_%s", s.ID(), targetVector)
+}
 
-	return s, nil
+// forEachVectorIndex calls f for the class-level vector index and the indexes
+// of all named vectors. The class-level index is passed with an empty target
+// vector.
+func (s *Shard) forEachVectorIndex(f func(targetVector string, index VectorIndex) error) error {
+	if s.vectorIndex != nil {
+		if err := f("", s.vectorIndex); err != nil {
+			return err
+		}
+	}
+	for targetVector, index := range s.vectorIndexes {
+		if err := f(targetVector, index); err != nil {
+			return fmt.Errorf("target vector %q: %w", targetVector, err)
+		}
+	}
+	return nil
 }
 
-func (s *Shard) initVectorIndex(
-	ctx context.Context, hnswUserConfig hnswent.UserConfig,
-) error {
-	var distProv distancer.Provider
+// getVectorIndex returns the index of the given target vector. An empty
+// target vector refers to the class-level vector index, unless the class has
+// exactly one named vector.
+func (s *Shard) getVectorIndex(targetVector string) (VectorIndex, error) {
+	if targetVector == "" {
+		if len(s.vectorIndexes) == 1 {
+			for _, index := range s.vectorIndexes {
+				return index, nil
+			}
+		}
+		return s.vectorIndex, nil
+	}
 
-	switch hnswUserConfig.Distance {
+	index, ok := s.vectorIndexes[targetVector]
+	if !ok {
+		return nil, errors.Errorf("target vector %q does not exist", targetVector)
+	}
+	return index, nil
+}
+
+// defaultVectorIndex is the index which is used if no target vector is
+// specified, see getVectorIndex
+func (s *Shard) defaultVectorIndex() VectorIndex {
+	index, _ := s.getVectorIndex("")
+	return index
+}
+
+func (s *Shard) deleteFromVectorIndexes(docIDs ...uint64) error {
+	return s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Delete(docIDs...)
+	})
+}
+
+func (s *Shard) flushVectorIndexes() error {
+	return s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Flush()
+	})
+}
+
+func distanceProvider(distance string) (distancer.Provider, error) {
+	switch distance {
 	case "", hnswent.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case hnswent.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case hnswent.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case hnswent.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case hnswent.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
 	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
+	}
+}
+
+func (s *Shard) initFlatVectorIndex(ctx context.Context, targetVector string,
+	flatUserConfig flatent.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distanceProvider(flatUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	vi, err := flat.New(flat.Config{
+		ID:               s.vectorIndexID(targetVector),
+		TargetVector:     targetVector,
+		Logger:           s.index.logger,
+		DistanceProvider: distProv,
+	}, flatUserConfig, s.store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
+	}
+
+	return vi, nil
+}
+
+func (s *Shard) initHnswVectorIndex(ctx context.Context, targetVector string,
+	hnswUserConfig hnswent.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distanceProvider(hnswUserConfig.Distance)
+	if err != nil {
+		return nil, err
 	}
 
 	s.vectorCycles.Init(
@@ -171,26 +310,37 @@ func (s *Shard) initVectorIndex(
 		cyclemanager.HnswCommitLoggerCycleTicker(),
 		cyclemanager.NewFixedIntervalTicker(time.Duration(hnswUserConfig.CleanupIntervalSeconds)*time.Second))
 
+	id := s.vectorIndexID(targetVector)
+	vectorForID := s.vectorByIndexID
+	tempVectorForID := s.readVectorByIndexIDIntoSlice
+	if targetVector != "" {
+		vectorForID = func(ctx context.Context, indexID uint64) ([]float32, error) {
+			return s.namedVectorByIndexID(ctx, indexID, targetVector)
+		}
+		tempVectorForID = func(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+			return s.readNamedVectorByIndexIDIntoSlice(ctx, indexID, container, targetVector)
+		}
+	}
+
 	vi, err := hnsw.New(hnsw.Config{
 		Logger:               s.index.logger,
 		RootPath:             s.index.Config.RootPath,
-		ID:                   s.ID(),
+		ID:                   id,
 		ShardName:            s.name,
 		ClassName:            s.index.Config.ClassName.String(),
 		PrometheusMetrics:    s.promMetrics,
-		VectorForIDThunk:     s.vectorByIndexID,
-		TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
+		VectorForIDThunk:     vectorForID,
+		TempVectorForIDThunk: tempVectorForID,
 		DistanceProvider:     distProv,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), s.index.logger, s.vectorCycles.CommitLogMaintenance())
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id, s.index.logger, s.vectorCycles.CommitLogMaintenance())
 		},
 	}, hnswUserConfig, s.vectorCycles.TombstoneCleanup())
 	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 	}
-	s.vectorIndex = vi
 
-	return nil
+	return vi, nil
 }
 
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
@@ -199,6 +349,10 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return errors.Wrapf(err, "init shard %q: shard db", s.ID())
 	}
 
+	if err := s.initChangeLog(ctx); err != nil {
+		return errors.Wrapf(err, "init shard %q: change log", s.ID())
+	}
+
 	counter, err := indexcounter.New(s.ID(), s.index.Config.RootPath)
 	if err != nil {
 		return errors.Wrapf(err, "init shard %q: index counter", s.ID())
@@ -263,6 +417,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
 		lsmkv.WithSecondaryIndices(1),
 		lsmkv.WithMonitorCount(),
+		lsmkv.WithCompression(s.index.Config.ObjectsCompression),
 		s.dynamicMemtableSizing(),
 		s.memtableIdleConfig(),
 	)
@@ -319,8 +474,10 @@ func (s *Shard) drop() error {
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
-	// remove vector index
-	err = s.vectorIndex.Drop(ctx)
+	// remove vector indexes
+	err = s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Drop(ctx)
+	})
 	if err != nil {
 		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
 	}
@@ -492,6 +649,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasPositionIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketPositionsFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))...,
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -539,6 +705,41 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (s *Shard) updateVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	err := s.updateStatus(storagestate.StatusReadOnly.String())
+	if err != nil {
+		return fmt.Errorf("attempt to mark read-only: %w", err)
+	}
+
+	// the shard is only marked ready again once every index has applied its
+	// update, some updates (e.g. compression) complete asynchronously
+	wg := &sync.WaitGroup{}
+	for targetVector, cfg := range updated {
+		index, ok := s.vectorIndexes[targetVector]
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		if err := index.UpdateUserConfig(cfg, wg.Done); err != nil {
+			wg.Done()
+			return fmt.Errorf("target vector %q: %w", targetVector, err)
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		s.updateStatus(storagestate.StatusReady.String())
+	}()
+
+	return nil
+}
+
 func (s *Shard) shutdown(ctx context.Context) error {
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
@@ -555,11 +756,14 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush vector index commitlog")
 	}
 
-	if err := s.vectorIndex.Shutdown(ctx); err != nil {
+	err := s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return index.Shutdown(ctx)
+	})
+	if err != nil {
 		return errors.Wrap(err, "shut down vector index")
 	}
 
@@ -584,13 +788,28 @@ func (s *Shard) notifyReady() {
 		Debugf("shard=%s is ready", s.name)
 }
 
+// objectCount does not include expired objects which have not been deleted
+// yet
 func (s *Shard) objectCount() int {
 	b := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return 0
 	}
 
-	return b.Count()
+	count := b.Count()
+	expired, err := s.expiredDocIDs(context.Background())
+	if err != nil {
+		s.index.logger.WithField("action", "object_count").
+			WithField("shard", s.name).
+			WithError(err).
+			Warn("failed to exclude expired objects from the object count")
+		return count
+	}
+	if expired != nil {
+		count -= expired.Len()
+	}
+
+	return count
 }
 
 func (s *Shard) isFallbackToSearchable() bool {
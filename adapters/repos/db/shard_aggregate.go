@@ -21,8 +21,19 @@ import (
 func (s *Shard) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	index, err := s.getVectorIndex(params.TargetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	expired, err := s.expiredDocIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return aggregator.New(s.store, params, s.index.getSchema,
-		s.index.classSearcher, s.deletedDocIDs, s.index.stopwords, s.versioner.Version(),
-		s.vectorIndex, s.index.logger, s.propLengths, s.isFallbackToSearchable).
+		s.index.classSearcher, s.deletedDocIDs, expired, s.index.stopwords,
+		s.versioner.Version(), index, s.index.logger, s.propLengths,
+		s.isFallbackToSearchable).
 		Do(ctx)
 }
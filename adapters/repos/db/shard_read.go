@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -56,6 +57,10 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
 
+	if s.index.objectExpiry().expired(obj) {
+		return nil, nil
+	}
+
 	return obj, nil
 }
 
@@ -74,6 +79,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 		ids[i] = idBytes
 	}
 
+	expiry := s.index.objectExpiry()
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
@@ -89,6 +95,9 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
+		if expiry.expired(obj) {
+			continue
+		}
 		objects[i] = obj
 	}
 
@@ -115,6 +124,14 @@ func (s *Shard) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 		return false, nil
 	}
 
+	if expiry := s.index.objectExpiry(); expiry != nil {
+		obj, err := storobj.FromBinary(bytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal object")
+		}
+		return !expiry.expired(obj), nil
+	}
+
 	return true, nil
 }
 
@@ -166,6 +183,31 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 	return storobj.VectorFromBinary(bytes, container.Slice)
 }
 
+func (s *Shard) namedVectorByIndexID(ctx context.Context, indexID uint64, targetVector string) ([]float32, error) {
+	keyBuf := make([]byte, 8)
+	return s.readNamedVectorByIndexIDIntoSlice(ctx, indexID, &hnsw.VectorSlice{Buff8: keyBuf}, targetVector)
+}
+
+func (s *Shard) readNamedVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64,
+	container *hnsw.VectorSlice, targetVector string,
+) ([]float32, error) {
+	binary.LittleEndian.PutUint64(container.Buff8, indexID)
+
+	bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
+		GetBySecondaryIntoMemory(0, container.Buff8, container.Buff)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes == nil {
+		return nil, storobj.NewErrNotFoundf(indexID,
+			"no object for doc id, it could have been deleted")
+	}
+
+	container.Buff = newBuff
+	return storobj.NamedVectorFromBinary(bytes, container.Slice, targetVector)
+}
+
 func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties) ([]*storobj.Object, []float32, error) {
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
@@ -196,30 +238,48 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.index.getSchema.GetSchemaSkipAuth(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.index.logger, s.versioner.Version())
-		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
+		bm25objs, bm25count, err = s.index.objectExpiry().searchUnexpired(limit,
+			func(limit int) ([]*storobj.Object, []float32, error) {
+				return bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
+			})
 		if err != nil {
 			return nil, nil, err
 		}
-
 		return bm25objs, bm25count, nil
 	}
 
 	if filters == nil {
-		objs, err := s.objectList(ctx, limit, sort,
-			cursor, additional, s.index.Config.ClassName)
-		return objs, nil, err
-	}
-	objs, err := inverted.NewSearcher(s.index.logger, s.store,
+		if cursor != nil {
+			limit = cursor.Limit
+		}
+		return s.index.objectExpiry().searchUnexpired(limit,
+			func(limit int) ([]*storobj.Object, []float32, error) {
+				c := cursor
+				if c != nil {
+					withLimit := *cursor
+					withLimit.Limit = limit
+					c = &withLimit
+				}
+				objs, err := s.objectList(ctx, limit, sort,
+					c, additional, s.index.Config.ClassName)
+				return objs, nil, err
+			})
+	}
+	searcher := inverted.NewSearcher(s.index.logger, s.store,
 		s.index.getSchema.GetSchemaSkipAuth(),
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
-		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable).
-		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName)
-	return objs, nil, err
+		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable)
+	return s.index.objectExpiry().searchUnexpired(limit,
+		func(limit int) ([]*storobj.Object, []float32, error) {
+			objs, err := searcher.Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName)
+			return objs, nil, err
+		})
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
+	searchVector []float32, targetVector string, targetDist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	var (
 		ids       []uint64
@@ -228,6 +288,11 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		allowList helpers.AllowList
 	)
 
+	vectorIndex, err := s.getVectorIndex(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if filters != nil {
 		beforeFilter := time.Now()
 		list, err := s.buildAllowList(ctx, filters, additional)
@@ -238,56 +303,67 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
 	}
 
-	beforeVector := time.Now()
-	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
-			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search by distance")
-		}
-	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search")
+	vectorSearch := func(limit int) ([]uint64, []float32, error) {
+		beforeVector := time.Now()
+		if limit < 0 {
+			ids, dists, err = vectorIndex.SearchByVectorDistance(
+				searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "vector search by distance")
+			}
+		} else {
+			ids, dists, err = vectorIndex.SearchByVector(searchVector, limit, allowList)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "vector search")
+			}
 		}
-	}
-	if len(ids) == 0 {
-		return nil, nil, nil
-	}
 
-	if filters != nil {
-		s.metrics.FilteredVectorVector(time.Since(beforeVector))
+		if filters != nil {
+			s.metrics.FilteredVectorVector(time.Since(beforeVector))
+		}
+		return ids, dists, nil
 	}
 
 	if groupBy != nil {
+		ids, dists, err = vectorSearch(limit)
+		if err != nil || len(ids) == 0 {
+			return nil, nil, err
+		}
 		return s.groupResults(ctx, ids, dists, groupBy, additional)
 	}
 
-	if len(sort) > 0 {
-		beforeSort := time.Now()
-		ids, dists, err = s.sortDocIDsAndDists(ctx, limit, sort,
-			s.index.Config.ClassName, ids, dists)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search sort")
-		}
-		if filters != nil {
-			s.metrics.FilteredVectorSort(time.Since(beforeSort))
-		}
-	}
+	return s.index.objectExpiry().searchUnexpired(limit,
+		func(limit int) ([]*storobj.Object, []float32, error) {
+			ids, dists, err := vectorSearch(limit)
+			if err != nil || len(ids) == 0 {
+				return nil, nil, err
+			}
 
-	beforeObjects := time.Now()
+			if len(sort) > 0 {
+				beforeSort := time.Now()
+				ids, dists, err = s.sortDocIDsAndDists(ctx, limit, sort,
+					s.index.Config.ClassName, ids, dists)
+				if err != nil {
+					return nil, nil, errors.Wrap(err, "vector search sort")
+				}
+				if filters != nil {
+					s.metrics.FilteredVectorSort(time.Since(beforeSort))
+				}
+			}
 
-	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
-	if err != nil {
-		return nil, nil, err
-	}
+			beforeObjects := time.Now()
 
-	if filters != nil {
-		s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
-	}
+			bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+			objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+			if err != nil {
+				return nil, nil, err
+			}
 
-	return objs, dists, nil
+			if filters != nil {
+				s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
+			}
+			return objs, dists, nil
+		})
 }
 
 func (s *Shard) objectList(ctx context.Context, limit int,
@@ -437,10 +513,17 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
+	change, err := s.prepareChange(cdc.EventTypeDelete, id, nil)
+	if err != nil {
+		return errors.Wrap(err, "record change")
+	}
+
 	err = bucket.Delete(idBytes)
 	if err != nil {
+		change.abort()
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	change.commit()
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -451,7 +534,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
@@ -51,6 +51,18 @@ func (s *Shard) updateStatus(in string) error {
 	return nil
 }
 
+// swapStatus sets the status of the shard, but not of its store, and returns
+// the previous status. The store can thus still flush its memtables while
+// the shard rejects writes.
+func (s *Shard) swapStatus(status storagestate.Status) storagestate.Status {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	previous := s.status
+	s.status = status
+	return previous
+}
+
 func (s *Shard) updateStoreStatus(targetStatus storagestate.Status) {
 	s.store.UpdateBucketsStatus(targetStatus)
 }
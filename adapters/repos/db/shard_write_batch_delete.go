@@ -123,7 +123,7 @@ func (b *deleteObjectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.flushVectorIndexes(); err != nil {
 		for i := range b.objects {
 			b.setErrorAtIndex(err, i)
 		}
@@ -193,7 +193,7 @@ func (ob *objectsBatcher) markDeletedInVectorStorage(ctx context.Context) {
 		return
 	}
 
-	if err := ob.shard.vectorIndex.Delete(docIDsToDelete...); err != nil {
+	if err := ob.shard.deleteFromVectorIndexes(docIDsToDelete...); err != nil {
 		for _, pos := range positions {
 			ob.setErrorAtIndex(err, pos)
 		}
@@ -253,7 +253,7 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		return
 	}
 
-	if object.Vector != nil {
+	if object.Vector != nil || len(object.Vectors) > 0 {
 		// By this time all required deletes (e.g. because of DocID changes) have
 		// already been grouped and performed in bulk. Only the insertions are
 		// left. The motivation for this change is explained in
@@ -273,7 +273,7 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		// shard.updateVectorIndex which would also handle the delete as required
 		// for a non-batch update. Instead a new method has been introduced that
 		// ignores deletes.
-		if err := ob.shard.updateVectorIndexIgnoreDelete(object.Vector, status); err != nil {
+		if err := ob.shard.updateVectorIndexesIgnoreDelete(object.Vector, object.Vectors, status); err != nil {
 			ob.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), index)
 			return
 		}
@@ -329,7 +329,7 @@ func (ob *objectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := ob.shard.vectorIndex.Flush(); err != nil {
+	if err := ob.shard.flushVectorIndexes(); err != nil {
 		for i := range ob.objects {
 			ob.setErrorAtIndex(err, i)
 		}
@@ -289,7 +289,7 @@ func (b *referencesBatcher) analyzeRef(obj *storobj.Object,
 		refs = parsed
 	}
 
-	a := inverted.NewAnalyzer(nil)
+	a := inverted.NewAnalyzer(nil, nil)
 
 	countItems, err := a.RefCount(refs)
 	if err != nil {
@@ -338,7 +338,7 @@ func (b *referencesBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.flushVectorIndexes(); err != nil {
 		for i := range b.refs {
 			b.setErrorAtIndex(err, i)
 		}
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -54,10 +55,17 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
+	change, err := s.prepareChange(cdc.EventTypeDelete, id, nil)
+	if err != nil {
+		return errors.Wrap(err, "record change")
+	}
+
 	err = bucket.Delete(idBytes)
 	if err != nil {
+		change.abort()
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	change.commit()
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -68,7 +76,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
@@ -76,7 +84,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -111,10 +119,21 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
-	err := bucket.Delete(idBytes)
+	id, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return fmt.Errorf("parse uuid: %w", err)
+	}
+	change, err := s.prepareChange(cdc.EventTypeDelete, strfmt.UUID(id.String()), nil)
+	if err != nil {
+		return fmt.Errorf("record change: %w", err)
+	}
+
+	err = bucket.Delete(idBytes)
 	if err != nil {
+		change.abort()
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	change.commit()
 
 	err = s.cleanupInvertedIndexOnDelete(obj, docID)
 	if err != nil {
@@ -125,7 +144,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
@@ -133,7 +152,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		return fmt.Errorf("flush all buffered WALs: %w", err)
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
 	}
 
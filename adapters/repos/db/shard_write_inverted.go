@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -40,7 +41,13 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 	if err != nil {
 		return nil, nil, err
 	}
+	return s.analyzeObjectOfClass(c, object)
+}
 
+// analyzeObjectOfClass analyzes the object using the properties of the given
+// class rather than the ones of the class in the schema
+func (s *Shard) analyzeObjectOfClass(c *models.Class, object *storobj.Object,
+) ([]inverted.Property, []nilProp, error) {
 	var schemaMap map[string]interface{}
 
 	if object.Properties() == nil {
@@ -85,6 +92,6 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 		schemaMap[filters.InternalPropLastUpdateTimeUnix] = object.Object.LastUpdateTimeUnix
 	}
 
-	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
+	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable, s.index.analyzers).Object(schemaMap, c.Properties, object.ID())
 	return props, nilProps, err
 }
@@ -99,6 +99,21 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		}
 	}
 
+	if property.HasPositionIndex {
+		bucketValue := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(property.Name))
+		if bucketValue == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			key := item.Data
+			pair := s.pairPropertyWithPositions(docID, item.Positions)
+			if err := s.addToPropertyMapBucket(bucketValue, pair, key); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -154,6 +169,18 @@ func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) l
 	}
 }
 
+func (s *Shard) pairPropertyWithPositions(docID uint64, positions []uint32) lsmkv.MapPair {
+	// 8 bytes for doc id, 4 bytes for each position. The positions bucket
+	// was introduced after Shard Index version 2, so it is always BigEndian
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+
+	return lsmkv.MapPair{
+		Key:   key,
+		Value: inverted.EncodePositions(positions),
+	}
+}
+
 func (s *Shard) keyPropertyLength(length int) ([]byte, error) {
 	return inverted.LexicographicallySortableInt64(int64(length))
 }
@@ -54,6 +54,22 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 				}
 			}
 		}
+
+		if prop.HasPositionIndex {
+			bucket := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket positions for prop '%s' found", prop.Name)
+			}
+
+			docIDBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(docIDBytes, docID)
+			for _, item := range prop.Items {
+				if err := bucket.MapDeleteKey(item.Data, docIDBytes); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from positions index",
+						string(item.Data))
+				}
+			}
+		}
 	}
 
 	return nil
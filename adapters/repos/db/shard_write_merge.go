@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -35,6 +36,9 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
 		}
 	}
+	if err := s.validateNamedVectorsBeforeInsert(merge.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
+	}
 
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
@@ -50,7 +54,7 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return err
 	}
 
-	if err := s.updateVectorIndex(next.Vector, status); err != nil {
+	if err := s.updateVectorIndexes(next.Vector, next.Vectors, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
 
@@ -62,7 +66,7 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -102,10 +106,18 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, status, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
+	change, err := s.prepareChange(mergeEventType(previous, merge), nextObj.ID(), nextObj)
+	if err != nil {
+		lock.Unlock()
+		return nil, status, errors.Wrap(err, "record change")
+	}
+
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
+		change.abort()
 		lock.Unlock()
 		return nil, status, errors.Wrap(err, "upsert object data")
 	}
+	change.commit()
 	lock.Unlock()
 
 	if err := s.updateInvertedIndexLSM(nextObj, status, previous); err != nil {
@@ -170,9 +182,16 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
+	change, err := s.prepareChange(mergeEventType(previous, merge), nextObj.ID(), nextObj)
+	if err != nil {
+		return out, errors.Wrap(err, "record change")
+	}
+
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
+		change.abort()
 		return out, errors.Wrap(err, "upsert object data")
 	}
+	change.commit()
 
 	// do not updated inverted index, since this requires delta analysis, which
 	// must be done by the caller!
@@ -180,6 +199,22 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	return out, nil
 }
 
+// mergeEventType distinguishes merges which only add references from other
+// updates for the change stream
+func mergeEventType(previous []byte, merge objects.MergeDocument) cdc.EventType {
+	if previous == nil {
+		return cdc.EventTypeCreate
+	}
+
+	if len(merge.References) > 0 && len(merge.PrimitiveSchema) == 0 &&
+		len(merge.PropertiesToDelete) == 0 && merge.Vector == nil &&
+		len(merge.Vectors) == 0 {
+		return cdc.EventTypeReferenceChange
+	}
+
+	return cdc.EventTypeUpdate
+}
+
 type mutableMergeResult struct {
 	next     *storobj.Object
 	previous *storobj.Object
@@ -244,6 +279,15 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	// named vectors are replaced one by one, vectors which are not part of
+	// the merge are kept
+	for targetVector, vector := range merge.Vectors {
+		if next.Vectors == nil {
+			next.Vectors = map[string][]float32{}
+		}
+		next.Vectors[targetVector] = vector
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -47,13 +48,16 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	if err := s.validateNamedVectorsBeforeInsert(object.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for %v", uuid)
+	}
 
 	status, err := s.putObjectLSM(object, uuid)
 	if err != nil {
 		return errors.Wrap(err, "store object in LSM store")
 	}
 
-	if err := s.updateVectorIndex(object.Vector, status); err != nil {
+	if err := s.updateVectorIndexes(object.Vector, object.Vectors, status); err != nil {
 		return errors.Wrap(err, "update vector index")
 	}
 
@@ -69,17 +73,52 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "flush prop length tracker to disk")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
 	return nil
 }
 
+// validateNamedVectorsBeforeInsert makes sure that every named vector of an
+// object can be added to its index
+func (s *Shard) validateNamedVectorsBeforeInsert(vectors map[string][]float32) error {
+	for targetVector, vector := range vectors {
+		index, ok := s.vectorIndexes[targetVector]
+		if !ok {
+			return errors.Errorf("target vector %q does not exist", targetVector)
+		}
+		if err := index.ValidateBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "target vector %q", targetVector)
+		}
+	}
+	return nil
+}
+
+// vectorForIndex selects the vector of an object that belongs into the index
+// of the given target vector
+func vectorForIndex(targetVector string, vector []float32,
+	vectors map[string][]float32,
+) []float32 {
+	if targetVector == "" {
+		return vector
+	}
+	return vectors[targetVector]
+}
+
 // as the name implies this method only performs the insertions, but completely
 // ignores any deletes. It thus assumes that the caller has already taken care
 // of all the deletes in another way
-func (s *Shard) updateVectorIndexIgnoreDelete(vector []float32,
+func (s *Shard) updateVectorIndexesIgnoreDelete(vector []float32,
+	vectors map[string][]float32, status objectInsertStatus,
+) error {
+	return s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return s.updateVectorIndexIgnoreDelete(index,
+			vectorForIndex(targetVector, vector, vectors), status)
+	})
+}
+
+func (s *Shard) updateVectorIndexIgnoreDelete(index VectorIndex, vector []float32,
 	status objectInsertStatus,
 ) error {
 	// vector is now optional as of
@@ -88,14 +127,23 @@ func (s *Shard) updateVectorIndexIgnoreDelete(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := index.Add(status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
 	return nil
 }
 
-func (s *Shard) updateVectorIndex(vector []float32,
+func (s *Shard) updateVectorIndexes(vector []float32,
+	vectors map[string][]float32, status objectInsertStatus,
+) error {
+	return s.forEachVectorIndex(func(targetVector string, index VectorIndex) error {
+		return s.updateVectorIndex(index,
+			vectorForIndex(targetVector, vector, vectors), status)
+	})
+}
+
+func (s *Shard) updateVectorIndex(index VectorIndex, vector []float32,
 	status objectInsertStatus,
 ) error {
 	// even if no vector is provided in an update, we still need
@@ -103,7 +151,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	// exists. otherwise, the associated doc id is left dangling,
 	// resulting in failed attempts to merge an object on restarts.
 	if status.docIDChanged {
-		if err := s.vectorIndex.Delete(status.oldDocID); err != nil {
+		if err := index.Delete(status.oldDocID); err != nil {
 			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
 		}
 	}
@@ -114,7 +162,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := index.Add(status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
@@ -152,11 +200,23 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 		return status, errors.Wrapf(err, "marshal object %s to binary", object.ID())
 	}
 
+	eventType := cdc.EventTypeCreate
+	if previous_object_bytes != nil {
+		eventType = cdc.EventTypeUpdate
+	}
+	change, err := s.prepareChange(eventType, object.ID(), object)
+	if err != nil {
+		lock.Unlock()
+		return status, errors.Wrap(err, "record change")
+	}
+
 	before = time.Now()
 	if err := s.upsertObjectDataLSM(bucket, idBytes, data, status.docID); err != nil {
+		change.abort()
 		lock.Unlock()
 		return status, errors.Wrap(err, "upsert object data")
 	}
+	change.commit()
 	lock.Unlock()
 	s.metrics.PutObjectUpsertObject(before)
 
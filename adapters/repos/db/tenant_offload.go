@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// offloadDescriptorKey is the key of the shard descriptor listing all
+// files of an offloaded shard
+const offloadDescriptorKey = "shard.json"
+
+// SetTenantOffloadBackend sets the backend the files of FROZEN tenants are
+// offloaded to. It needs to be set after modules have been initialized.
+func (db *DB) SetTenantOffloadBackend(backend modulecapabilities.BackupBackend) {
+	db.offloadBackend = backend
+}
+
+// offloadID is the ID under which the files of a shard are stored on the
+// offload backend. Each replica of a shard offloads its own copy.
+func (i *Index) offloadID(shardName string) string {
+	return fmt.Sprintf("offload/%s/%s/%s", i.ID(), shardName, i.getSchema.NodeName())
+}
+
+// offloadShard uploads the files of a loaded shard to the backend, shuts
+// the shard down and removes its files from local disk. The shard must
+// already be detached from the index.
+//
+// The shard is shut down even if the offload fails. Its files are only
+// removed from local disk once all of them were uploaded, so the shard can
+// be restored with restoreShard.
+func (i *Index) offloadShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shard *Shard,
+) error {
+	desc := backup.ShardDescriptor{}
+	err := func() error {
+		if err := backend.Initialize(ctx, i.offloadID(shard.name)); err != nil {
+			return fmt.Errorf("init offload backend: %w", err)
+		}
+		if err := shard.beginBackup(ctx); err != nil {
+			return fmt.Errorf("prepare shard: %w", err)
+		}
+		if err := shard.listBackupFiles(ctx, &desc); err != nil {
+			return fmt.Errorf("list shard files: %w", err)
+		}
+		return nil
+	}()
+	if err2 := shard.shutdown(ctx); err2 != nil && err == nil {
+		err = fmt.Errorf("shut down shard: %w", err2)
+	}
+	if err != nil {
+		return err
+	}
+
+	id := i.offloadID(shard.name)
+
+	for _, file := range desc.Files {
+		if err := backend.PutFile(ctx, id, file, file); err != nil {
+			return fmt.Errorf("upload %q: %w", file, err)
+		}
+	}
+	data, err := json.Marshal(desc)
+	if err != nil {
+		return fmt.Errorf("marshal shard descriptor: %w", err)
+	}
+	if err := backend.PutObject(ctx, id, offloadDescriptorKey, data); err != nil {
+		return fmt.Errorf("upload shard descriptor: %w", err)
+	}
+
+	return i.removeShardFiles(&desc)
+}
+
+// downloadShard writes the files of an offloaded shard back to local disk.
+// Nothing is downloaded if the shard is still present on disk, e.g. because
+// a previous offload attempt failed.
+//
+// Files are downloaded into a temporary folder first and only moved into
+// place once all of them were written. The lsm folder is moved last, so an
+// interrupted download never leaves a shard which looks present on disk.
+func (i *Index) downloadShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shardName string,
+) error {
+	if i.shardExistsOnDisk(shardName) {
+		return nil
+	}
+
+	// leftovers of an interrupted download are discarded
+	tmpDir := i.shardDownloadPath(shardName)
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return fmt.Errorf("remove %q: %w", tmpDir, err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	id := i.offloadID(shardName)
+	data, err := backend.GetObject(ctx, id, offloadDescriptorKey)
+	if err != nil {
+		return fmt.Errorf("download shard descriptor: %w", err)
+	}
+	var desc backup.ShardDescriptor
+	if err := json.Unmarshal(data, &desc); err != nil {
+		return fmt.Errorf("unmarshal shard descriptor: %w", err)
+	}
+
+	for _, file := range desc.Files {
+		destPath := path.Join(tmpDir, file)
+		if err := os.MkdirAll(path.Dir(destPath), os.ModePerm); err != nil {
+			return fmt.Errorf("create folder for %q: %w", file, err)
+		}
+		if err := backend.WriteToFile(ctx, id, file, destPath); err != nil {
+			return fmt.Errorf("download %q: %w", file, err)
+		}
+	}
+
+	metadata := map[string][]byte{
+		desc.DocIDCounterPath:      desc.DocIDCounter,
+		desc.PropLengthTrackerPath: desc.PropLengthTracker,
+		desc.ShardVersionPath:      desc.Version,
+	}
+	for name, content := range metadata {
+		destPath := path.Join(tmpDir, name)
+		if err := os.MkdirAll(path.Dir(destPath), os.ModePerm); err != nil {
+			return fmt.Errorf("create folder for %q: %w", name, err)
+		}
+		if err := os.WriteFile(destPath, content, os.ModePerm); err != nil {
+			return fmt.Errorf("write %q: %w", name, err)
+		}
+	}
+
+	return i.moveDownloadedShard(tmpDir, shardName)
+}
+
+// moveDownloadedShard moves the top-level files and folders of a download
+// into the root folder, replacing anything left behind by an earlier
+// attempt. The lsm folder is moved last.
+func (i *Index) moveDownloadedShard(tmpDir, shardName string) error {
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		return fmt.Errorf("read %q: %w", tmpDir, err)
+	}
+
+	lsmName := path.Base(i.shardLSMPath(shardName))
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[b].Name() == lsmName && entries[a].Name() != lsmName
+	})
+
+	for _, entry := range entries {
+		destPath := path.Join(i.Config.RootPath, entry.Name())
+		if err := os.RemoveAll(destPath); err != nil {
+			return fmt.Errorf("remove %q: %w", destPath, err)
+		}
+		if err := os.Rename(path.Join(tmpDir, entry.Name()), destPath); err != nil {
+			return fmt.Errorf("move %q: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// restoreShard loads a shard again whose offload failed. Its files are
+// downloaded first if they were already removed from local disk.
+func (i *Index) restoreShard(ctx context.Context, backend modulecapabilities.BackupBackend,
+	shardName string, class *models.Class, promMetrics *monitoring.PrometheusMetrics,
+) error {
+	if err := i.downloadShard(ctx, backend, shardName); err != nil {
+		return fmt.Errorf("download: %w", err)
+	}
+	shard, err := NewShard(ctx, promMetrics, shardName, i, class, i.centralJobQueue)
+	if err != nil {
+		return fmt.Errorf("load: %w", err)
+	}
+	i.shards.Store(shardName, shard)
+	return nil
+}
+
+// deleteOffloadedShard removes the files of an offloaded shard from the backend
+func (i *Index) deleteOffloadedShard(ctx context.Context,
+	backend modulecapabilities.BackupBackend, shardName string,
+) error {
+	return backend.DeleteBackup(ctx, i.offloadID(shardName))
+}
+
+// removeShardFiles removes the files and folders of an offloaded shard.
+// Folders are removed as a whole since they belong to the shard only. The
+// lsm folder is removed first, a shard whose lsm folder still exists is
+// considered to be present on disk.
+func (i *Index) removeShardFiles(desc *backup.ShardDescriptor) error {
+	lsmPath := i.shardLSMPath(desc.Name)
+	if err := os.RemoveAll(lsmPath); err != nil {
+		return fmt.Errorf("remove %q: %w", lsmPath, err)
+	}
+
+	entries := map[string]struct{}{
+		desc.DocIDCounterPath:      {},
+		desc.PropLengthTrackerPath: {},
+		desc.ShardVersionPath:      {},
+	}
+	for _, file := range desc.Files {
+		entries[strings.Split(path.Clean(file), "/")[0]] = struct{}{}
+	}
+	for entry := range entries {
+		if err := os.RemoveAll(path.Join(i.Config.RootPath, entry)); err != nil {
+			return fmt.Errorf("remove %q: %w", entry, err)
+		}
+	}
+	return nil
+}
+
+func (i *Index) shardLSMPath(shardName string) string {
+	return path.Join(i.Config.RootPath, fmt.Sprintf("%s_%s_lsm", i.ID(), shardName))
+}
+
+// shardDownloadPath is the temporary folder the files of an offloaded shard
+// are downloaded to
+func (i *Index) shardDownloadPath(shardName string) string {
+	return path.Join(i.Config.RootPath, fmt.Sprintf(".%s_%s_download", i.ID(), shardName))
+}
+
+// shardExistsOnDisk checks if a shard which is not loaded has files on disk
+func (i *Index) shardExistsOnDisk(shardName string) bool {
+	_, err := os.Stat(i.shardLSMPath(shardName))
+	return err == nil
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func (index *Index) currentPQConfig() ent.PQConfig {
+	return index.pqConfig.Load().(ent.PQConfig)
+}
+
+func (index *Index) compressed() bool {
+	index.compressionLock.RLock()
+	defer index.compressionLock.RUnlock()
+	return index.pq != nil
+}
+
+// compressIfRequired starts fitting the product quantizer in the background
+// once compression is enabled and enough vectors are present to train it.
+// The callback is fired once the compression is complete or immediately if
+// there is nothing to do.
+func (index *Index) compressIfRequired(callback func()) {
+	cfg := index.currentPQConfig()
+	// k-means requires at least as many vectors as centroids
+	required := cfg.TrainingLimit
+	if required < cfg.Centroids {
+		required = cfg.Centroids
+	}
+	if !cfg.Enabled || index.compressed() ||
+		atomic.LoadInt64(&index.count) < int64(required) {
+		callback()
+		return
+	}
+
+	if !index.compressing.CompareAndSwap(false, true) {
+		// a compression is already running
+		callback()
+		return
+	}
+
+	go func() {
+		defer callback()
+		defer index.compressing.Store(false)
+
+		index.logger.WithField("action", "compress").WithField("id", index.id).
+			Info("switching to compressed vectors")
+		if err := index.compress(cfg); err != nil {
+			index.logger.WithField("action", "compress").WithField("id", index.id).
+				Error(err)
+			return
+		}
+		index.logger.WithField("action", "compress").WithField("id", index.id).
+			Info("vector compression complete")
+	}()
+}
+
+func (index *Index) compress(cfg ent.PQConfig) error {
+	dims := int(atomic.LoadInt32(&index.dims))
+	if dims == 0 {
+		return errors.New("cannot compress an empty index")
+	}
+
+	// segments == 0 (default value) means use as many segments as dimensions
+	segments := cfg.Segments
+	if segments <= 0 {
+		segments = dims
+	}
+
+	limit := cfg.TrainingLimit
+	if limit < cfg.Centroids {
+		limit = cfg.Centroids
+	}
+
+	pq, err := ssdhelpers.NewProductQuantizer(hnswent.PQConfig{
+		Enabled:       true,
+		Segments:      segments,
+		Centroids:     cfg.Centroids,
+		TrainingLimit: limit,
+		Encoder:       cfg.Encoder,
+	}, index.distancerProvider, dims)
+	if err != nil {
+		return errors.Wrap(err, "init product quantizer")
+	}
+
+	data := make([][]float32, 0, limit)
+	cursor := index.bucket().Cursor()
+	for k, v := cursor.First(); k != nil && len(data) < limit; k, v = cursor.Next() {
+		data = append(data, vectorFromBytes(v, nil))
+	}
+	cursor.Close()
+	pq.Fit(data)
+
+	// Block inserts while encoding, so every vector present once the lock is
+	// released has a code.
+	index.compressionLock.Lock()
+	defer index.compressionLock.Unlock()
+
+	codes := make([][]byte, atomic.LoadInt64(&index.count))
+	buf := make([]float32, dims)
+	cursor = index.bucket().Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		id := binary.LittleEndian.Uint64(k)
+		if id >= uint64(len(codes)) {
+			grown := make([][]byte, id+1+id/4)
+			copy(grown, codes)
+			codes = grown
+		}
+		buf = vectorFromBytes(v, buf)
+		codes[id] = pq.Encode(buf)
+	}
+	cursor.Close()
+
+	index.codesLock.Lock()
+	index.codes = codes
+	index.codesLock.Unlock()
+	index.pq = pq
+
+	return nil
+}
+
+func (index *Index) dropCompression() {
+	index.compressionLock.Lock()
+	defer index.compressionLock.Unlock()
+
+	index.codesLock.Lock()
+	index.codes = nil
+	index.codesLock.Unlock()
+	index.pq = nil
+}
@@ -0,0 +1,349 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package flat provides a brute-force vector index. Vectors are persisted in
+// an lsmkv bucket of the owning shard and every search scans all of them (or
+// only the ones contained in the allow list). There is no graph to build or
+// maintain, which makes the index a good fit for small collections, e.g.
+// tenants with a few thousand objects.
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+type Config struct {
+	ID               string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+	// TargetVector is the name of the named vector this index is built for,
+	// it is empty for the class-level vector
+	TargetVector string
+}
+
+func (c Config) Validate() error {
+	if c.ID == "" {
+		return errors.Errorf("id cannot be empty")
+	}
+
+	if c.Logger == nil {
+		return errors.Errorf("logger cannot be nil")
+	}
+
+	if c.DistanceProvider == nil {
+		return errors.Errorf("distancerProvider cannot be nil")
+	}
+
+	return nil
+}
+
+type Index struct {
+	id                string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+	bucketName        string
+
+	// dims of the vectors stored in the index, 0 as long as the index is empty
+	dims  int32
+	count int64
+
+	// pqConfig may change at runtime, see UpdateUserConfig
+	pqConfig atomic.Value
+
+	// compressionLock protects pq. The compression itself holds the write
+	// lock while encoding, so that concurrent inserts cannot be missed.
+	compressionLock sync.RWMutex
+	pq              *ssdhelpers.ProductQuantizer
+	compressing     atomic.Bool
+
+	// codes holds the compressed vectors indexed by their id
+	codesLock sync.RWMutex
+	codes     [][]byte
+
+	// idLocks serialize the existence check and the write of an id, so that
+	// count only changes when an id is actually added or removed
+	idLocks [idLockCount]sync.Mutex
+}
+
+const idLockCount = 512
+
+// New creates a flat index which persists its vectors in the given store.
+// The store is owned by the shard, the index only adds a bucket to it.
+func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if store == nil {
+		return nil, errors.Errorf("store cannot be nil")
+	}
+
+	bucketName := helpers.VectorsBucketFromTargetVector(cfg.TargetVector)
+	err := store.CreateOrLoadBucket(context.Background(), bucketName,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	if err != nil {
+		return nil, errors.Wrap(err, "create or load vectors bucket")
+	}
+
+	index := &Index{
+		id:                cfg.ID,
+		logger:            cfg.Logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		bucketName:        bucketName,
+	}
+	index.pqConfig.Store(uc.PQ)
+	index.initDims()
+
+	return index, nil
+}
+
+func (index *Index) bucket() *lsmkv.Bucket {
+	return index.store.Bucket(index.bucketName)
+}
+
+// initDims restores the dimensions and the count of an existing index.
+func (index *Index) initDims() {
+	bucket := index.bucket()
+	atomic.StoreInt64(&index.count, int64(bucket.Count()))
+
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	if _, v := cursor.First(); v != nil {
+		atomic.StoreInt32(&index.dims, int32(len(v)/4))
+	}
+}
+
+func (index *Index) ValidateBeforeInsert(vector []float32) error {
+	dims := int(atomic.LoadInt32(&index.dims))
+	if dims == 0 {
+		return nil
+	}
+
+	if dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+
+	return nil
+}
+
+func (index *Index) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	atomic.CompareAndSwapInt32(&index.dims, 0, int32(len(vector)))
+	if err := index.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	vector = index.normalized(vector)
+
+	index.compressionLock.RLock()
+	added, err := index.put(id, vector)
+	if err != nil {
+		index.compressionLock.RUnlock()
+		return err
+	}
+	if index.pq != nil {
+		index.storeCode(id, index.pq.Encode(vector))
+	}
+	index.compressionLock.RUnlock()
+
+	if added {
+		atomic.AddInt64(&index.count, 1)
+	}
+	index.compressIfRequired(func() {})
+	return nil
+}
+
+// put stores the vector and indicates whether the id was not present before
+func (index *Index) put(id uint64, vector []float32) (bool, error) {
+	lock := &index.idLocks[id%idLockCount]
+	lock.Lock()
+	defer lock.Unlock()
+
+	existing, err := index.bucket().Get(idBytes(id))
+	if err != nil {
+		return false, errors.Wrapf(err, "get vector %d", id)
+	}
+	if err := index.bucket().Put(idBytes(id), vectorBytes(vector)); err != nil {
+		return false, errors.Wrapf(err, "put vector %d", id)
+	}
+	return existing == nil, nil
+}
+
+// delete removes the vector and indicates whether the id was present before
+func (index *Index) delete(id uint64) (bool, error) {
+	lock := &index.idLocks[id%idLockCount]
+	lock.Lock()
+	defer lock.Unlock()
+
+	existing, err := index.bucket().Get(idBytes(id))
+	if err != nil {
+		return false, errors.Wrapf(err, "get vector %d", id)
+	}
+	if existing == nil {
+		return false, nil
+	}
+	if err := index.bucket().Delete(idBytes(id)); err != nil {
+		return false, errors.Wrapf(err, "delete vector %d", id)
+	}
+	return true, nil
+}
+
+func (index *Index) Delete(ids ...uint64) error {
+	index.compressionLock.RLock()
+	defer index.compressionLock.RUnlock()
+
+	for _, id := range ids {
+		deleted, err := index.delete(id)
+		if err != nil {
+			return err
+		}
+		index.storeCode(id, nil)
+		if deleted {
+			atomic.AddInt64(&index.count, -1)
+		}
+	}
+
+	return nil
+}
+
+func (index *Index) storeCode(id uint64, code []byte) {
+	index.codesLock.Lock()
+	defer index.codesLock.Unlock()
+
+	if id >= uint64(len(index.codes)) {
+		if code == nil {
+			return
+		}
+		grown := make([][]byte, id+1+id/4)
+		copy(grown, index.codes)
+		index.codes = grown
+	}
+	index.codes[id] = code
+}
+
+func (index *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	index.pqConfig.Store(parsed.PQ)
+	if !parsed.PQ.Enabled {
+		index.dropCompression()
+		callback()
+		return nil
+	}
+
+	// the compression will fire the callback once it's complete
+	index.compressIfRequired(callback)
+	return nil
+}
+
+func (index *Index) Drop(ctx context.Context) error {
+	// the vectors bucket is part of the shard's store and is removed together
+	// with it
+	return nil
+}
+
+func (index *Index) Flush() error {
+	// nothing to do, the vectors bucket is flushed by the shard's store
+	return nil
+}
+
+func (index *Index) Shutdown(ctx context.Context) error {
+	index.dropCompression()
+	return nil
+}
+
+func (index *Index) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (index *Index) ListFiles(context.Context) ([]string, error) {
+	// the vectors bucket is part of the shard's store, its files are listed
+	// together with the rest of the store
+	return nil, nil
+}
+
+func (index *Index) PostStartup() {
+	// compressed codes are only held in memory, they need to be rebuilt after
+	// every restart
+	index.compressIfRequired(func() {})
+}
+
+func (index *Index) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", labels[0])
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Dimensions: %d\n", atomic.LoadInt32(&index.dims))
+	fmt.Printf("Vectors: %d\n", atomic.LoadInt64(&index.count))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *Index) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func idBytes(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, id)
+	return key
+}
+
+func vectorBytes(vector []float32) []byte {
+	out := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func vectorFromBytes(in []byte, out []float32) []float32 {
+	n := len(in) / 4
+	if cap(out) < n {
+		out = make([]float32, n)
+	}
+	out = out[:n]
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return out
+}
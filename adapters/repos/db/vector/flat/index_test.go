@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func newTestIndex(t *testing.T, uc ent.UserConfig) (*Index, *lsmkv.Store) {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(t.TempDir(), "", logger, nil)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		store.Shutdown(context.Background())
+	})
+
+	index, err := New(Config{
+		ID:               "flat-test",
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc, store)
+	require.Nil(t, err)
+
+	return index, store
+}
+
+func TestFlatIndex(t *testing.T) {
+	index, store := newTestIndex(t, ent.NewDefaultUserConfig())
+
+	vectors := [][]float32{
+		{0, 0},
+		{1, 0},
+		{2, 0},
+		{3, 0},
+		{4, 0},
+	}
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("search by vector", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector([]float32{3.1, 0}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3, 4, 2}, ids)
+		assert.InDelta(t, 0.01, dists[0], 1e-4)
+	})
+
+	t.Run("search with an allow list", func(t *testing.T) {
+		allow := helpers.NewAllowList(0, 1, 4)
+		ids, _, err := index.SearchByVector([]float32{3.1, 0}, 2, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{4, 1}, ids)
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		ids, _, err := index.SearchByVectorDistance([]float32{0, 0}, 4, -1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1, 2}, ids)
+
+		ids, _, err = index.SearchByVectorDistance([]float32{0, 0}, 4, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, ids)
+	})
+
+	t.Run("vectors with a different length are rejected", func(t *testing.T) {
+		assert.NotNil(t, index.Add(10, []float32{1, 2, 3}))
+	})
+
+	t.Run("deleted vectors are not returned", func(t *testing.T) {
+		require.Nil(t, index.Delete(3))
+		ids, _, err := index.SearchByVector([]float32{3.1, 0}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{4, 2}, ids)
+	})
+
+	t.Run("count only changes for new and removed ids", func(t *testing.T) {
+		require.Nil(t, index.Add(4, []float32{4, 1}))
+		require.Nil(t, index.Delete(3))
+		require.Nil(t, index.Delete(42))
+		assert.Equal(t, int64(4), index.count)
+	})
+
+	t.Run("dimensions and count are restored from the bucket", func(t *testing.T) {
+		restored, err := New(Config{
+			ID:               "flat-test",
+			Logger:           index.logger,
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+		}, ent.NewDefaultUserConfig(), store)
+		require.Nil(t, err)
+		assert.Equal(t, int32(2), restored.dims)
+		assert.Equal(t, int64(4), restored.count)
+	})
+}
+
+func TestFlatIndexCompressed(t *testing.T) {
+	dims := 16
+	size := 500
+	k := 10
+
+	uc := ent.NewDefaultUserConfig()
+	uc.PQ.Enabled = true
+	uc.PQ.Segments = 4
+	uc.PQ.Centroids = 16
+	uc.PQ.TrainingLimit = 200
+	uc.PQ.RescoreLimit = 50
+
+	index, _ := newTestIndex(t, uc)
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dims)
+		for j := range vectors[i] {
+			vectors[i][j] = r.Float32()
+		}
+	}
+
+	for i := 0; i < uc.PQ.TrainingLimit; i++ {
+		require.Nil(t, index.Add(uint64(i), vectors[i]))
+	}
+
+	assert.Eventually(t, index.compressed, 10*time.Second, 10*time.Millisecond,
+		"index should switch to compressed vectors once the training limit is reached")
+
+	for i := uc.PQ.TrainingLimit; i < size; i++ {
+		require.Nil(t, index.Add(uint64(i), vectors[i]))
+	}
+
+	index.codesLock.RLock()
+	for i := 0; i < size; i++ {
+		require.NotNil(t, index.codes[i], "vector %d has no code", i)
+	}
+	index.codesLock.RUnlock()
+
+	t.Run("the exact vector is found", func(t *testing.T) {
+		for _, id := range []int{0, 250, 499} {
+			ids, dists, err := index.SearchByVector(vectors[id], k, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, k)
+			assert.Equal(t, uint64(id), ids[0])
+			assert.InDelta(t, 0, dists[0], 1e-6)
+		}
+	})
+
+	t.Run("disabling pq drops the compression", func(t *testing.T) {
+		uc.PQ.Enabled = false
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.False(t, index.compressed())
+
+		ids, _, err := index.SearchByVector(vectors[42], k, nil)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(42), ids[0])
+	})
+}
@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+func (index *Index) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	vector = index.normalized(vector)
+
+	index.compressionLock.RLock()
+	defer index.compressionLock.RUnlock()
+
+	if index.pq != nil {
+		return index.searchCompressed(vector, k, allow)
+	}
+
+	results := priorityqueue.NewMax(k)
+	err := index.iterate(allow, func(id uint64, candidate []float32) error {
+		dist, _, err := index.distancerProvider.SingleDist(vector, candidate)
+		if err != nil {
+			return err
+		}
+		addToQueue(results, k, id, dist)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := queueToSlices(results)
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance returns all vectors within the target distance. The
+// uncompressed vectors are always used, since a threshold on approximate
+// distances could drop matching results.
+func (index *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vector = index.normalized(vector)
+
+	var ids []uint64
+	var dists []float32
+	err := index.iterate(allow, func(id uint64, candidate []float32) error {
+		dist, _, err := index.distancerProvider.SingleDist(vector, candidate)
+		if err != nil {
+			return err
+		}
+		if dist <= targetDistance ||
+			floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6) {
+			ids = append(ids, id)
+			dists = append(dists, dist)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Sort(byDistance{ids: ids, dists: dists})
+	if maxLimit >= 0 && int64(len(ids)) > maxLimit {
+		index.logger.
+			WithField("action", "unlimited_vector_search").
+			Warnf("maximum search limit of %d results has been reached", maxLimit)
+		ids, dists = ids[:maxLimit], dists[:maxLimit]
+	}
+
+	return ids, dists, nil
+}
+
+// searchCompressed scans the compressed codes and rescores the best
+// candidates with their uncompressed vectors. It must be called while
+// holding the compressionLock.
+func (index *Index) searchCompressed(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	rescoreLimit := index.currentPQConfig().RescoreLimit
+	if rescoreLimit < k {
+		rescoreLimit = k
+	}
+
+	distancer := index.pq.NewDistancer(vector)
+	defer index.pq.ReturnDistancer(distancer)
+
+	candidates := priorityqueue.NewMax(rescoreLimit)
+	index.codesLock.RLock()
+	if allow != nil {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			if id >= uint64(len(index.codes)) || index.codes[id] == nil {
+				continue
+			}
+			dist, _, _ := distancer.Distance(index.codes[id])
+			addToQueue(candidates, rescoreLimit, id, dist)
+		}
+	} else {
+		for id, code := range index.codes {
+			if code == nil {
+				continue
+			}
+			dist, _, _ := distancer.Distance(code)
+			addToQueue(candidates, rescoreLimit, uint64(id), dist)
+		}
+	}
+	index.codesLock.RUnlock()
+
+	results := priorityqueue.NewMax(k)
+	bucket := index.bucket()
+	var buf []float32
+	for candidates.Len() > 0 {
+		id := candidates.Pop().ID
+		v, err := bucket.Get(idBytes(id))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "get vector %d", id)
+		}
+		if v == nil {
+			// deleted in the meantime
+			continue
+		}
+		buf = vectorFromBytes(v, buf)
+		dist, _, err := index.distancerProvider.SingleDist(vector, buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		addToQueue(results, k, id, dist)
+	}
+
+	ids, dists := queueToSlices(results)
+	return ids, dists, nil
+}
+
+// iterate calls fn for every stored vector, or only for those contained in
+// allow if it is set. The vector passed to fn is only valid for the duration
+// of the call.
+func (index *Index) iterate(allow helpers.AllowList,
+	fn func(id uint64, vector []float32) error,
+) error {
+	bucket := index.bucket()
+	var buf []float32
+
+	if allow != nil {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			v, err := bucket.Get(idBytes(id))
+			if err != nil {
+				return errors.Wrapf(err, "get vector %d", id)
+			}
+			if v == nil {
+				continue
+			}
+			buf = vectorFromBytes(v, buf)
+			if err := fn(id, buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		buf = vectorFromBytes(v, buf)
+		if err := fn(binary.LittleEndian.Uint64(k), buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addToQueue(q *priorityqueue.Queue, limit int, id uint64, dist float32) {
+	if q.Len() < limit {
+		q.Insert(id, dist)
+	} else if q.Top().Dist > dist {
+		q.Pop()
+		q.Insert(id, dist)
+	}
+}
+
+// queueToSlices drains a max queue into slices ordered by ascending distance
+func queueToSlices(q *priorityqueue.Queue) ([]uint64, []float32) {
+	ids := make([]uint64, q.Len())
+	dists := make([]float32, q.Len())
+
+	// results is ordered in reverse, we need to flip the order before presenting
+	// to the user!
+	i := len(ids) - 1
+	for q.Len() > 0 {
+		res := q.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+		i--
+	}
+
+	return ids, dists
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s byDistance) Len() int {
+	return len(s.ids)
+}
+
+func (s byDistance) Less(i, j int) bool {
+	return s.dists[i] < s.dists[j]
+}
+
+func (s byDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
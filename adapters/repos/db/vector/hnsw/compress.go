@@ -15,17 +15,26 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
+func (h *hnsw) compressedStorePath() string {
+	return fmt.Sprintf("%s/%s/%s", h.rootPath, h.className, h.shardName)
+}
+
 func (h *hnsw) initCompressedStore() error {
-	store, err := lsmkv.New(fmt.Sprintf("%s/%s/%s", h.rootPath, h.className, h.shardName), "", h.logger, nil)
+	store, err := lsmkv.New(h.compressedStorePath(), "", h.logger, nil)
 	if err != nil {
 		return errors.Wrap(err, "Init lsmkv (compressed vectors store)")
 	}
@@ -57,7 +66,7 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 		cfg.Segments = dims
 	}
 
-	h.pq, err = ssdhelpers.NewProductQuantizer(cfg, h.distancerProvider, dims)
+	pq, err := ssdhelpers.NewProductQuantizer(cfg, h.distancerProvider, dims)
 	if err != nil {
 		return errors.Wrap(err, "Compressing vectors.")
 	}
@@ -71,25 +80,136 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 		cleanData = append(cleanData, point)
 	}
 	h.compressedVectorsCache.grow(uint64(len(data)))
-	h.pq.Fit(cleanData)
+	pq.Fit(cleanData)
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
 	ssdhelpers.Concurrently(uint64(len(cleanData)),
 		func(index uint64) {
-			encoded := h.pq.Encode(cleanData[index])
+			encoded := pq.Encode(cleanData[index])
 			h.storeCompressedVector(index, encoded)
 			h.compressedVectorsCache.preload(index, encoded)
 		})
-	if err := h.commitLog.AddPQ(h.pq.ExposeFields()); err != nil {
+	if err := h.commitLog.AddPQ(pq.ExposeFields()); err != nil {
 		return errors.Wrap(err, "Adding PQ to the commit logger")
 	}
 
+	h.compressor = pq
 	h.compressed.Store(true)
 	h.cache.drop()
 	return nil
 }
 
+// compressBinary switches the index to binary quantized vectors. Contrary to
+// PQ there is nothing to fit, so all present vectors are encoded right away
+// and an empty index is compressed from the very first insert.
+func (h *hnsw) compressBinary() error {
+	if h.compressedStore == nil {
+		if err := h.initCompressedStore(); err != nil {
+			return errors.Wrap(err, "Initializing compressed vector store")
+		}
+	}
+
+	bq := ssdhelpers.NewBinaryQuantizer(h.distancerProvider, int(atomic.LoadInt32(&h.dims)))
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	// a previous, interrupted run must not be mistaken for a complete one
+	if err := h.removeBinaryCompressionMarker(); err != nil {
+		return err
+	}
+
+	h.RLock()
+	size := uint64(len(h.nodes))
+	h.RUnlock()
+
+	h.compressedVectorsCache.grow(size)
+	var encodeErr error
+	var encodeErrOnce sync.Once
+	ssdhelpers.Concurrently(size,
+		func(id uint64) {
+			if h.nodeByID(id) == nil {
+				return
+			}
+			vec, err := h.vectorForID(context.Background(), id)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if !errors.As(err, &e) {
+					encodeErrOnce.Do(func() { encodeErr = err })
+				}
+				return
+			}
+			encoded := bq.Encode(vec)
+			h.storeCompressedVector(id, encoded)
+			h.compressedVectorsCache.preload(id, encoded)
+		})
+	if encodeErr != nil {
+		return errors.Wrap(encodeErr, "Encoding vectors")
+	}
+
+	if err := h.compressedStore.Bucket(helpers.CompressedObjectsBucketLSM).WriteWAL(); err != nil {
+		return errors.Wrap(err, "Writing compressed vectors")
+	}
+	if err := h.writeBinaryCompressionMarker(bq.Dimensions()); err != nil {
+		return err
+	}
+	// rescoring reads the uncompressed vectors into buffers of this size
+	atomic.CompareAndSwapInt32(&h.dims, 0, int32(bq.Dimensions()))
+
+	h.compressor = bq
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
+// binaryCompressionMarker is written once all vectors present at the time of
+// the compression are encoded. It holds the original dimensions, which are
+// needed to decode the vectors, or 0 if the index was empty.
+const binaryCompressionMarker = "bq.complete"
+
+func (h *hnsw) binaryCompressionMarkerPath() string {
+	return filepath.Join(h.compressedStorePath(), binaryCompressionMarker)
+}
+
+func (h *hnsw) writeBinaryCompressionMarker(dims int) error {
+	path := h.binaryCompressionMarkerPath()
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dims))
+	if err := os.WriteFile(path+".tmp", buf, 0o666); err != nil {
+		return errors.Wrap(err, "Writing binary compression marker")
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return errors.Wrap(err, "Writing binary compression marker")
+	}
+	return nil
+}
+
+// readBinaryCompressionMarker returns the dimensions stored in the marker and
+// whether the marker exists at all
+func (h *hnsw) readBinaryCompressionMarker() (int, bool, error) {
+	buf, err := os.ReadFile(h.binaryCompressionMarkerPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+		return 0, false, errors.Wrap(err, "Reading binary compression marker")
+	}
+	if len(buf) != 4 {
+		// an incomplete marker is treated like a missing one
+		return 0, false, nil
+	}
+	return int(binary.LittleEndian.Uint32(buf)), true, nil
+}
+
+func (h *hnsw) removeBinaryCompressionMarker() error {
+	err := os.Remove(h.binaryCompressionMarkerPath())
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "Removing binary compression marker")
+	}
+	return nil
+}
+
 //nolint:unused
 func (h *hnsw) encodedVector(id uint64) ([]byte, error) {
 	return h.compressedVectorsCache.get(context.Background(), id)
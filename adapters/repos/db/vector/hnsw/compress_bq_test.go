@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package hnsw
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func bqTestVectors(size, dimensions int) [][]float32 {
+	r := getRandomSeed()
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		vectors[i] = make([]float32, dimensions)
+		for j := range vectors[i] {
+			// binary quantization only keeps the sign, so the vectors need to be
+			// centered around zero
+			vectors[i][j] = r.Float32()*2 - 1
+		}
+	}
+	return vectors
+}
+
+func bqTestIndex(t *testing.T, vectors [][]float32, uc ent.UserConfig) *hnsw {
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "bq",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewNoop())
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		index.Shutdown(context.Background())
+	})
+	return index
+}
+
+func bqTestUserConfig() ent.UserConfig {
+	uc := ent.UserConfig{}
+	uc.MaxConnections = 32
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.VectorCacheMaxObjects = 10e12
+	return uc
+}
+
+func TestBinaryQuantization(t *testing.T) {
+	dimensions := 64
+	vectors := bqTestVectors(2000, dimensions)
+
+	assertExactMatches := func(t *testing.T, index *hnsw) {
+		for _, id := range []int{0, 500, 1999} {
+			ids, dists, err := index.SearchByVector(vectors[id], 10, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 10)
+			assert.Equal(t, uint64(id), ids[0])
+			// the results are rescored, so the distance is exact
+			assert.InDelta(t, 0, dists[0], 1e-6)
+		}
+	}
+
+	t.Run("enabled before the first insert", func(t *testing.T) {
+		uc := bqTestUserConfig()
+		uc.BQ.Enabled = true
+		index := bqTestIndex(t, vectors, uc)
+		require.True(t, index.compressed.Load())
+
+		ssdhelpers.Concurrently(uint64(len(vectors)), func(id uint64) {
+			require.Nil(t, index.Add(id, vectors[id]))
+		})
+
+		code, err := index.compressedVectorsCache.get(context.Background(), 42)
+		require.Nil(t, err)
+		assert.Len(t, code, dimensions/8)
+
+		assertExactMatches(t, index)
+	})
+
+	t.Run("enabled on an existing index", func(t *testing.T) {
+		uc := bqTestUserConfig()
+		index := bqTestIndex(t, vectors, uc)
+
+		ssdhelpers.Concurrently(uint64(len(vectors)), func(id uint64) {
+			require.Nil(t, index.Add(id, vectors[id]))
+		})
+		require.False(t, index.compressed.Load())
+
+		uc.BQ.Enabled = true
+		done := make(chan struct{})
+		require.Nil(t, index.UpdateUserConfig(uc, func() { close(done) }))
+		<-done
+		require.True(t, index.compressed.Load())
+
+		assertExactMatches(t, index)
+
+	})
+}
+
+func TestBinaryQuantizationRestart(t *testing.T) {
+	dimensions := 60
+	vectors := bqTestVectors(200, dimensions)
+	rootPath := t.TempDir()
+	uc := bqTestUserConfig()
+	uc.BQ.Enabled = true
+
+	open := func(t *testing.T) *hnsw {
+		index, err := New(Config{
+			RootPath: rootPath,
+			ID:       "bq",
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(rootPath, "bq", logrus.New(), cyclemanager.NewNoop())
+			},
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewNoop())
+		require.Nil(t, err)
+		return index
+	}
+
+	index := open(t)
+	for id := range vectors {
+		require.Nil(t, index.Add(uint64(id), vectors[id]))
+	}
+	require.True(t, index.compressed.Load())
+	require.Nil(t, index.Shutdown(context.Background()))
+
+	t.Run("restored once the compression is complete", func(t *testing.T) {
+		index := open(t)
+		defer index.Shutdown(context.Background())
+		require.True(t, index.compressed.Load())
+
+		// the codes are padded to full bytes, decoding trims them to the
+		// original dimensions
+		id := make([]byte, 8)
+		binary.LittleEndian.PutUint64(id, 42)
+		code, err := index.compressedStore.Bucket(helpers.CompressedObjectsBucketLSM).Get(id)
+		require.Nil(t, err)
+		assert.Len(t, code, (dimensions+7)/8)
+		assert.Len(t, index.compressor.Decode(code), dimensions)
+
+		require.Nil(t, index.removeBinaryCompressionMarker())
+	})
+
+	t.Run("compressed again if the compression was interrupted", func(t *testing.T) {
+		index := open(t)
+		defer index.Shutdown(context.Background())
+		require.False(t, index.compressed.Load())
+
+		require.Nil(t, index.compressBinary())
+		require.True(t, index.compressed.Load())
+		dims, complete, err := index.readBinaryCompressionMarker()
+		require.Nil(t, err)
+		assert.True(t, complete)
+		assert.Equal(t, dimensions, dims)
+
+		ids, _, err := index.SearchByVector(vectors[42], 10, nil)
+		require.Nil(t, err)
+		require.NotEmpty(t, ids)
+		assert.Equal(t, uint64(42), ids[0])
+	})
+}
+
+func TestBinaryQuantizationConfigUpdate(t *testing.T) {
+	bq := bqTestUserConfig()
+	bq.BQ.Enabled = true
+	pq := bqTestUserConfig()
+	pq.PQ.Enabled = true
+
+	t.Run("disabling bq", func(t *testing.T) {
+		err := ValidateUserConfigUpdate(bq, bqTestUserConfig())
+		assert.EqualError(t, err, "bq compression cannot be disabled once it is enabled")
+	})
+
+	t.Run("switching from pq to bq", func(t *testing.T) {
+		err := ValidateUserConfigUpdate(pq, bq)
+		assert.EqualError(t, err, "cannot switch from pq to bq compression")
+	})
+
+	t.Run("enabling bq", func(t *testing.T) {
+		assert.Nil(t, ValidateUserConfigUpdate(bqTestUserConfig(), bq))
+	})
+}
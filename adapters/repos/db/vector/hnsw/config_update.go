@@ -55,6 +55,14 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	if initialParsed.BQ.Enabled && !updatedParsed.BQ.Enabled {
+		return errors.Errorf("bq compression cannot be disabled once it is enabled")
+	}
+
+	if initialParsed.PQ.Enabled && updatedParsed.BQ.Enabled {
+		return errors.Errorf("cannot switch from pq to bq compression")
+	}
+
 	return nil
 }
 
@@ -91,7 +99,7 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
-	if !parsed.PQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.BQ.Enabled {
 		callback()
 		return nil
 	}
@@ -122,10 +130,12 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 	h.logger.WithField("action", "compress").Info("switching to compressed vectors")
 
-	err := ent.ValidatePQConfig(cfg.PQ)
-	if err != nil {
-		callback()
-		return err
+	if !cfg.BQ.Enabled {
+		err := ent.ValidatePQConfig(cfg.PQ)
+		if err != nil {
+			callback()
+			return err
+		}
 	}
 
 	go h.compressThenCallback(cfg, callback)
@@ -136,7 +146,13 @@ func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 func (h *hnsw) compressThenCallback(cfg ent.UserConfig, callback func()) {
 	defer callback()
 
-	if err := h.Compress(cfg.PQ); err != nil {
+	var err error
+	if cfg.BQ.Enabled {
+		err = h.compressBinary()
+	} else {
+		err = h.Compress(cfg.PQ)
+	}
+	if err != nil {
 		h.logger.Error(err)
 		return
 	}
@@ -12,6 +12,9 @@
 package distancer
 
 import (
+	"encoding/binary"
+	"math/bits"
+
 	"github.com/pkg/errors"
 )
 
@@ -78,3 +81,25 @@ func (l HammingProvider) Step(x, y []float32) float32 {
 func (l HammingProvider) Wrap(x float32) float32 {
 	return x
 }
+
+// HammingBitwise calculates the hamming distance between two bit-packed
+// vectors, i.e. the number of bits in which they differ. It is used to
+// compare binary quantized vectors, where each dimension is a single bit.
+func HammingBitwise(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+
+	var sum int
+	i := 0
+	for ; i+8 <= len(x); i += 8 {
+		sum += bits.OnesCount64(binary.LittleEndian.Uint64(x[i:]) ^
+			binary.LittleEndian.Uint64(y[i:]))
+	}
+	for ; i < len(x); i++ {
+		sum += bits.OnesCount8(x[i] ^ y[i])
+	}
+
+	return float32(sum), nil
+}
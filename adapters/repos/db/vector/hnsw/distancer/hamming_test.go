@@ -99,3 +99,25 @@ func TestHammingDistancerStepbyStep(t *testing.T) {
 		assert.Equal(t, control, expectedDistance)
 	})
 }
+
+func TestHammingBitwise(t *testing.T) {
+	t.Run("identical vectors", func(t *testing.T) {
+		vec := []byte{0b1010_1010, 0xff, 0, 3, 4, 5, 6, 7, 8, 9}
+		dist, err := HammingBitwise(vec, vec)
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), dist)
+	})
+
+	t.Run("differing bits in the packed and the trailing part", func(t *testing.T) {
+		vec1 := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0b0000_0000, 0b1111_0000}
+		vec2 := []byte{0xff, 0, 0, 0, 0, 0, 0, 1, 0b0000_0011, 0b1111_0001}
+		dist, err := HammingBitwise(vec1, vec2)
+		require.Nil(t, err)
+		assert.Equal(t, float32(8+1+2+1), dist)
+	})
+
+	t.Run("different lengths", func(t *testing.T) {
+		_, err := HammingBitwise([]byte{1, 2}, []byte{1})
+		assert.NotNil(t, err)
+	})
+}
@@ -63,7 +63,7 @@ func (h *hnsw) selectNeighborsHeuristic(input *priorityqueue.Queue,
 			currVec := vecs[curr.Index]
 			good := true
 			for _, item := range returnList {
-				peerDist := h.pq.DistanceBetweenCompressedVectors(currVec, vecs[item.Index])
+				peerDist := h.compressor.DistanceBetweenCompressedVectors(currVec, vecs[item.Index])
 
 				if peerDist < distToQuery {
 					good = false
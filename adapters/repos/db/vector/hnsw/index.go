@@ -148,8 +148,9 @@ type hnsw struct {
 
 	compressed             atomic.Bool
 	doNotRescore           bool
-	pq                     *ssdhelpers.ProductQuantizer
+	compressor             ssdhelpers.Quantizer
 	pqConfig               ent.PQConfig
+	bqConfig               ent.BQConfig
 	compressedVectorsCache cache[byte]
 	compressedStore        *lsmkv.Store
 	compressActionLock     *sync.RWMutex
@@ -219,7 +220,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
 
 	var compressedVectorsCache *compressedShardedLockCache
-	if uc.PQ.Enabled {
+	if uc.PQ.Enabled || uc.BQ.Enabled {
 		compressedVectorsCache = newCompressedShardedLockCache(uc.VectorCacheMaxObjects, cfg.Logger)
 	}
 
@@ -266,6 +267,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCleanupCycle cyclemanager.Cycle
 		VectorForIDThunk:     cfg.VectorForIDThunk,
 		TempVectorForIDThunk: cfg.TempVectorForIDThunk,
 		pqConfig:             uc.PQ,
+		bqConfig:             uc.BQ,
 	}
 
 	// TODO common_cycle_manager move to poststartup?
@@ -458,7 +460,7 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", b)
 		}
 
-		return h.pq.DistanceBetweenCompressedVectors(v1, v2), true, nil
+		return h.compressor.DistanceBetweenCompressedVectors(v1, v2), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -517,7 +519,7 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", node)
 		}
 
-		return h.pq.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
+		return h.compressor.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -643,6 +645,12 @@ func (h *hnsw) Shutdown(ctx context.Context) error {
 		}
 	} else {
 		h.cache.drop()
+		// the store is already open if binary compression is still pending
+		if h.compressedStore != nil {
+			if err := h.compressedStore.Shutdown(ctx); err != nil {
+				return errors.Wrap(err, "hnsw shutdown")
+			}
+		}
 	}
 
 	return nil
@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 )
 
 func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
@@ -90,9 +91,16 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 
 	h.nodes[node.id] = node
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.compressor.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
+
+		// an empty index was compressed before its dimensions were known
+		if bq, ok := h.compressor.(*ssdhelpers.BinaryQuantizer); ok {
+			if err := h.writeBinaryCompressionMarker(bq.Dimensions()); err != nil {
+				return err
+			}
+		}
 	} else {
 		h.cache.preload(node.id, nodeVec)
 	}
@@ -168,7 +176,7 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	// // make sure this new vec is immediately present in the cache, so we don't
 	// // have to read it from disk again
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.compressor.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
 	} else {
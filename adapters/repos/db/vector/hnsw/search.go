@@ -165,17 +165,17 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.QuantizerDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewQuantizerDistancer(queryVector)
+		defer h.compressor.ReturnQuantizerDistancer(byteDistancer)
 	}
 	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, byteDistancer)
 }
 
 func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
-	allowList helpers.AllowList, byteDistancer *ssdhelpers.PQDistancer) (*priorityqueue.Queue, error,
+	allowList helpers.AllowList, byteDistancer ssdhelpers.QuantizerDistancer) (*priorityqueue.Queue, error,
 ) {
 	h.pools.visitedListsLock.Lock()
 	visited := h.pools.visitedLists.Borrow()
@@ -185,8 +185,8 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewQuantizerDistancer(queryVector)
+		defer h.compressor.ReturnQuantizerDistancer(byteDistancer)
 	} else {
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
@@ -376,7 +376,7 @@ func (h *hnsw) currentWorstResultDistanceToFloat(results *priorityqueue.Queue,
 }
 
 func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
-	distancer *ssdhelpers.PQDistancer,
+	distancer ssdhelpers.QuantizerDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		item := results.Top()
@@ -403,7 +403,7 @@ func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
+func (h *hnsw) distanceToByteNode(distancer ssdhelpers.QuantizerDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
 	vec, err := h.compressedVectorsCache.get(context.Background(), nodeID)
@@ -420,7 +420,7 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 	return distancer.Distance(vec)
 }
 
-func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDistancer, nodeID uint64) (float32, bool, error) {
+func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer ssdhelpers.QuantizerDistancer, nodeID uint64) (float32, bool, error) {
 	slice := h.pools.tempVectors.Get(int(h.dims))
 	defer h.pools.tempVectors.Put(slice)
 	vec, err := h.TempVectorForIDThunk(context.Background(), nodeID, slice)
@@ -498,10 +498,10 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 			"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
 	}
 
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.QuantizerDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(searchVec)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.compressor.NewQuantizerDistancer(searchVec)
+		defer h.compressor.ReturnQuantizerDistancer(byteDistancer)
 	}
 	// stop at layer 1, not 0!
 	for level := h.currentMaximumLayer; level >= 1; level-- {
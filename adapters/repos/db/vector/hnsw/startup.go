@@ -17,6 +17,7 @@ import (
 	"encoding/binary"
 	"io"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -25,6 +26,7 @@ import (
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func (h *hnsw) init(cfg Config) error {
@@ -34,6 +36,12 @@ func (h *hnsw) init(cfg Config) error {
 		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
 	}
 
+	if h.bqConfig.Enabled {
+		if err := h.restoreBinaryCompression(); err != nil {
+			return errors.Wrapf(err, "restore binary compression of hnsw index %q", cfg.ID)
+		}
+	}
+
 	// init commit logger for future writes
 	cl, err := cfg.MakeCommitLoggerThunk()
 	if err != nil {
@@ -129,7 +137,7 @@ func (h *hnsw) restoreFromDisk() error {
 		}
 		h.cache.drop()
 
-		h.pq, err = ssdhelpers.NewProductQuantizerWithEncoders(
+		pq, err := ssdhelpers.NewProductQuantizerWithEncoders(
 			h.pqConfig,
 			h.distancerProvider,
 			int(state.PQData.Dimensions),
@@ -138,6 +146,7 @@ func (h *hnsw) restoreFromDisk() error {
 		if err != nil {
 			return errors.Wrap(err, "Restoring PQ data.")
 		}
+		h.compressor = pq
 	} else {
 		// make sure the cache fits the current size
 		h.cache.grow(uint64(len(h.nodes)))
@@ -166,9 +175,56 @@ func (h *hnsw) tombstoneCleanup(shouldBreak cyclemanager.ShouldBreakFunc) bool {
 // vector cache, however, depend on the shard being ready as they will call
 // getVectorForID.
 func (h *hnsw) PostStartup() {
+	if h.bqConfig.Enabled && !h.compressed.Load() {
+		// binary compression was turned on, but the vectors were never encoded,
+		// e.g. because the last shutdown happened while compressing
+		go h.compressThenCallback(ent.UserConfig{BQ: h.bqConfig}, func() {})
+		return
+	}
+
 	h.prefillCache()
 }
 
+// restoreBinaryCompression turns on binary compression at startup. Contrary
+// to PQ, there is nothing that needs to be persisted in the commit log, the
+// encoded vectors and the completion marker are the only state. An empty
+// index is compressed right away. If the index is not empty, but the marker is
+// missing, e.g. because the last shutdown happened while compressing, the
+// compression is left to PostStartup, as the uncompressed vectors cannot be
+// read before the shard is ready.
+func (h *hnsw) restoreBinaryCompression() error {
+	if h.compressed.Load() {
+		return errors.New("index is already compressed using product quantization")
+	}
+
+	dims, complete, err := h.readBinaryCompressionMarker()
+	if err != nil {
+		return err
+	}
+
+	if !complete {
+		if !h.isEmpty() {
+			return nil
+		}
+		return h.compressBinary()
+	}
+
+	if h.compressedStore == nil {
+		if err := h.initCompressedStore(); err != nil {
+			return err
+		}
+	}
+
+	if dims > 0 {
+		// rescoring reads the uncompressed vectors into buffers of this size
+		atomic.StoreInt32(&h.dims, int32(dims))
+	}
+	h.compressor = ssdhelpers.NewBinaryQuantizer(h.distancerProvider, dims)
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
 func (h *hnsw) prefillCache() {
 	limit := 0
 	if h.compressed.Load() {
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// BinaryQuantizer compresses a vector to a single bit per dimension, the bit
+// is set if the dimension is positive. Compressed vectors are compared using
+// their hamming distance. As there is no training involved, vectors can be
+// encoded as soon as the quantizer is created.
+type BinaryQuantizer struct {
+	distance distancer.Provider
+	// dims is the length of the original vectors, codes are padded to full
+	// bytes, so it is needed to trim decoded vectors. If it is not known when
+	// the quantizer is created, it is taken from the first encoded vector.
+	dims atomic.Int32
+}
+
+func NewBinaryQuantizer(distance distancer.Provider, dims int) *BinaryQuantizer {
+	bq := &BinaryQuantizer{
+		distance: distance,
+	}
+	bq.dims.Store(int32(dims))
+	return bq
+}
+
+// Dimensions returns the length of the original vectors, or 0 if it is not
+// known yet
+func (bq *BinaryQuantizer) Dimensions() int {
+	return int(bq.dims.Load())
+}
+
+func (bq *BinaryQuantizer) Encode(vec []float32) []byte {
+	bq.dims.CompareAndSwap(0, int32(len(vec)))
+	code := make([]byte, (len(vec)+7)/8)
+	for i, v := range vec {
+		if v > 0 {
+			code[i/8] |= 1 << (i % 8)
+		}
+	}
+	return code
+}
+
+// Decode returns a vector of -1 and 1 values. Encoding it again results in
+// the original code, but the magnitudes of the original vector are lost.
+func (bq *BinaryQuantizer) Decode(code []byte) []float32 {
+	size := len(code) * 8
+	if dims := int(bq.dims.Load()); dims > 0 && dims < size {
+		size = dims
+	}
+	vec := make([]float32, size)
+	for i := range vec {
+		if code[i/8]&(1<<(i%8)) != 0 {
+			vec[i] = 1
+		} else {
+			vec[i] = -1
+		}
+	}
+	return vec
+}
+
+func (bq *BinaryQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
+	dist, _ := distancer.HammingBitwise(x, y)
+	return dist
+}
+
+func (bq *BinaryQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+	return bq.DistanceBetweenCompressedVectors(bq.Encode(x), encoded)
+}
+
+type BQDistancer struct {
+	x  []float32
+	bq *BinaryQuantizer
+	// code is the query vector in its compressed form, it is only encoded
+	// once per distancer
+	code []byte
+}
+
+func (bq *BinaryQuantizer) NewDistancer(a []float32) *BQDistancer {
+	return &BQDistancer{
+		x:    a,
+		bq:   bq,
+		code: bq.Encode(a),
+	}
+}
+
+func (bq *BinaryQuantizer) ReturnDistancer(d *BQDistancer) {}
+
+func (bq *BinaryQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return bq.NewDistancer(a)
+}
+
+func (bq *BinaryQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {}
+
+func (d *BQDistancer) Distance(x []byte) (float32, bool, error) {
+	dist, err := distancer.HammingBitwise(d.code, x)
+	if err != nil {
+		return 0, false, err
+	}
+	return dist, true, nil
+}
+
+func (d *BQDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.bq.distance.SingleDist(d.x, x)
+}
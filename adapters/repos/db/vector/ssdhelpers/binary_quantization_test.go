@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestBinaryQuantizer(t *testing.T) {
+	vec1 := []float32{0.1, -0.2, 0.3, -0.4, 0.5, -0.6, 0.7, -0.8, 0.9, 0}
+	vec2 := []float32{-0.1, -0.2, 0.3, -0.4, 0.5, -0.6, 0.7, -0.8, 0.9, 1}
+
+	bq := ssdhelpers.NewBinaryQuantizer(distancer.NewL2SquaredProvider(), len(vec1))
+
+	t.Run("encoding", func(t *testing.T) {
+		code := bq.Encode(vec1)
+		assert.Equal(t, []byte{0b0101_0101, 0b0000_0001}, code)
+	})
+
+	t.Run("decoding keeps the signs", func(t *testing.T) {
+		decoded := bq.Decode(bq.Encode(vec1))
+		assert.Equal(t, []float32{1, -1, 1, -1, 1, -1, 1, -1, 1, -1}, decoded)
+		assert.Equal(t, bq.Encode(vec1), bq.Encode(decoded))
+	})
+
+	t.Run("dimensions are taken from the first encoded vector", func(t *testing.T) {
+		bq := ssdhelpers.NewBinaryQuantizer(distancer.NewL2SquaredProvider(), 0)
+		assert.Equal(t, 0, bq.Dimensions())
+
+		code := bq.Encode(vec1)
+		assert.Equal(t, len(vec1), bq.Dimensions())
+		assert.Len(t, bq.Decode(code), len(vec1))
+	})
+
+	t.Run("distance between compressed vectors", func(t *testing.T) {
+		dist := bq.DistanceBetweenCompressedVectors(bq.Encode(vec1), bq.Encode(vec2))
+		assert.Equal(t, float32(2), dist)
+
+		dist = bq.DistanceBetweenCompressedAndUncompressedVectors(vec1, bq.Encode(vec2))
+		assert.Equal(t, float32(2), dist)
+	})
+
+	t.Run("distancer", func(t *testing.T) {
+		d := bq.NewQuantizerDistancer(vec1)
+		defer bq.ReturnQuantizerDistancer(d)
+
+		dist, ok, err := d.Distance(bq.Encode(vec2))
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, float32(2), dist)
+
+		// rescoring uses the exact distance of the uncompressed vectors
+		dist, ok, err = d.DistanceToFloat(vec2)
+		require.Nil(t, err)
+		assert.True(t, ok)
+		expected, _, _ := distancer.NewL2SquaredProvider().SingleDist(vec1, vec2)
+		assert.Equal(t, expected, dist)
+	})
+}
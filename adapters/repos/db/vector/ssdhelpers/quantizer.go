@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+// Quantizer is implemented by all supported vector compressions, so that an
+// index can work on the compressed vectors without knowing which compression
+// is in use.
+type Quantizer interface {
+	Encode(vec []float32) []byte
+	Decode(code []byte) []float32
+	DistanceBetweenCompressedVectors(x, y []byte) float32
+	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32
+	NewQuantizerDistancer(a []float32) QuantizerDistancer
+	ReturnQuantizerDistancer(d QuantizerDistancer)
+}
+
+// QuantizerDistancer calculates distances to a fixed (uncompressed) query
+// vector. Distance works on compressed vectors, DistanceToFloat on
+// uncompressed ones and is used to rescore candidates.
+type QuantizerDistancer interface {
+	Distance(x []byte) (float32, bool, error)
+	DistanceToFloat(x []float32) (float32, bool, error)
+}
+
+func (pq *ProductQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return pq.NewDistancer(a)
+}
+
+func (pq *ProductQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {
+	if concrete, ok := d.(*PQDistancer); ok {
+		pq.ReturnDistancer(concrete)
+	}
+}
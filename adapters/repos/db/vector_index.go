@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -36,3 +37,19 @@ type VectorIndex interface {
 	PostStartup()
 	ValidateBeforeInsert(vector []float32) error
 }
+
+// convertToVectorIndexConfigs extracts the parsed vector index configs of the
+// named vectors of a class
+func convertToVectorIndexConfigs(configs map[string]models.VectorConfig) map[string]schema.VectorIndexConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	out := make(map[string]schema.VectorIndexConfig, len(configs))
+	for targetVector, cfg := range configs {
+		if vic, ok := cfg.VectorIndexConfig.(schema.VectorIndexConfig); ok {
+			out[targetVector] = vic
+		}
+	}
+	return out
+}
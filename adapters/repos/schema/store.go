@@ -299,6 +299,19 @@ func (r *store) NewShards(_ context.Context, class string, shards []ucs.KeyValue
 	return r.db.Update(f)
 }
 
+// UpdateShards updates (replaces) shards of an existing class
+func (r *store) UpdateShards(_ context.Context, class string, shards []ucs.KeyValuePair) error {
+	classKey := encodeClassName(class)
+	f := func(tx *bolt.Tx) error {
+		b := tx.Bucket(schemaBucket).Bucket(classKey)
+		if b == nil {
+			return fmt.Errorf("class not found")
+		}
+		return appendShards(b, shards, make([]byte, 1, 68))
+	}
+	return r.db.Update(f)
+}
+
 // DeleteShards of a specific class
 //
 //	If the class or a shard does not exist then nothing is done and a nil error is returned
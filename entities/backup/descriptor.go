@@ -28,11 +28,13 @@ type DistributedBackupDescriptor struct {
 	StartedAt     time.Time                  `json:"startedAt"`
 	CompletedAt   time.Time                  `json:"completedAt"`
 	ID            string                     `json:"id"` // User created backup id
+	BaseBackupID  string                     `json:"baseBackupId,omitempty"`
 	Nodes         map[string]*NodeDescriptor `json:"nodes"`
 	Status        Status                     `json:"status"`  //
 	Version       string                     `json:"version"` //
 	ServerVersion string                     `json:"serverVersion"`
 	Error         string                     `json:"error"`
+	Coordinator   string                     `json:"coordinator,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -186,6 +188,34 @@ type ShardDescriptor struct {
 	PropLengthTracker     []byte `json:"propLengthTracker"`
 	ShardVersionPath      string `json:"shardVersionPath"`
 	Version               []byte `json:"version"`
+
+	// Compression is the algorithm used to compress Chunks.
+	// It is empty for backups whose files were uploaded one by one.
+	Compression string            `json:"compression,omitempty"`
+	Chunks      []ChunkDescriptor `json:"chunks,omitempty"`
+	// FileInfos is used by incremental backups to detect files which haven't changed
+	FileInfos map[string]FileInfo `json:"fileInfos,omitempty"`
+}
+
+// ChunkDescriptor describes a compressed archive containing a subset of a shard's files
+type ChunkDescriptor struct {
+	Key      string   `json:"key"`
+	Files    []string `json:"files"`
+	Size     int64    `json:"size"`     // size of the compressed chunk in bytes
+	Checksum string   `json:"checksum"` // hex encoded sha256 of the compressed chunk
+
+	// BackupID is set if the chunk is stored in another (base) backup.
+	// Only Files are extracted from such a chunk.
+	BackupID string `json:"backupId,omitempty"`
+	// Compression overrides the compression of the shard.
+	// It is set if the chunk is stored in another backup.
+	Compression string `json:"compression,omitempty"`
+}
+
+// FileInfo identifies the version of a file
+type FileInfo struct {
+	Size    int64 `json:"size"`
+	ModTime int64 `json:"modTime"` // unix nanoseconds
 }
 
 // ClassDescriptor contains everything needed to completely restore a class
@@ -202,6 +232,7 @@ type BackupDescriptor struct {
 	StartedAt     time.Time         `json:"startedAt"`
 	CompletedAt   time.Time         `json:"completedAt"`
 	ID            string            `json:"id"` // User created backup id
+	BaseBackupID  string            `json:"baseBackupId,omitempty"`
 	Classes       []ClassDescriptor `json:"classes"`
 	Status        string            `json:"status"`  // "STARTED|TRANSFERRING|TRANSFERRED|SUCCESS|FAILED"
 	Version       string            `json:"version"` //
@@ -306,6 +337,14 @@ func (d *BackupDescriptor) Validate() error {
 					return fmt.Errorf("invalid shard %q.%q: file number %d", c.Name, s.Name, i)
 				}
 			}
+			for i, chunk := range s.Chunks {
+				if chunk.Key == "" || chunk.Checksum == "" || len(chunk.Files) == 0 {
+					return fmt.Errorf("invalid shard %q.%q: chunk number %d", c.Name, s.Name, i)
+				}
+				if chunk.Compression == "" && s.Compression == "" {
+					return fmt.Errorf("invalid shard %q.%q: missing compression", c.Name, s.Name)
+				}
+			}
 		}
 	}
 	return nil
@@ -323,6 +362,7 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		StartedAt:     d.StartedAt,
 		CompletedAt:   d.CompletedAt,
 		ID:            d.ID,
+		BaseBackupID:  d.BaseBackupID,
 		Status:        Status(d.Status),
 		Version:       d.Version,
 		ServerVersion: d.ServerVersion,
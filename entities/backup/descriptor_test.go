@@ -178,6 +178,42 @@ func TestValidateBackup(t *testing.T) {
 				}},
 			}},
 		}, success: true},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					Chunks: []ChunkDescriptor{{Key: "k", Checksum: "c", Files: []string{"file"}}},
+				}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					Compression: "gzip", Chunks: []ChunkDescriptor{{Key: "k", Files: []string{"file"}}},
+				}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					Compression: "gzip", Chunks: []ChunkDescriptor{{Key: "k", Checksum: "c", Files: []string{"file"}}},
+				}},
+			}},
+		}, success: true},
 	}
 	for i, tc := range tests {
 		err := tc.desc.Validate()
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package cdc contains the types of the change data capture stream, which
+// emits an event for every mutation of an object.
+package cdc
+
+import (
+	"errors"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type EventType string
+
+const (
+	EventTypeCreate          EventType = "CREATE"
+	EventTypeUpdate          EventType = "UPDATE"
+	EventTypeDelete          EventType = "DELETE"
+	EventTypeReferenceChange EventType = "REFERENCE_CHANGE"
+)
+
+var (
+	// ErrSequenceTrimmed is returned when a stream is resumed from a sequence
+	// number whose events have already been removed by the retention policy
+	ErrSequenceTrimmed = errors.New("events after the requested sequence have been trimmed")
+	// ErrDisabled is returned when a change stream is requested, but the change
+	// stream is not enabled in the configuration
+	ErrDisabled = errors.New("the change stream is not enabled")
+)
+
+// Event describes a single mutation of an object. Sequence numbers increase
+// monotonically per class, they are not guaranteed to be contiguous.
+type Event struct {
+	Sequence uint64      `json:"sequence"`
+	Type     EventType   `json:"type"`
+	Class    string      `json:"class"`
+	Tenant   string      `json:"tenant,omitempty"`
+	ID       strfmt.UUID `json:"id"`
+	// Time is the unix time in milliseconds at which the event was recorded
+	Time int64 `json:"time"`
+	// Object is the state of the object after the mutation, it is nil for
+	// deletes
+	Object *models.Object `json:"object,omitempty"`
+}
@@ -64,3 +64,16 @@ func HnswCommitLoggerCycleTicker() CycleTicker {
 	return NewExpTicker(hnswCommitLoggerMinInterval, hnswCommitLoggerMaxInterval,
 		hnswCommitLoggerBase, hnswCommitLoggerSteps)
 }
+
+const (
+	objectTTLMinInterval = 10 * time.Second
+	objectTTLMaxInterval = 5 * time.Minute
+	objectTTLBase        = uint(2)
+	objectTTLSteps       = uint(4)
+)
+
+// 10s . 29.3s .. 68s .... 2m25s ........ 5m
+func ObjectTTLCycleTicker() CycleTicker {
+	return NewExpTicker(objectTTLMinInterval, objectTTLMaxInterval,
+		objectTTLBase, objectTTLSteps)
+}
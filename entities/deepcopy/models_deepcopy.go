@@ -50,9 +50,21 @@ func Class(c *models.Class) *models.Class {
 		Vectorizer:          c.Vectorizer,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
+		VectorConfig:        vectorConfig(c.VectorConfig),
 	}
 }
 
+func vectorConfig(in map[string]models.VectorConfig) map[string]models.VectorConfig {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]models.VectorConfig, len(in))
+	for name, cfg := range in {
+		out[name] = cfg
+	}
+	return out
+}
+
 func Prop(p *models.Property) *models.Property {
 	return &models.Property{
 		DataType:        p.DataType,
@@ -62,6 +74,7 @@ func Prop(p *models.Property) *models.Property {
 		Tokenization:    p.Tokenization,
 		IndexFilterable: ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable: ptrBoolCopy(p.IndexSearchable),
+		IndexPositions:  ptrBoolCopy(p.IndexPositions),
 	}
 }
 
@@ -88,12 +101,36 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
 	}
 
+	var analyzers map[string]models.AnalyzerConfig = nil
+	if i.Analyzers != nil {
+		analyzers = make(map[string]models.AnalyzerConfig, len(i.Analyzers))
+		for name, analyzer := range i.Analyzers {
+			analyzers[name] = analyzer
+		}
+	}
+
+	var synonyms []*models.SynonymSet = nil
+	if i.Synonyms != nil {
+		synonyms = make([]*models.SynonymSet, len(i.Synonyms))
+		for j, set := range i.Synonyms {
+			if set != nil {
+				synonyms[j] = &models.SynonymSet{Terms: append([]string(nil), set.Terms...)}
+				if set.Weight != nil {
+					weight := *set.Weight
+					synonyms[j].Weight = &weight
+				}
+			}
+		}
+	}
+
 	return &models.InvertedIndexConfig{
+		Analyzers:              analyzers,
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
 	}
 }
@@ -18,6 +18,9 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
+// MaxFuzzyDistance is the largest edit distance supported by the Fuzzy operator
+const MaxFuzzyDistance = 2
+
 type Operator int
 
 const (
@@ -33,6 +36,9 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorContainsPhrase
+	OperatorWithinWords
+	OperatorFuzzy
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +51,10 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorContainsPhrase,
+		OperatorWithinWords,
+		OperatorFuzzy:
 		return true
 	default:
 		return false
@@ -78,6 +87,12 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorContainsPhrase:
+		return "ContainsPhrase"
+	case OperatorWithinWords:
+		return "WithinWords"
+	case OperatorFuzzy:
+		return "Fuzzy"
 	default:
 		panic("Unknown operator")
 	}
@@ -110,6 +125,13 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = int(asFloat)
 	}
 
+	asMap, ok := v.Value.(map[string]interface{})
+	if v.Type == schema.DataTypeText && ok {
+		text, _ := asMap["text"].(string)
+		distance, _ := asMap["distance"].(float64)
+		v.Value = TextProximity{Text: text, Distance: int(distance)}
+	}
+
 	return nil
 }
 
@@ -120,6 +142,14 @@ type Clause struct {
 	Operands []Clause `json:"operands"`
 }
 
+// TextProximity to be used with fields of type text and the WithinWords or
+// Fuzzy operators. For WithinWords it identifies words and the maximum
+// distance between them, for Fuzzy a term and its maximum edit distance.
+type TextProximity struct {
+	Text     string `json:"text"`
+	Distance int    `json:"distance"`
+}
+
 // GeoRange to be used with fields of type GeoCoordinates. Identifies a point
 // and a maximum distance from that point.
 type GeoRange struct {
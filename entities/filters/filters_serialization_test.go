@@ -52,4 +52,20 @@ func TestSerializeValue(t *testing.T) {
 
 		assert.Equal(t, before, after)
 	})
+
+	t.Run("with a text proximity value", func(t *testing.T) {
+		before := Value{
+			Value: TextProximity{Text: "machine learning", Distance: 3},
+			Type:  schema.DataTypeText,
+		}
+
+		bytes, err := json.Marshal(before)
+		require.Nil(t, err)
+
+		var after Value
+		err = json.Unmarshal(bytes, &after)
+		require.Nil(t, err)
+
+		assert.Equal(t, before, after)
+	})
 }
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -109,6 +110,14 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if op := cw.getOperator(); op == OperatorContainsPhrase || op == OperatorWithinWords {
+		return validatePhraseClause(prop, cw)
+	}
+
+	if cw.getOperator() == OperatorFuzzy {
+		return validateFuzzyClause(prop, cw)
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
@@ -186,6 +195,58 @@ func validateInternalPropertyClause(propName schema.PropertyName, cw *clauseWrap
 	}
 }
 
+func validatePhraseClause(prop *models.Property, cw *clauseWrapper) error {
+	op := cw.getOperator()
+	switch dt := schema.DataType(prop.DataType[0]); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return errors.Errorf("operator %s can only be used on properties of type text "+
+			"or text[], but property %q is of type %q", op.Name(), prop.Name, dt)
+	}
+
+	if op == OperatorContainsPhrase {
+		if _, ok := cw.getValue().(string); !ok || !cw.isType(schema.DataTypeText) {
+			return errors.Errorf("operator ContainsPhrase requires a valueText, got %q instead",
+				cw.getValueNameFromType())
+		}
+		return nil
+	}
+
+	proximity, ok := cw.getValue().(TextProximity)
+	if !ok {
+		return errors.Errorf("operator WithinWords requires a valueTextProximity")
+	}
+	if proximity.Distance < 1 {
+		return errors.Errorf("operator WithinWords requires a distance of at least 1, got %d",
+			proximity.Distance)
+	}
+	return nil
+}
+
+func validateFuzzyClause(prop *models.Property, cw *clauseWrapper) error {
+	switch dt := schema.DataType(prop.DataType[0]); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return errors.Errorf("operator Fuzzy can only be used on properties of type text "+
+			"or text[], but property %q is of type %q", prop.Name, dt)
+	}
+
+	if !cw.isType(schema.DataTypeText) {
+		return errors.Errorf("operator Fuzzy requires a valueText or valueTextProximity, got %q instead",
+			cw.getValueNameFromType())
+	}
+
+	// the edit distance is derived from the length of a valueText, a
+	// valueTextProximity sets it explicitly
+	if fuzzy, ok := cw.getValue().(TextProximity); ok {
+		if fuzzy.Distance < 1 || fuzzy.Distance > MaxFuzzyDistance {
+			return errors.Errorf("operator Fuzzy requires a distance between 1 and %d, got %d",
+				MaxFuzzyDistance, fuzzy.Distance)
+		}
+	}
+	return nil
+}
+
 func isUUIDType(dtString string) bool {
 	dt := schema.DataType(dtString)
 	return dt == schema.DataTypeUUID || dt == schema.DataTypeUUIDArray
@@ -213,6 +213,137 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateTextOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		prop     schema.PropertyName
+		operator Operator
+		value    Value
+		valid    bool
+	}{
+		{
+			name:     "contains phrase on text",
+			prop:     "description",
+			operator: OperatorContainsPhrase,
+			value:    Value{Value: "machine learning", Type: schema.DataTypeText},
+			valid:    true,
+		},
+		{
+			name:     "contains phrase on text[]",
+			prop:     "tags",
+			operator: OperatorContainsPhrase,
+			value:    Value{Value: "machine learning", Type: schema.DataTypeText},
+			valid:    true,
+		},
+		{
+			name:     "contains phrase on int",
+			prop:     "count",
+			operator: OperatorContainsPhrase,
+			value:    Value{Value: "machine learning", Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "contains phrase with proximity value",
+			prop:     "description",
+			operator: OperatorContainsPhrase,
+			value:    Value{Value: TextProximity{Text: "machine learning", Distance: 3}, Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "within words on text",
+			prop:     "description",
+			operator: OperatorWithinWords,
+			value:    Value{Value: TextProximity{Text: "machine learning", Distance: 3}, Type: schema.DataTypeText},
+			valid:    true,
+		},
+		{
+			name:     "within words with text value",
+			prop:     "description",
+			operator: OperatorWithinWords,
+			value:    Value{Value: "machine learning", Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "within words with zero distance",
+			prop:     "description",
+			operator: OperatorWithinWords,
+			value:    Value{Value: TextProximity{Text: "machine learning"}, Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "fuzzy on text",
+			prop:     "description",
+			operator: OperatorFuzzy,
+			value:    Value{Value: "iphnoe", Type: schema.DataTypeText},
+			valid:    true,
+		},
+		{
+			name:     "fuzzy on text[]",
+			prop:     "tags",
+			operator: OperatorFuzzy,
+			value:    Value{Value: "iphnoe", Type: schema.DataTypeText},
+			valid:    true,
+		},
+		{
+			name:     "fuzzy on int",
+			prop:     "count",
+			operator: OperatorFuzzy,
+			value:    Value{Value: 3, Type: schema.DataTypeInt},
+			valid:    false,
+		},
+		{
+			name:     "fuzzy with explicit distance",
+			prop:     "description",
+			operator: OperatorFuzzy,
+			value:    Value{Value: TextProximity{Text: "iphnoe", Distance: 2}, Type: schema.DataTypeText},
+			valid:    true,
+		},
+		{
+			name:     "fuzzy with distance above maximum",
+			prop:     "description",
+			operator: OperatorFuzzy,
+			value:    Value{Value: TextProximity{Text: "iphnoe", Distance: MaxFuzzyDistance + 1}, Type: schema.DataTypeText},
+			valid:    false,
+		},
+		{
+			name:     "fuzzy with int value on text",
+			prop:     "description",
+			operator: OperatorFuzzy,
+			value:    Value{Value: 3, Type: schema.DataTypeInt},
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Document",
+						Properties: []*models.Property{
+							{Name: "description", DataType: schema.DataTypeText.PropString()},
+							{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+							{Name: "count", DataType: schema.DataTypeInt.PropString()},
+						},
+					},
+				},
+			}}
+			value := tt.value
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &value,
+				On:       &Path{Class: "Document", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
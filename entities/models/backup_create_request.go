@@ -28,7 +28,7 @@ import (
 // swagger:model BackupCreateRequest
 type BackupCreateRequest struct {
 
-	// Custom configuration for the backup creation process
+	// Custom configuration for the backup creation process. Supported keys are `compression` (gzip or zstd), `compressionLevel`, `chunkSize` (uncompressed size of an archive chunk in MB) and `incrementalBaseBackupId` (only upload files which are not part of this backup)
 	Config interface{} `json:"config,omitempty"`
 
 	// List of classes to exclude from the backup creation process
@@ -28,7 +28,7 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
-	// Custom configuration for the backup restoration process
+	// Custom configuration for the backup restoration process. Supported keys are `classMapping` (restore classes under new names, e.g. {"Article": "ArticleStaging"}) and `nodeMapping` (restore the data of a node in the backup onto a node with a different name, e.g. {"node1": "staging-node1"})
 	Config interface{} `json:"config,omitempty"`
 
 	// List of classes to exclude from the backup restoration process
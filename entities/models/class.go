@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Class class
@@ -45,6 +46,9 @@ type Class struct {
 	// multi tenancy config
 	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
 
+	// object Ttl config
+	ObjectTTLConfig *ObjectTTLConfig `json:"objectTtlConfig,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
@@ -54,6 +58,9 @@ type Class struct {
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Configure named vectors. Either use this field or `vectorizer`, `vectorIndexType`, and `vectorIndexConfig` fields.
+	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
@@ -76,6 +83,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectTTLConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
@@ -84,6 +95,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectorConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -128,6 +143,25 @@ func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateObjectTTLConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectTTLConfig) { // not required
+		return nil
+	}
+
+	if m.ObjectTTLConfig != nil {
+		if err := m.ObjectTTLConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTtlConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTtlConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.Properties) { // not required
 		return nil
@@ -173,6 +207,32 @@ func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorConfig) { // not required
+		return nil
+	}
+
+	for k := range m.VectorConfig {
+
+		if err := validate.Required("vectorConfig"+"."+k, "body", m.VectorConfig[k]); err != nil {
+			return err
+		}
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorConfig" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorConfig" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this class based on the context it is used
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -185,6 +245,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectTTLConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProperties(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -193,6 +257,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVectorConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -231,6 +299,22 @@ func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats s
 	return nil
 }
 
+func (m *Class) contextValidateObjectTTLConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectTTLConfig != nil {
+		if err := m.ObjectTTLConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTtlConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTtlConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Properties); i++ {
@@ -267,6 +351,21 @@ func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Class) contextValidateVectorConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.VectorConfig {
+
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
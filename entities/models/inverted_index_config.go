@@ -18,10 +18,12 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // InvertedIndexConfig Configure the inverted index built into Weaviate
@@ -29,6 +31,9 @@ import (
 // swagger:model InvertedIndexConfig
 type InvertedIndexConfig struct {
 
+	// Text analysis chains by property name, applied at index and at query time
+	Analyzers map[string]AnalyzerConfig `json:"analyzers,omitempty"`
+
 	// bm25
 	Bm25 *BM25Config `json:"bm25,omitempty"`
 
@@ -46,12 +51,19 @@ type InvertedIndexConfig struct {
 
 	// stopwords
 	Stopwords *StopwordConfig `json:"stopwords,omitempty"`
+
+	// Query-time synonym sets for keyword search, can be changed without reindexing
+	Synonyms []*SynonymSet `json:"synonyms,omitempty"`
 }
 
 // Validate validates this inverted index config
 func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAnalyzers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateBm25(formats); err != nil {
 		res = append(res, err)
 	}
@@ -60,12 +72,42 @@ func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSynonyms(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateAnalyzers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Analyzers) { // not required
+		return nil
+	}
+
+	for k := range m.Analyzers {
+
+		if err := validate.Required("analyzers"+"."+k, "body", m.Analyzers[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Analyzers[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("analyzers" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("analyzers" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InvertedIndexConfig) validateBm25(formats strfmt.Registry) error {
 	if swag.IsZero(m.Bm25) { // not required
 		return nil
@@ -104,10 +146,40 @@ func (m *InvertedIndexConfig) validateStopwords(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateSynonyms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Synonyms) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Synonyms); i++ {
+		if swag.IsZero(m.Synonyms[i]) { // not required
+			continue
+		}
+
+		if m.Synonyms[i] != nil {
+			if err := m.Synonyms[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this inverted index config based on the context it is used
 func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAnalyzers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateBm25(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -116,12 +188,31 @@ func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSynonyms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateAnalyzers(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.Analyzers {
+
+		if val, ok := m.Analyzers[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InvertedIndexConfig) contextValidateBm25(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Bm25 != nil {
@@ -154,6 +245,26 @@ func (m *InvertedIndexConfig) contextValidateStopwords(ctx context.Context, form
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateSynonyms(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Synonyms); i++ {
+
+		if m.Synonyms[i] != nil {
+			if err := m.Synonyms[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InvertedIndexConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
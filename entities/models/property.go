@@ -43,6 +43,9 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Should the positions of the tokens of this property be stored in an additional inverted index. Defaults to false. Applicable only to properties of data type text and text[]. Required for phrase and proximity queries, i.e. the `ContainsPhrase` and `WithinWords` operators of where filters and quoted phrases in bm25 queries
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
@@ -52,8 +55,8 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
-	// Enum: [word lowercase whitespace field]
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims), `ngram` (splits on any non-alphanumerical, lowercases, indexes character n-grams of each word, trigrams unless configured otherwise in the analyzer), `cjk` (splits Chinese, Japanese and Korean text into overlapping bigrams, tokenizes remaining text like `word`). Not supported for remaining data types
+	// Enum: [word lowercase whitespace field ngram cjk]
 	Tokenization string `json:"tokenization,omitempty"`
 }
 
@@ -75,7 +78,7 @@ var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","ngram","cjk"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -96,6 +99,12 @@ const (
 
 	// PropertyTokenizationField captures enum value "field"
 	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationNgram captures enum value "ngram"
+	PropertyTokenizationNgram string = "ngram"
+
+	// PropertyTokenizationCjk captures enum value "cjk"
+	PropertyTokenizationCjk string = "cjk"
 )
 
 // prop value enum
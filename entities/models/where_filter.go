@@ -37,7 +37,7 @@ type WhereFilter struct {
 
 	// operator to use
 	// Example: GreaterThanEqual
-	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull]
+	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange IsNull ContainsPhrase WithinWords Fuzzy]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -70,6 +70,9 @@ type WhereFilter struct {
 	// value as text
 	// Example: my search term
 	ValueText *string `json:"valueText,omitempty"`
+
+	// value as text and maximum distance between its words, or maximum edit distance for the Fuzzy operator
+	ValueTextProximity *WhereFilterTextProximity `json:"valueTextProximity,omitempty"`
 }
 
 // Validate validates this where filter
@@ -88,6 +91,10 @@ func (m *WhereFilter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueTextProximity(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -124,7 +131,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","IsNull","ContainsPhrase","WithinWords","Fuzzy"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -169,6 +176,15 @@ const (
 
 	// WhereFilterOperatorIsNull captures enum value "IsNull"
 	WhereFilterOperatorIsNull string = "IsNull"
+
+	// WhereFilterOperatorContainsPhrase captures enum value "ContainsPhrase"
+	WhereFilterOperatorContainsPhrase string = "ContainsPhrase"
+
+	// WhereFilterOperatorWithinWords captures enum value "WithinWords"
+	WhereFilterOperatorWithinWords string = "WithinWords"
+
+	// WhereFilterOperatorFuzzy captures enum value "Fuzzy"
+	WhereFilterOperatorFuzzy string = "Fuzzy"
 )
 
 // prop value enum
@@ -211,6 +227,25 @@ func (m *WhereFilter) validateValueGeoRange(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WhereFilter) validateValueTextProximity(formats strfmt.Registry) error {
+	if swag.IsZero(m.ValueTextProximity) { // not required
+		return nil
+	}
+
+	if m.ValueTextProximity != nil {
+		if err := m.ValueTextProximity.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueTextProximity")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueTextProximity")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this where filter based on the context it is used
 func (m *WhereFilter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -223,6 +258,10 @@ func (m *WhereFilter) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateValueTextProximity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -265,6 +304,22 @@ func (m *WhereFilter) contextValidateValueGeoRange(ctx context.Context, formats
 	return nil
 }
 
+func (m *WhereFilter) contextValidateValueTextProximity(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ValueTextProximity != nil {
+		if err := m.ValueTextProximity.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("valueTextProximity")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("valueTextProximity")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *WhereFilter) MarshalBinary() ([]byte, error) {
 	if m == nil {
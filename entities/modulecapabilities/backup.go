@@ -38,6 +38,10 @@ type BackupBackend interface {
 	PutFile(ctx context.Context, backupID, key, srcPath string) error
 	// PutObject writes bytes to the object with key `key`
 	PutObject(ctx context.Context, backupID, key string, byes []byte) error
+	// AllBackups returns the IDs of all backups stored in the backend
+	AllBackups(ctx context.Context) ([]string, error)
+	// DeleteBackup removes all objects stored under backupID
+	DeleteBackup(ctx context.Context, backupID string) error
 	// Initialize initializes backup provider and make sure that app have access rights to write into the object store.
 	Initialize(ctx context.Context, backupID string) error
 }
@@ -34,6 +34,12 @@ type NearParam interface {
 	SimilarityMetricProvided() bool
 }
 
+// TargetVectorParam is implemented by search params which can search one of
+// the named vectors of a class
+type TargetVectorParam interface {
+	GetTargetVector() string
+}
+
 // ValidateFn validates a given module param
 type ValidateFn = func(param interface{}) error
 
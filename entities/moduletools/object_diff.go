@@ -13,6 +13,7 @@ package moduletools
 
 type ObjectDiff struct {
 	oldVec        []float32
+	oldVecs       map[string][]float32
 	oldPropValues map[string]interface{}
 	newPropValues map[string]interface{}
 }
@@ -31,6 +32,26 @@ func (od *ObjectDiff) WithProp(propName string, oldValue, newValue interface{})
 	return od
 }
 
+// WithTargetVec sets the previous vector of a named vector
+func (od *ObjectDiff) WithTargetVec(targetVector string, oldVec []float32) *ObjectDiff {
+	if od.oldVecs == nil {
+		od.oldVecs = map[string][]float32{}
+	}
+	od.oldVecs[targetVector] = oldVec
+	return od
+}
+
+// ForTargetVector returns a diff of the same properties, whose vector is the
+// previous vector of the given named vector. Named vectors are vectorized one
+// at a time, so each of them is compared against its own previous vector.
+func (od *ObjectDiff) ForTargetVector(targetVector string) *ObjectDiff {
+	return &ObjectDiff{
+		oldVec:        od.oldVecs[targetVector],
+		oldPropValues: od.oldPropValues,
+		newPropValues: od.newPropValues,
+	}
+}
+
 func (od *ObjectDiff) GetVec() []float32 {
 	return od.oldVec
 }
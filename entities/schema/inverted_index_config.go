@@ -19,6 +19,7 @@ type InvertedIndexConfig struct {
 	IndexTimestamps     bool
 	IndexNullState      bool
 	IndexPropertyLength bool
+	Analyzers           map[string]models.AnalyzerConfig
 }
 
 type BM25Config struct {
@@ -19,6 +19,7 @@ import (
 var (
 	validateClassNameRegex    *regexp.Regexp
 	validatePropertyNameRegex *regexp.Regexp
+	validateVectorNameRegex   *regexp.Regexp
 	reservedPropertyNames     []string
 )
 
@@ -30,6 +31,7 @@ const (
 func init() {
 	validateClassNameRegex = regexp.MustCompile(`^` + ClassNameRegexCore + `$`)
 	validatePropertyNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	validateVectorNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]{0,230}$`)
 	reservedPropertyNames = []string{"_additional", "_id", "id"}
 }
 
@@ -51,6 +53,17 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidateVectorName validates that this string is a valid name of a named
+// vector. Vector names are used as GraphQL arguments and in file names of
+// the vector indexes, so they follow the property name rules.
+func ValidateVectorName(name string) error {
+	if validateVectorNameRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid vector name. "+
+		"Vector names must match “/[_A-Za-z][_0-9A-Za-z]{0,230}/”.", name)
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
@@ -11,6 +11,81 @@
 
 package schema
 
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
 type VectorIndexConfig interface {
 	IndexType() string
+	DistanceName() string
+}
+
+// TargetVectorIndexConfig returns the vector index config which is used when
+// searching the given target vector of a class. An empty target vector refers
+// to the class-level vector. Classes with named vectors require a target
+// vector unless they have exactly one named vector.
+func TargetVectorIndexConfig(class *models.Class, targetVector string) (VectorIndexConfig, error) {
+	if len(class.VectorConfig) == 0 {
+		if targetVector != "" {
+			return nil, fmt.Errorf("class %s does not have named vectors, "+
+				"cannot search target vector %q", class.Class, targetVector)
+		}
+		return typeAssertVectorIndexConfig(class.Class, class.VectorIndexConfig)
+	}
+
+	if targetVector == "" {
+		if len(class.VectorConfig) > 1 {
+			return nil, fmt.Errorf("class %s has multiple named vectors, "+
+				"a target vector needs to be specified", class.Class)
+		}
+		for name := range class.VectorConfig {
+			targetVector = name
+		}
+	}
+
+	vc, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("class %s does not have named vector %q",
+			class.Class, targetVector)
+	}
+	return typeAssertVectorIndexConfig(class.Class, vc.VectorIndexConfig)
+}
+
+// DefaultTargetVector returns the name of the named vector which is searched
+// if no target vector is specified. It is empty if the class uses the
+// class-level vector or has more than one named vector.
+func DefaultTargetVector(class *models.Class) string {
+	if len(class.VectorConfig) != 1 {
+		return ""
+	}
+	for name := range class.VectorConfig {
+		return name
+	}
+	return ""
+}
+
+// NamedVectorizer returns the name of the vectorizer module of a named vector
+// together with the module config. The vectorizer of a named vector is
+// configured as a map with the module name as its only key.
+func NamedVectorizer(cfg models.VectorConfig) (string, map[string]interface{}) {
+	vectorizer, ok := cfg.Vectorizer.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	for name, moduleCfg := range vectorizer {
+		moduleCfgMap, _ := moduleCfg.(map[string]interface{})
+		return name, moduleCfgMap
+	}
+	return "", nil
+}
+
+func typeAssertVectorIndexConfig(className string, cfg interface{}) (VectorIndexConfig, error) {
+	vectorConfig, ok := cfg.(VectorIndexConfig)
+	if !ok {
+		return nil, fmt.Errorf("class '%s' vector index: config is not schema.VectorIndexConfig: %T",
+			className, cfg)
+	}
+	return vectorConfig, nil
 }
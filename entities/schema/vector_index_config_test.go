@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeVectorIndexConfig struct {
+	distance string
+}
+
+func (f fakeVectorIndexConfig) IndexType() string {
+	return "hnsw"
+}
+
+func (f fakeVectorIndexConfig) DistanceName() string {
+	return f.distance
+}
+
+func TestTargetVectorIndexConfig(t *testing.T) {
+	classLevel := &models.Class{
+		Class:             "ClassLevel",
+		VectorIndexConfig: fakeVectorIndexConfig{distance: "cosine"},
+	}
+	single := &models.Class{
+		Class: "Single",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {VectorIndexConfig: fakeVectorIndexConfig{distance: "dot"}},
+		},
+	}
+	multiple := &models.Class{
+		Class: "Multiple",
+		VectorConfig: map[string]models.VectorConfig{
+			"title":       {VectorIndexConfig: fakeVectorIndexConfig{distance: "dot"}},
+			"description": {VectorIndexConfig: fakeVectorIndexConfig{distance: "l2-squared"}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		class            *models.Class
+		targetVector     string
+		expectedDistance string
+		expectedErr      string
+	}{
+		{
+			name:             "class-level vector",
+			class:            classLevel,
+			expectedDistance: "cosine",
+		},
+		{
+			name:         "target vector on a class without named vectors",
+			class:        classLevel,
+			targetVector: "title",
+			expectedErr:  "class ClassLevel does not have named vectors, cannot search target vector \"title\"",
+		},
+		{
+			name:             "single named vector without target",
+			class:            single,
+			expectedDistance: "dot",
+		},
+		{
+			name:             "multiple named vectors with target",
+			class:            multiple,
+			targetVector:     "description",
+			expectedDistance: "l2-squared",
+		},
+		{
+			name:        "multiple named vectors without target",
+			class:       multiple,
+			expectedErr: "class Multiple has multiple named vectors, a target vector needs to be specified",
+		},
+		{
+			name:         "unknown target vector",
+			class:        multiple,
+			targetVector: "summary",
+			expectedErr:  "class Multiple does not have named vector \"summary\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := TargetVectorIndexConfig(test.class, test.targetVector)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedDistance, cfg.DistanceName())
+		})
+	}
+}
+
+func TestDefaultTargetVector(t *testing.T) {
+	assert.Equal(t, "", DefaultTargetVector(&models.Class{}))
+	assert.Equal(t, "title", DefaultTargetVector(&models.Class{
+		VectorConfig: map[string]models.VectorConfig{"title": {}},
+	}))
+	assert.Equal(t, "", DefaultTargetVector(&models.Class{
+		VectorConfig: map[string]models.VectorConfig{"title": {}, "description": {}},
+	}))
+}
+
+func TestNamedVectorizer(t *testing.T) {
+	t.Run("with module config", func(t *testing.T) {
+		module, cfg := NamedVectorizer(models.VectorConfig{
+			Vectorizer: map[string]interface{}{
+				"text2vec-contextionary": map[string]interface{}{"vectorizeClassName": false},
+			},
+		})
+		assert.Equal(t, "text2vec-contextionary", module)
+		assert.Equal(t, map[string]interface{}{"vectorizeClassName": false}, cfg)
+	})
+
+	t.Run("without vectorizer", func(t *testing.T) {
+		module, cfg := NamedVectorizer(models.VectorConfig{})
+		assert.Equal(t, "", module)
+		assert.Nil(t, cfg)
+	})
+}
@@ -16,6 +16,7 @@ type NearVector struct {
 	Certainty    float64   `json:"certainty"`
 	Distance     float64   `json:"distance"`
 	WithDistance bool      `json:"-"`
+	TargetVector string    `json:"targetVector"`
 }
 
 type KeywordRanking struct {
@@ -23,6 +24,7 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	Fuzziness              int      `json:"fuzziness"`
 }
 
 type WeightedSearchResult struct {
@@ -39,6 +41,7 @@ type HybridSearch struct {
 	Vector          []float32   `json:"vector"`
 	Properties      []string    `json:"properties"`
 	FusionAlgorithm int         `json:"fusionalgorithm"`
+	TargetVector    string      `json:"targetVector"`
 }
 
 type NearObject struct {
@@ -47,6 +50,7 @@ type NearObject struct {
 	Certainty    float64 `json:"certainty"`
 	Distance     float64 `json:"distance"`
 	WithDistance bool    `json:"-"`
+	TargetVector string  `json:"targetVector"`
 }
 
 type ObjectMove struct {
@@ -71,6 +75,7 @@ type NearTextParams struct {
 	WithDistance bool
 	Network      bool
 	Autocorrect  bool
+	TargetVector string
 }
 
 type GroupBy struct {
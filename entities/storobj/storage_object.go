@@ -37,9 +37,12 @@ type Object struct {
 	Object            models.Object `json:"object"`
 	Vector            []float32     `json:"vector"`
 	VectorLen         int           `json:"-"`
-	BelongsToNode     string        `json:"-"`
-	BelongsToShard    string        `json:"-"`
-	IsConsistent      bool          `json:"-"`
+	// Vectors holds the named vectors of an object, keyed by the name of the
+	// target vector as configured in the class' vectorConfig
+	Vectors        map[string][]float32 `json:"vectors"`
+	BelongsToNode  string               `json:"-"`
+	BelongsToShard string               `json:"-"`
+	IsConsistent   bool                 `json:"-"`
 
 	docID uint64
 }
@@ -51,7 +54,7 @@ func New(docID uint64) *Object {
 	}
 }
 
-func FromObject(object *models.Object, vector []float32) *Object {
+func FromObject(object *models.Object, vector []float32, vectors models.Vectors) *Object {
 	// clear out nil entries of properties to make sure leaving a property out and setting it nil is identical
 	properties, ok := object.Properties.(map[string]interface{})
 	if ok {
@@ -63,9 +66,18 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
+	var namedVectors map[string][]float32
+	if len(vectors) > 0 {
+		namedVectors = make(map[string][]float32, len(vectors))
+		for name, vec := range vectors {
+			namedVectors[name] = vec
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
+		Vectors:           namedVectors,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 	}
@@ -180,6 +192,16 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	if addProp.Vector && r.Len() > 0 {
+		var vectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &vectorsLength), "vectors length")
+		vectors := make([]byte, vectorsLength)
+		_, err = r.Read(vectors)
+		ec.AddWrap(err, "vectors")
+		ko.Vectors, err = unmarshalVectors(vectors)
+		ec.AddWrap(err, "parse vectors")
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -379,6 +401,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   ko.namedVectors(),
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -443,6 +466,22 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 	return docID, err
 }
 
+// UpdateTimeFromBinary reads the last update time of an object without
+// parsing the rest of the payload
+func UpdateTimeFromBinary(in []byte) (int64, error) {
+	// version, doc id, kind, uuid and create time precede the update time
+	const offset = 1 + 8 + 1 + 16 + 8
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("object payload too short: %d bytes", len(in))
+	}
+
+	if in[0] != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", in[0])
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset : offset+8])), nil
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -466,6 +505,8 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of named vectors, optional
+// n          | []byte    | named vectors, see marshalVectors
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -501,8 +542,13 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return nil, err
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
+	vectors := ko.marshalVectors()
+	vectorsLength := uint32(len(vectors))
 
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	if vectorsLength > 0 {
+		totalBufferLength += 4 + vectorsLength
+	}
 	byteBuffer := make([]byte, totalBufferLength)
 	byteOps := byte_operations.ByteOperations{Buffer: byteBuffer}
 	byteOps.WriteByte(ko.MarshallerVersion)
@@ -542,9 +588,94 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if vectorsLength > 0 {
+		byteOps.WriteUint32(vectorsLength)
+		err = byteOps.CopyBytesToBuffer(vectors)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy vectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
+// marshalVectors creates the binary representation of the named vectors.
+// Objects without named vectors are stored exactly as before, so that the
+// section is optional and older objects can still be read.
+//
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | number of named vectors
+// per named vector:
+// 2          | uint16    | length of name
+// n          | []byte    | name
+// 2          | uint16    | VectorLength
+// n*4        | []float32 | vector of length n
+func (ko *Object) marshalVectors() []byte {
+	if len(ko.Vectors) == 0 {
+		return nil
+	}
+
+	length := 2
+	for name, vec := range ko.Vectors {
+		length += 2 + len(name) + 2 + len(vec)*4
+	}
+
+	buf := make([]byte, length)
+	byteOps := byte_operations.ByteOperations{Buffer: buf}
+	byteOps.WriteUint16(uint16(len(ko.Vectors)))
+	for name, vec := range ko.Vectors {
+		byteOps.WriteUint16(uint16(len(name)))
+		byteOps.CopyBytesToBuffer([]byte(name))
+		byteOps.WriteUint16(uint16(len(vec)))
+		for _, v := range vec {
+			byteOps.WriteUint32(math.Float32bits(v))
+		}
+	}
+
+	return buf
+}
+
+func unmarshalVectors(in []byte) (map[string][]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	byteOps := byte_operations.ByteOperations{Buffer: in}
+	count := int(byteOps.ReadUint16())
+	vectors := make(map[string][]float32, count)
+	for i := 0; i < count; i++ {
+		nameLength := uint64(byteOps.ReadUint16())
+		if byteOps.Position+nameLength > uint64(len(in)) {
+			return nil, errors.Errorf("corrupt named vectors at position %d", byteOps.Position)
+		}
+		name := string(byteOps.ReadBytesFromBuffer(nameLength))
+		vecLength := int(byteOps.ReadUint16())
+		if byteOps.Position+uint64(vecLength*4) > uint64(len(in)) {
+			return nil, errors.Errorf("corrupt named vector %q", name)
+		}
+		vec := make([]float32, vecLength)
+		for j := range vec {
+			vec[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
+		vectors[name] = vec
+	}
+
+	return vectors, nil
+}
+
+func (ko *Object) namedVectors() models.Vectors {
+	if len(ko.Vectors) == 0 {
+		return nil
+	}
+
+	out := make(models.Vectors, len(ko.Vectors))
+	for name, vec := range ko.Vectors {
+		out[name] = vec
+	}
+	return out
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -684,6 +815,18 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if byteOps.Position < uint64(len(data)) {
+		vectorsLength := uint64(byteOps.ReadUint32())
+		vectors, err := byteOps.CopyBytesFromBuffer(vectorsLength, nil)
+		if err != nil {
+			return errors.Wrap(err, "Could not copy vectors")
+		}
+		ko.Vectors, err = unmarshalVectors(vectors)
+		if err != nil {
+			return errors.Wrap(err, "Could not parse vectors")
+		}
+	}
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -730,6 +873,57 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	return out, nil
 }
 
+// NamedVectorFromBinary reads a single named vector without parsing the rest
+// of the object. It returns nil if the object has no vector with the given
+// name.
+func NamedVectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	// skip everything up to and including the vector weights, the named vectors
+	// are the last section of the object
+	byteOps := byte_operations.ByteOperations{Position: 42, Buffer: in}
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint16()) * 4)
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint16()))
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint32()))
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint32()))
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint32()))
+	if byteOps.Position >= uint64(len(in)) {
+		return nil, nil
+	}
+
+	byteOps.MoveBufferPositionForward(4) // length of named vectors
+	count := int(byteOps.ReadUint16())
+	for i := 0; i < count; i++ {
+		nameLength := uint64(byteOps.ReadUint16())
+		name := byteOps.ReadBytesFromBuffer(nameLength)
+		vecLength := int(byteOps.ReadUint16())
+		if string(name) != targetVector {
+			byteOps.MoveBufferPositionForward(uint64(vecLength * 4))
+			continue
+		}
+
+		var out []float32
+		if cap(buffer) >= vecLength {
+			out = buffer[:vecLength]
+		} else {
+			out = make([]float32, vecLength)
+		}
+		for j := range out {
+			out[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
+		return out, nil
+	}
+
+	return nil, nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
 	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
 ) error {
@@ -825,6 +1019,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
@@ -841,6 +1036,17 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string][]float32, len(orig))
+	for name, vec := range orig {
+		out[name] = deepCopyVector(vec)
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
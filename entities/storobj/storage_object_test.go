@@ -50,6 +50,7 @@ func TestStorageObjectMarshalling(t *testing.T) {
 			},
 		},
 		[]float32{1, 2, 0.7},
+		nil,
 	)
 	before.SetDocID(7)
 
@@ -69,6 +70,12 @@ func TestStorageObjectMarshalling(t *testing.T) {
 		assert.Equal(t, uint64(7), id)
 	})
 
+	t.Run("extract only update time and compare", func(t *testing.T) {
+		updateTime, err := UpdateTimeFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(56789), updateTime)
+	})
+
 	t.Run("extract single text prop", func(t *testing.T) {
 		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
 		require.Nil(t, err)
@@ -85,6 +92,47 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithNamedVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+		},
+		nil,
+		models.Vectors{
+			"title":       []float32{1, 2, 3},
+			"description": []float32{0.4, 0.5},
+		},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("with vectors", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.Object, after.Object)
+		assert.Equal(t, models.Vectors{
+			"title":       []float32{1, 2, 3},
+			"description": []float32{0.4, 0.5},
+		}, after.SearchResult(additional.Properties{}, "").Vectors)
+	})
+
+	t.Run("without vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
@@ -96,6 +144,7 @@ func TestFilteringNilProperty(t *testing.T) {
 			},
 		},
 		[]float32{1, 2, 0.7},
+		nil,
 	)
 	props := object.Properties()
 	propsTyped, ok := props.(map[string]interface{})
@@ -134,6 +183,7 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 			},
 		},
 		[]float32{1, 2, 0.7},
+		nil,
 	)
 	before.SetDocID(7)
 
@@ -208,7 +258,7 @@ func TestNewStorageObject(t *testing.T) {
 					Properties: map[string]interface{}{
 						"foo": "bar",
 					},
-				}, nil)
+				}, nil, nil)
 				alt.SetDocID(13)
 
 				assert.Equal(t, so, alt)
@@ -262,7 +312,7 @@ func TestNewStorageObject(t *testing.T) {
 					Properties: map[string]interface{}{
 						"foo": "bar",
 					},
-				}, nil)
+				}, nil, nil)
 				alt.SetDocID(13)
 
 				assert.Equal(t, so, alt)
@@ -298,6 +348,7 @@ func TestStorageArrayObjectMarshalling(t *testing.T) {
 			},
 		},
 		[]float32{1, 2, 0.7},
+		nil,
 	)
 	before.SetDocID(7)
 
@@ -368,6 +419,7 @@ func TestExtractionOfSingleProperties(t *testing.T) {
 			Properties:         properties,
 		},
 		[]float32{1, 2, 0.7},
+		nil,
 	)
 
 	before.SetDocID(7)
@@ -442,6 +494,7 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 			},
 		},
 		[]float32{1, 2, 0.7},
+		nil,
 	)
 	before.SetDocID(7)
 
@@ -0,0 +1,282 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultDistanceMetric        = hnsw.DistanceCosine
+	DefaultPQEnabled             = false
+	DefaultPQSegments            = 0
+	DefaultPQCentroids           = 256
+	DefaultPQTrainingLimit       = 100000
+	DefaultPQRescoreLimit        = 100
+	DefaultPQEncoderType         = hnsw.PQEncoderTypeKMeans
+	DefaultPQEncoderDistribution = hnsw.PQEncoderDistributionLogNormal
+
+	// Fail validation if those criteria are not met
+	MinimumPQTrainingLimit = 1
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance string   `json:"distance"`
+	PQ       PQConfig `json:"pq"`
+}
+
+// PQConfig configures product quantization of the flat index. Once
+// TrainingLimit vectors are present the quantizer is fit and all scans are
+// performed on the compressed codes. The best RescoreLimit candidates are
+// then rescored using the uncompressed vectors.
+type PQConfig struct {
+	Enabled       bool           `json:"enabled"`
+	Segments      int            `json:"segments"`
+	Centroids     int            `json:"centroids"`
+	TrainingLimit int            `json:"trainingLimit"`
+	RescoreLimit  int            `json:"rescoreLimit"`
+	Encoder       hnsw.PQEncoder `json:"encoder"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "flat"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.PQ = PQConfig{
+		Enabled:       DefaultPQEnabled,
+		Segments:      DefaultPQSegments,
+		Centroids:     DefaultPQCentroids,
+		TrainingLimit: DefaultPQTrainingLimit,
+		RescoreLimit:  DefaultPQRescoreLimit,
+		Encoder: hnsw.PQEncoder{
+			Type:         DefaultPQEncoderType,
+			Distribution: DefaultPQEncoderDistribution,
+		},
+	}
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := optionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := parsePQMap(asMap, &uc.PQ); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	var errMsgs []string
+	switch u.Distance {
+	case hnsw.DistanceCosine, hnsw.DistanceDot, hnsw.DistanceL2Squared,
+		hnsw.DistanceManhattan, hnsw.DistanceHamming:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf("unrecognized distance metric %q", u.Distance))
+	}
+
+	if u.PQ.Enabled {
+		if u.PQ.TrainingLimit < MinimumPQTrainingLimit {
+			errMsgs = append(errMsgs, fmt.Sprintf(
+				"pq.trainingLimit must be a positive integer with a minimum of %d",
+				MinimumPQTrainingLimit))
+		}
+		if u.PQ.Centroids < 1 || u.PQ.Centroids > 256 {
+			errMsgs = append(errMsgs, "pq.centroids must be between 1 and 256")
+		}
+		if u.PQ.Segments < 0 {
+			errMsgs = append(errMsgs, "pq.segments must not be negative")
+		}
+		if u.PQ.RescoreLimit < 0 {
+			errMsgs = append(errMsgs, "pq.rescoreLimit must not be negative")
+		}
+		if err := hnsw.ValidatePQConfig(hnsw.PQConfig{Encoder: u.PQ.Encoder}); err != nil {
+			errMsgs = append(errMsgs, err.Error())
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("invalid flat config: %s",
+			strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
+	pqConfigValue, ok := in["pq"]
+	if !ok {
+		return nil
+	}
+
+	pqConfigMap, ok := pqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(pqConfigMap, "enabled", func(v bool) {
+		pq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(pqConfigMap, "segments", func(v int) {
+		pq.Segments = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(pqConfigMap, "centroids", func(v int) {
+		pq.Centroids = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(pqConfigMap, "trainingLimit", func(v int) {
+		pq.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(pqConfigMap, "rescoreLimit", func(v int) {
+		pq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	pqEncoderMap, ok := pqConfigMap["encoder"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalStringFromMap(pqEncoderMap, "type", func(v string) {
+		pq.Encoder.Type = v
+	}); err != nil {
+		return err
+	}
+
+	return optionalStringFromMap(pqEncoderMap, "distribution", func(v string) {
+		pq.Encoder.Distribution = v
+	})
+}
+
+// Tries to parse the int value from the map, if it overflows math.MaxInt64, it
+// uses math.MaxInt64 instead. This is to protect from rounding errors from
+// json marshalling where the type may be assumed as float64
+func optionalIntFromMap(in map[string]interface{}, name string,
+	setFn func(v int),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asInt64 int64
+	var err error
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asInt64, err = typed.Int64()
+	case float64:
+		asInt64 = int64(typed)
+	}
+	if err != nil {
+		// try to recover from error
+		if errors.Is(err, strconv.ErrRange) {
+			setFn(int(math.MaxInt64))
+			return nil
+		}
+
+		return errors.Wrapf(err, "json.Number to int64 for %q", name)
+	}
+
+	setFn(int(asInt64))
+	return nil
+}
+
+func optionalBoolFromMap(in map[string]interface{}, name string,
+	setFn func(v bool),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	asBool, ok := value.(bool)
+	if !ok {
+		return nil
+	}
+
+	setFn(asBool)
+	return nil
+}
+
+func optionalStringFromMap(in map[string]interface{}, name string,
+	setFn func(v string),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	setFn(asString)
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	defaultPQ := PQConfig{
+		Enabled:       DefaultPQEnabled,
+		Segments:      DefaultPQSegments,
+		Centroids:     DefaultPQCentroids,
+		TrainingLimit: DefaultPQTrainingLimit,
+		RescoreLimit:  DefaultPQRescoreLimit,
+		Encoder: hnsw.PQEncoder{
+			Type:         DefaultPQEncoderType,
+			Distribution: DefaultPQEncoderDistribution,
+		},
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance: DefaultDistanceMetric,
+				PQ:       defaultPQ,
+			},
+		},
+		{
+			name: "with a different distance",
+			input: map[string]interface{}{
+				"distance": "l2-squared",
+			},
+			expected: UserConfig{
+				Distance: hnsw.DistanceL2Squared,
+				PQ:       defaultPQ,
+			},
+		},
+		{
+			name: "with pq enabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":       true,
+					"segments":      json.Number("8"),
+					"centroids":     json.Number("128"),
+					"trainingLimit": json.Number("1000"),
+					"rescoreLimit":  float64(20),
+					"encoder": map[string]interface{}{
+						"type": hnsw.PQEncoderTypeTile,
+					},
+				},
+			},
+			expected: UserConfig{
+				Distance: DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:       true,
+					Segments:      8,
+					Centroids:     128,
+					TrainingLimit: 1000,
+					RescoreLimit:  20,
+					Encoder: hnsw.PQEncoder{
+						Type:         hnsw.PQEncoderTypeTile,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+			},
+		},
+		{
+			name: "with invalid pq settings, but pq disabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"centroids": json.Number("1000"),
+				},
+			},
+			expected: UserConfig{
+				Distance: DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:       DefaultPQEnabled,
+					Segments:      DefaultPQSegments,
+					Centroids:     1000,
+					TrainingLimit: DefaultPQTrainingLimit,
+					RescoreLimit:  DefaultPQRescoreLimit,
+					Encoder:       defaultPQ.Encoder,
+				},
+			},
+		},
+		{
+			name: "with an unknown distance",
+			input: map[string]interface{}{
+				"distance": "not-a-distance",
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid flat config: unrecognized distance metric \"not-a-distance\"",
+		},
+		{
+			name: "with too many centroids",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":   true,
+					"centroids": json.Number("1000"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid flat config: pq.centroids must be between 1 and 256",
+		},
+		{
+			name: "with a training limit below the minimum",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":       true,
+					"trainingLimit": json.Number("0"),
+				},
+			},
+			expectErr: true,
+			expectErrMsg: "invalid flat config: pq.trainingLimit must be a positive " +
+				"integer with a minimum of 1",
+		},
+		{
+			name: "with a negative rescore limit",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": json.Number("-1"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid flat config: pq.rescoreLimit must not be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectErrMsg, err.Error())
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+			assert.Equal(t, "flat", cfg.IndexType())
+		})
+	}
+}
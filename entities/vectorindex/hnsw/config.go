@@ -63,6 +63,7 @@ type UserConfig struct {
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
 	Distance               string   `json:"distance"`
 	PQ                     PQConfig `json:"pq"`
+	BQ                     BQConfig `json:"bq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -71,6 +72,10 @@ func (u UserConfig) IndexType() string {
 	return "hnsw"
 }
 
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.MaxConnections = DefaultMaxConnections
@@ -95,6 +100,9 @@ func (u *UserConfig) SetDefaults() {
 			Distribution: DefaultPQEncoderDistribution,
 		},
 	}
+	u.BQ = BQConfig{
+		Enabled: DefaultBQEnabled,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -182,6 +190,10 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseBQMap(asMap, &uc.BQ); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -201,6 +213,10 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.PQ.Enabled && u.BQ.Enabled {
+		errMsgs = append(errMsgs, "pq and bq compression cannot be enabled at the same time")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
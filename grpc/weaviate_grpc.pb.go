@@ -29,6 +29,14 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
+	BatchReferences(ctx context.Context, in *BatchReferencesRequest, opts ...grpc.CallOption) (*BatchReferencesReply, error)
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectReply, error)
+	PutObject(ctx context.Context, in *PutObjectRequest, opts ...grpc.CallOption) (*PutObjectReply, error)
+	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectReply, error)
+	ExportObjects(ctx context.Context, in *ExportObjectsRequest, opts ...grpc.CallOption) (Weaviate_ExportObjectsClient, error)
+	StreamChanges(ctx context.Context, in *StreamChangesRequest, opts ...grpc.CallOption) (Weaviate_StreamChangesClient, error)
 }
 
 type weaviateClient struct {
@@ -48,11 +56,137 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error) {
+	out := new(BatchObjectsReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error) {
+	out := new(BatchDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) BatchReferences(ctx context.Context, in *BatchReferencesRequest, opts ...grpc.CallOption) (*BatchReferencesReply, error) {
+	out := new(BatchReferencesReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectReply, error) {
+	out := new(GetObjectReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/GetObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) PutObject(ctx context.Context, in *PutObjectRequest, opts ...grpc.CallOption) (*PutObjectReply, error) {
+	out := new(PutObjectReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/PutObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectReply, error) {
+	out := new(DeleteObjectReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/DeleteObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ExportObjects(ctx context.Context, in *ExportObjectsRequest, opts ...grpc.CallOption) (Weaviate_ExportObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviategrpc.Weaviate/ExportObjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateExportObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ExportObjectsClient interface {
+	Recv() (*ExportObjectsReply, error)
+	grpc.ClientStream
+}
+
+type weaviateExportObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateExportObjectsClient) Recv() (*ExportObjectsReply, error) {
+	m := new(ExportObjectsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) StreamChanges(ctx context.Context, in *StreamChangesRequest, opts ...grpc.CallOption) (Weaviate_StreamChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviategrpc.Weaviate/StreamChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateStreamChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_StreamChangesClient interface {
+	Recv() (*StreamChangesReply, error)
+	grpc.ClientStream
+}
+
+type weaviateStreamChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateStreamChangesClient) Recv() (*StreamChangesReply, error) {
+	m := new(StreamChangesReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
+	BatchReferences(context.Context, *BatchReferencesRequest) (*BatchReferencesReply, error)
+	GetObject(context.Context, *GetObjectRequest) (*GetObjectReply, error)
+	PutObject(context.Context, *PutObjectRequest) (*PutObjectReply, error)
+	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectReply, error)
+	ExportObjects(*ExportObjectsRequest, Weaviate_ExportObjectsServer) error
+	StreamChanges(*StreamChangesRequest, Weaviate_StreamChangesServer) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -62,6 +196,30 @@ type UnimplementedWeaviateServer struct{}
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
+}
+func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedWeaviateServer) BatchReferences(context.Context, *BatchReferencesRequest) (*BatchReferencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReferences not implemented")
+}
+func (UnimplementedWeaviateServer) GetObject(context.Context, *GetObjectRequest) (*GetObjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (UnimplementedWeaviateServer) PutObject(context.Context, *PutObjectRequest) (*PutObjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObject not implemented")
+}
+func (UnimplementedWeaviateServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedWeaviateServer) ExportObjects(*ExportObjectsRequest, Weaviate_ExportObjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportObjects not implemented")
+}
+func (UnimplementedWeaviateServer) StreamChanges(*StreamChangesRequest, Weaviate_StreamChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChanges not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +251,156 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchObjects(ctx, req.(*BatchObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_BatchReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchReferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchReferences(ctx, req.(*BatchReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/GetObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).GetObject(ctx, req.(*GetObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_PutObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).PutObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/PutObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).PutObject(ctx, req.(*PutObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/DeleteObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ExportObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).ExportObjects(m, &weaviateExportObjectsServer{stream})
+}
+
+type Weaviate_ExportObjectsServer interface {
+	Send(*ExportObjectsReply) error
+	grpc.ServerStream
+}
+
+type weaviateExportObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateExportObjectsServer) Send(m *ExportObjectsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_StreamChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).StreamChanges(m, &weaviateStreamChangesServer{stream})
+}
+
+type Weaviate_StreamChangesServer interface {
+	Send(*StreamChangesReply) error
+	grpc.ServerStream
+}
+
+type weaviateStreamChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateStreamChangesServer) Send(m *StreamChangesReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,7 +412,42 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Weaviate_Search_Handler,
 		},
+		{
+			MethodName: "BatchObjects",
+			Handler:    _Weaviate_BatchObjects_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _Weaviate_BatchDelete_Handler,
+		},
+		{
+			MethodName: "BatchReferences",
+			Handler:    _Weaviate_BatchReferences_Handler,
+		},
+		{
+			MethodName: "GetObject",
+			Handler:    _Weaviate_GetObject_Handler,
+		},
+		{
+			MethodName: "PutObject",
+			Handler:    _Weaviate_PutObject_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _Weaviate_DeleteObject_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportObjects",
+			Handler:       _Weaviate_ExportObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamChanges",
+			Handler:       _Weaviate_StreamChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "weaviate.proto",
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/backup"
 )
@@ -176,6 +177,62 @@ func (a *azureClient) PutObject(ctx context.Context, backupID, key string, data
 	return nil
 }
 
+// backupsPrefix is the prefix shared by all blobs of all backups
+func (a *azureClient) backupsPrefix() string {
+	if prefix := a.makeObjectName(); prefix != "" {
+		return prefix + "/"
+	}
+	return ""
+}
+
+func (a *azureClient) AllBackups(ctx context.Context) ([]string, error) {
+	prefix := a.backupsPrefix()
+	pager := a.client.ServiceClient().NewContainerClient(a.config.Container).
+		NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{Prefix: to.Ptr(prefix)})
+
+	var ids []string
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, p := range resp.Segment.BlobPrefixes {
+			if p.Name == nil {
+				continue
+			}
+			id := strings.TrimPrefix(*p.Name, prefix)
+			ids = append(ids, strings.TrimSuffix(id, "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (a *azureClient) DeleteBackup(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return fmt.Errorf("empty backup id")
+	}
+	prefix := a.makeObjectName(backupID) + "/"
+	pager := a.client.NewListBlobsFlatPager(a.config.Container,
+		&azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
+
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, item := range resp.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			_, err := a.client.DeleteBlob(ctx, a.config.Container, *item.Name, nil)
+			if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob '%s'", *item.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) Initialize(ctx context.Context, backupID string) error {
 	key := "access-check"
 
@@ -128,6 +128,36 @@ func (m *Module) PutObject(ctx context.Context, backupID, key string, byes []byt
 	return nil
 }
 
+func (m *Module) AllBackups(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(m.backupsPath)
+	if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrapf(err, "read dir '%s'", m.backupsPath))
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			ids = append(ids, entry.Name())
+		}
+	}
+	return ids, nil
+}
+
+func (m *Module) DeleteBackup(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return fmt.Errorf("empty backup id")
+	}
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete backup '%s'", backupID))
+	}
+
+	backupPath := m.makeBackupDirPath(backupID)
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "remove dir '%s'", backupPath))
+	}
+	return nil
+}
+
 func (m *Module) Initialize(ctx context.Context, backupID string) error {
 	// TODO: does anything need to be done here?
 	return nil
@@ -52,3 +52,27 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_AllBackupsAndDelete(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	for _, id := range []string{"backup1", "backup2"} {
+		assert.Nil(t, module.PutObject(ctx, id, "node1/meta.json", []byte("{}")))
+	}
+
+	ids, err := module.AllBackups(ctx)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"backup1", "backup2"}, ids)
+
+	assert.Nil(t, module.DeleteBackup(ctx, "backup1"))
+	assert.NotNil(t, module.DeleteBackup(ctx, ""))
+
+	ids, err = module.AllBackups(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"backup2"}, ids)
+
+	_, err = os.Stat(module.HomeDir("backup1"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -216,6 +217,66 @@ func (g *gcsClient) PutObject(ctx context.Context, backupID, key string, byes []
 	return nil
 }
 
+// backupsPrefix is the prefix shared by all objects of all backups
+func (g *gcsClient) backupsPrefix() string {
+	if prefix := g.makeObjectName(); prefix != "" {
+		return prefix + "/"
+	}
+	return ""
+}
+
+func (g *gcsClient) AllBackups(ctx context.Context) ([]string, error) {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := g.backupsPrefix()
+	var ids []string
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix, Delimiter: "/"})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		// with a delimiter "directories" are returned as synthetic prefixes
+		if attrs.Prefix != "" {
+			id := strings.TrimPrefix(attrs.Prefix, prefix)
+			ids = append(ids, strings.TrimSuffix(id, "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (g *gcsClient) DeleteBackup(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return fmt.Errorf("empty backup id")
+	}
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := g.makeObjectName(backupID) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		err = bucket.Object(attrs.Name).Delete(ctx)
+		if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+}
+
 func (g *gcsClient) Initialize(ctx context.Context, backupID string) error {
 	key := "access-check"
 
@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -146,6 +147,66 @@ func (s *s3Client) PutObject(ctx context.Context, backupID, key string, byes []b
 	return nil
 }
 
+// backupsPrefix is the prefix shared by all objects of all backups
+func (s *s3Client) backupsPrefix() string {
+	if prefix := s.makeObjectName(); prefix != "" {
+		return prefix + "/"
+	}
+	return ""
+}
+
+func (s *s3Client) AllBackups(ctx context.Context) ([]string, error) {
+	prefix := s.backupsPrefix()
+	opt := minio.ListObjectsOptions{Prefix: prefix}
+
+	var ids []string
+	for obj := range s.client.ListObjects(ctx, s.config.Bucket, opt) {
+		if obj.Err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(obj.Err, "list objects '%s'", prefix))
+		}
+		// non-recursive listings return one entry per "directory"
+		if id := strings.TrimPrefix(obj.Key, prefix); strings.HasSuffix(id, "/") {
+			ids = append(ids, strings.TrimSuffix(id, "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (s *s3Client) DeleteBackup(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return fmt.Errorf("empty backup id")
+	}
+	prefix := s.makeObjectName(backupID) + "/"
+	opt := minio.ListObjectsOptions{Prefix: prefix, Recursive: true}
+
+	var listErr error
+	objects := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objects)
+		for obj := range s.client.ListObjects(ctx, s.config.Bucket, opt) {
+			if obj.Err != nil {
+				listErr = obj.Err
+				return
+			}
+			objects <- obj
+		}
+	}()
+
+	var removeErr error
+	for rErr := range s.client.RemoveObjects(ctx, s.config.Bucket, objects, minio.RemoveObjectsOptions{}) {
+		if removeErr == nil {
+			removeErr = errors.Wrapf(rErr.Err, "remove object '%s'", rErr.ObjectName)
+		}
+	}
+	if listErr != nil {
+		return backup.NewErrInternal(errors.Wrapf(listErr, "list objects '%s'", prefix))
+	}
+	if removeErr != nil {
+		return backup.NewErrInternal(removeErr)
+	}
+	return nil
+}
+
 func (s *s3Client) Initialize(ctx context.Context, backupID string) error {
 	key := "access-check"
 
@@ -25,6 +25,7 @@ type NearTextParams struct {
 	WithDistance bool
 	Network      bool
 	Autocorrect  bool
+	TargetVector string
 }
 
 func (n NearTextParams) GetCertainty() float64 {
@@ -39,6 +40,10 @@ func (n NearTextParams) SimilarityMetricProvided() bool {
 	return n.Certainty != 0 || n.WithDistance
 }
 
+func (n NearTextParams) GetTargetVector() string {
+	return n.TargetVector
+}
+
 // ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
 type ExploreMove struct {
 	Values  []string
@@ -56,6 +56,11 @@ func (g *GraphQLArgumentsProvider) extractNearTextFn(source map[string]interface
 		args.WithDistance = true
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
 	// moveTo is an optional arg, so it could be nil
 	moveTo, ok := source["moveTo"]
 	if ok {
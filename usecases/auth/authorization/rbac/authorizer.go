@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const AnonymousPrincipalUsername = "anonymous"
+
+// Authorizer grants access based on the roles assigned to the user or to
+// any of the groups of the principal
+type Authorizer struct {
+	roles  map[string][]permission
+	users  map[string][]string
+	groups map[string][]string
+}
+
+type permission struct {
+	verbs     map[string]struct{}
+	resources []pattern
+}
+
+// pattern is a resource pattern split into its path segments
+type pattern []string
+
+// New Authorizer using role based access control. The config is expected to
+// have been validated.
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{
+		roles:  make(map[string][]permission, len(cfg.Roles)),
+		users:  cfg.Users,
+		groups: cfg.Groups,
+	}
+
+	for name, role := range cfg.Roles {
+		perms := make([]permission, len(role.Permissions))
+		for i, perm := range role.Permissions {
+			perms[i] = newPermission(perm)
+		}
+		a.roles[name] = perms
+	}
+
+	return a
+}
+
+// Authorize allows the request if any role of the principal grants the verb
+// on the resource
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = newAnonymousPrincipal()
+	}
+
+	for _, role := range a.principalRoles(principal) {
+		for _, perm := range a.roles[role] {
+			if perm.allows(verb, resource) {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func (a *Authorizer) principalRoles(principal *models.Principal) []string {
+	roles := append([]string{}, a.users[principal.Username]...)
+	for _, group := range principal.Groups {
+		roles = append(roles, a.groups[group]...)
+	}
+	return roles
+}
+
+func newPermission(perm Permission) permission {
+	p := permission{
+		verbs:     make(map[string]struct{}, len(perm.Verbs)),
+		resources: make([]pattern, len(perm.Resources)),
+	}
+
+	for _, verb := range perm.Verbs {
+		p.verbs[verb] = struct{}{}
+	}
+
+	for i, resource := range perm.Resources {
+		p.resources[i] = newPattern(resource)
+	}
+
+	return p
+}
+
+func (p permission) allows(verb, resource string) bool {
+	if _, ok := p.verbs["*"]; !ok {
+		if _, ok := p.verbs[verb]; !ok {
+			return false
+		}
+	}
+
+	for _, pattern := range p.resources {
+		if pattern.matches(resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newPattern(resource string) pattern {
+	return strings.Split(resource, "/")
+}
+
+// matches compares the resource segment by segment. A '*' segment matches
+// any single segment, as the last segment of the pattern it matches all
+// remaining segments. All other segments have to be equal, so
+// "objects/Article" does not match "objects/ArticleDrafts".
+func (p pattern) matches(resource string) bool {
+	segments := strings.Split(resource, "/")
+	for i, part := range p {
+		if part == "*" && i == len(p)-1 {
+			return len(segments) >= len(p)
+		}
+		if i >= len(segments) || (part != "*" && part != segments[i]) {
+			return false
+		}
+	}
+	return len(segments) == len(p)
+}
+
+func newAnonymousPrincipal() *models.Principal {
+	return &models.Principal{
+		Username: AnonymousPrincipalUsername,
+	}
+}
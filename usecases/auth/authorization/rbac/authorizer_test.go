@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func Test_Authorizer(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Roles: map[string]Role{
+			"admin": {Permissions: []Permission{{
+				Verbs:     []string{"*"},
+				Resources: []string{"*"},
+			}}},
+			"reader": {Permissions: []Permission{{
+				Verbs:     []string{"get", "list", "head"},
+				Resources: []string{"objects/*", "schema/*", "traversal/*"},
+			}}},
+			"team-a-writer": {Permissions: []Permission{{
+				Verbs: []string{"create", "update", "delete"},
+				Resources: []string{
+					"objects/TeamAArticle", "objects/TeamAArticle/*",
+					"schema/TeamAArticle", "batch/*",
+				},
+			}}},
+			"tenant-writer": {Permissions: []Permission{{
+				Verbs:     []string{"create", "update"},
+				Resources: []string{"objects/Shared/tenants/acme", "objects/Shared/tenants/acme/*"},
+			}}},
+			"backup-operator": {Permissions: []Permission{{
+				Verbs:     []string{"add", "get", "restore"},
+				Resources: []string{"backups/s3/*"},
+			}}},
+			"acme-reader": {Permissions: []Permission{{
+				Verbs:     []string{"get"},
+				Resources: []string{"objects/*/tenants/acme/*"},
+			}}},
+		},
+		Users: map[string][]string{
+			"root":      {"admin"},
+			"alice":     {"reader", "team-a-writer"},
+			"anonymous": {"reader"},
+			"ops":       {"backup-operator"},
+			"acme":      {"tenant-writer", "acme-reader"},
+		},
+		Groups: map[string][]string{
+			"team-a": {"team-a-writer"},
+		},
+	}
+	authorizer := New(cfg)
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resource  string
+		allowed   bool
+	}{
+		{
+			name:      "admin can do anything",
+			principal: &models.Principal{Username: "root"},
+			verb:      "delete",
+			resource:  "schema/Article",
+			allowed:   true,
+		},
+		{
+			name:      "reader can read any class",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "get",
+			resource:  "objects/Article/8c6d2b62-23bc-4dd4-a5c8-8a8d2bd9bd1f",
+			allowed:   true,
+		},
+		{
+			name:      "team writer can write own classes",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "create",
+			resource:  "objects/TeamAArticle",
+			allowed:   true,
+		},
+		{
+			name:      "team writer cannot write other classes",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "create",
+			resource:  "objects/TeamBArticle",
+			allowed:   false,
+		},
+		{
+			name:      "role assigned through group",
+			principal: &models.Principal{Username: "bob", Groups: []string{"other", "team-a"}},
+			verb:      "update",
+			resource:  "schema/TeamAArticle",
+			allowed:   true,
+		},
+		{
+			name:      "group role does not grant other verbs",
+			principal: &models.Principal{Username: "bob", Groups: []string{"team-a"}},
+			verb:      "get",
+			resource:  "objects/TeamAArticle",
+			allowed:   false,
+		},
+		{
+			name:      "tenant writer can write its tenant",
+			principal: &models.Principal{Username: "acme"},
+			verb:      "update",
+			resource:  "objects/Shared/tenants/acme/8c6d2b62-23bc-4dd4-a5c8-8a8d2bd9bd1f",
+			allowed:   true,
+		},
+		{
+			name:      "tenant writer cannot write other tenants",
+			principal: &models.Principal{Username: "acme"},
+			verb:      "update",
+			resource:  "objects/Shared/tenants/acme2/8c6d2b62-23bc-4dd4-a5c8-8a8d2bd9bd1f",
+			allowed:   false,
+		},
+		{
+			name:      "backup operator on allowed backend",
+			principal: &models.Principal{Username: "ops"},
+			verb:      "restore",
+			resource:  "backups/s3/backup-1/restore",
+			allowed:   true,
+		},
+		{
+			name:      "backup operator on other backend",
+			principal: &models.Principal{Username: "ops"},
+			verb:      "add",
+			resource:  "backups/filesystem/backup-1",
+			allowed:   false,
+		},
+		{
+			name:      "anonymous principal",
+			principal: nil,
+			verb:      "list",
+			resource:  "schema/*",
+			allowed:   true,
+		},
+		{
+			name:      "unknown user",
+			principal: &models.Principal{Username: "mallory"},
+			verb:      "get",
+			resource:  "objects/Article",
+			allowed:   false,
+		},
+		{
+			name:      "names are not matched as prefixes",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "create",
+			resource:  "objects/TeamAArticleDrafts",
+			allowed:   false,
+		},
+		{
+			name:      "wildcard in the middle matches a single segment",
+			principal: &models.Principal{Username: "acme"},
+			verb:      "get",
+			resource:  "objects/Other/tenants/acme/8c6d2b62-23bc-4dd4-a5c8-8a8d2bd9bd1f",
+			allowed:   true,
+		},
+		{
+			name:      "wildcard in the middle does not match the class",
+			principal: &models.Principal{Username: "acme"},
+			verb:      "get",
+			resource:  "objects/Other",
+			allowed:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.Authorize(test.principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.IsType(t, errors.Forbidden{}, err)
+			}
+		})
+	}
+}
+
+func Test_Pattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		resource string
+		matches  bool
+	}{
+		{pattern: "objects/Article", resource: "objects/Article", matches: true},
+		{pattern: "objects/Article", resource: "objects/Article/id", matches: false},
+		{pattern: "objects/Article", resource: "objects/ArticleDrafts", matches: false},
+		{pattern: "objects/Article", resource: "objects", matches: false},
+		{pattern: "objects/Article/*", resource: "objects/Article/tenants/acme/id", matches: true},
+		{pattern: "objects/Article/*", resource: "objects/Article", matches: false},
+		{pattern: "objects/Article/*", resource: "objects/ArticleDrafts/id", matches: false},
+		{pattern: "objects/*/tenants/acme", resource: "objects/Article/tenants/acme", matches: true},
+		{pattern: "objects/*/tenants/acme", resource: "objects/Article/tenants/acme/id", matches: false},
+		{pattern: "objects/A.ticle", resource: "objects/Article", matches: false},
+		{pattern: "*", resource: "", matches: true},
+		{pattern: "*", resource: "schema/Article/tenants", matches: true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.matches, newPattern(test.pattern).matches(test.resource),
+			"pattern %q, resource %q", test.pattern, test.resource)
+	}
+}
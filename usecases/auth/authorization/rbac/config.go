@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Verbs which are used by the usecases when authorizing a request. The
+// wildcard grants all of them.
+var validVerbs = map[string]struct{}{
+	"*":        {},
+	"get":      {},
+	"list":     {},
+	"head":     {},
+	"create":   {},
+	"update":   {},
+	"delete":   {},
+	"validate": {},
+	"add":      {},
+	"restore":  {},
+}
+
+// Config defines the roles and which users and groups they are assigned to.
+// Users are identified by the username of the principal, which is the user
+// configured for an API key or the user claim of an OIDC token. Groups are
+// the groups claim of an OIDC token.
+type Config struct {
+	Enabled bool                `json:"enabled" yaml:"enabled"`
+	Roles   map[string]Role     `json:"roles" yaml:"roles"`
+	Users   map[string][]string `json:"users" yaml:"users"`
+	Groups  map[string][]string `json:"groups" yaml:"groups"`
+}
+
+// Role grants all of its permissions
+type Role struct {
+	Permissions []Permission `json:"permissions" yaml:"permissions"`
+}
+
+// Permission grants the verbs on all resources matching one of the resource
+// patterns. Patterns are matched by their '/' separated segments, names
+// have to match exactly. A '*' segment matches any name, as the last segment
+// it also matches everything nested below, e.g. "objects/Article/*" matches
+// all objects of the class Article, "objects/*/tenants/acme/*" the objects
+// of the tenant acme in all classes and "schema/*" the schema of all
+// classes. '*' can't be used as part of a name.
+type Permission struct {
+	Verbs     []string `json:"verbs" yaml:"verbs"`
+	Resources []string `json:"resources" yaml:"resources"`
+}
+
+// Validate the rbac config for viability, can be called from the central
+// config package
+func (c Config) Validate() error {
+	for _, name := range sortedKeys(c.Roles) {
+		if err := c.Roles[name].validate(); err != nil {
+			return fmt.Errorf("rbac: role '%s': %w", name, err)
+		}
+	}
+
+	if err := c.validateAssignments("user", c.Users); err != nil {
+		return err
+	}
+
+	return c.validateAssignments("group", c.Groups)
+}
+
+func (c Config) validateAssignments(kind string, assignments map[string][]string) error {
+	for _, subject := range sortedKeys(assignments) {
+		for _, role := range assignments[subject] {
+			if _, ok := c.Roles[role]; !ok {
+				return fmt.Errorf("rbac: %s '%s' is assigned unknown role '%s'",
+					kind, subject, role)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r Role) validate() error {
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("no permissions set")
+	}
+
+	for i, perm := range r.Permissions {
+		if len(perm.Verbs) == 0 {
+			return fmt.Errorf("permission %d: no verbs set", i)
+		}
+		for _, verb := range perm.Verbs {
+			if _, ok := validVerbs[verb]; !ok {
+				return fmt.Errorf("permission %d: unknown verb '%s'", i, verb)
+			}
+		}
+
+		if len(perm.Resources) == 0 {
+			return fmt.Errorf("permission %d: no resources set", i)
+		}
+		for _, resource := range perm.Resources {
+			if resource == "" {
+				return fmt.Errorf("permission %d: empty resource pattern", i)
+			}
+			for _, segment := range strings.Split(resource, "/") {
+				if segment != "*" && strings.Contains(segment, "*") {
+					return fmt.Errorf("permission %d: resource pattern '%s': "+
+						"'*' has to be a whole path segment", i, resource)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedKeys makes sure that validation errors are deterministic
+func sortedKeys[T any](in map[string]T) []string {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
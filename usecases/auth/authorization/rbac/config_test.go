@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validation(t *testing.T) {
+	reader := Role{Permissions: []Permission{{
+		Verbs:     []string{"get", "list"},
+		Resources: []string{"objects/*", "schema/*"},
+	}}}
+
+	tests := []struct {
+		name        string
+		cfg         Config
+		expectedErr string
+	}{
+		{
+			name: "valid roles and assignments",
+			cfg: Config{
+				Enabled: true,
+				Roles:   map[string]Role{"reader": reader},
+				Users:   map[string][]string{"alice": {"reader"}},
+				Groups:  map[string][]string{"team-a": {"reader"}},
+			},
+		},
+		{
+			name: "role without permissions",
+			cfg: Config{
+				Enabled: true,
+				Roles:   map[string]Role{"empty": {}},
+			},
+			expectedErr: "rbac: role 'empty': no permissions set",
+		},
+		{
+			name: "unknown verb",
+			cfg: Config{
+				Enabled: true,
+				Roles: map[string]Role{"writer": {Permissions: []Permission{{
+					Verbs:     []string{"write"},
+					Resources: []string{"objects/*"},
+				}}}},
+			},
+			expectedErr: "rbac: role 'writer': permission 0: unknown verb 'write'",
+		},
+		{
+			name: "permission without resources",
+			cfg: Config{
+				Enabled: true,
+				Roles: map[string]Role{"writer": {Permissions: []Permission{{
+					Verbs: []string{"*"},
+				}}}},
+			},
+			expectedErr: "rbac: role 'writer': permission 0: no resources set",
+		},
+		{
+			name: "wildcard as part of a name",
+			cfg: Config{
+				Enabled: true,
+				Roles: map[string]Role{"writer": {Permissions: []Permission{{
+					Verbs:     []string{"*"},
+					Resources: []string{"objects/Article*"},
+				}}}},
+			},
+			expectedErr: "rbac: role 'writer': permission 0: resource pattern 'objects/Article*': " +
+				"'*' has to be a whole path segment",
+		},
+		{
+			name: "user with unknown role",
+			cfg: Config{
+				Enabled: true,
+				Roles:   map[string]Role{"reader": reader},
+				Users:   map[string][]string{"alice": {"admin"}},
+			},
+			expectedErr: "rbac: user 'alice' is assigned unknown role 'admin'",
+		},
+		{
+			name: "group with unknown role",
+			cfg: Config{
+				Enabled: true,
+				Roles:   map[string]Role{"reader": reader},
+				Groups:  map[string][]string{"team-a": {"writer"}},
+			},
+			expectedErr: "rbac: group 'team-a' is assigned unknown role 'writer'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// Objects is the resource of objects. With an empty class it is the legacy
+// resource which is not scoped to a class ("objects" or "objects/{id}"). The
+// tenant is part of the resource, so that access can be granted to single
+// tenants of a class:
+//
+//	objects/{class}
+//	objects/{class}/{id}
+//	objects/{class}/tenants/{tenant}
+//	objects/{class}/tenants/{tenant}/{id}
+func Objects(class, tenant string, id strfmt.UUID) string {
+	if class == "" {
+		if id == "" {
+			return "objects"
+		}
+		return fmt.Sprintf("objects/%s", id)
+	}
+
+	resource := fmt.Sprintf("objects/%s", class)
+	if tenant != "" {
+		resource = fmt.Sprintf("%s/tenants/%s", resource, tenant)
+	}
+	if id != "" {
+		resource = fmt.Sprintf("%s/%s", resource, id)
+	}
+	return resource
+}
+
+// Schema is the resource of the schema of a single class, with an empty
+// class it is the resource of the whole schema
+func Schema(class string) string {
+	if class == "" {
+		return "schema/objects"
+	}
+	return fmt.Sprintf("schema/%s", class)
+}
+
+// SchemaTenants is the resource of the tenants of a class
+func SchemaTenants(class string) string {
+	return fmt.Sprintf("schema/%s/tenants", class)
+}
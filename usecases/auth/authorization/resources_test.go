@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Resources(t *testing.T) {
+	t.Run("objects", func(t *testing.T) {
+		assert.Equal(t, "objects", Objects("", "", ""))
+		assert.Equal(t, "objects/123", Objects("", "", "123"))
+		assert.Equal(t, "objects/123", Objects("", "acme", "123"))
+		assert.Equal(t, "objects/Article", Objects("Article", "", ""))
+		assert.Equal(t, "objects/Article/123", Objects("Article", "", "123"))
+		assert.Equal(t, "objects/Article/tenants/acme", Objects("Article", "acme", ""))
+		assert.Equal(t, "objects/Article/tenants/acme/123", Objects("Article", "acme", "123"))
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		assert.Equal(t, "schema/objects", Schema(""))
+		assert.Equal(t, "schema/Article", Schema("Article"))
+		assert.Equal(t, "schema/Article/tenants", SchemaTenants("Article"))
+	})
+}
@@ -61,6 +61,24 @@ func Test_Authorization(t *testing.T) {
 			expectedVerb:     "get",
 			expectedResource: "backups/s3/123/restore",
 		},
+		{
+			methodName:       "List",
+			additionalArgs:   []interface{}{"s3"},
+			expectedVerb:     "get",
+			expectedResource: "backups/s3",
+		},
+		{
+			methodName:       "Cancel",
+			additionalArgs:   []interface{}{"s3", "123"},
+			expectedVerb:     "delete",
+			expectedResource: "backups/s3/123",
+		},
+		{
+			methodName:       "Delete",
+			additionalArgs:   []interface{}{"s3", "123"},
+			expectedVerb:     "delete",
+			expectedResource: "backups/s3/123",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -71,7 +89,7 @@ func Test_Authorization(t *testing.T) {
 
 		for _, method := range allExportedMethods(&Scheduler{}) {
 			switch method {
-			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus":
+			case "OnCommit", "OnAbort", "OnCanCommit", "OnStatus", "OnCancel":
 				continue
 			}
 			assert.Contains(t, testedMethods, method)
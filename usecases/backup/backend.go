@@ -18,6 +18,7 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -44,6 +45,8 @@ const (
 	GlobalBackupFile  = "backup_config.json"
 	GlobalRestoreFile = "restore_config.json"
 	_TempDirectory    = ".backup.tmp"
+	// _ChunkDirectory holds compressed chunks before they are uploaded
+	_ChunkDirectory = ".backup.chunks"
 )
 
 var _NUMCPU = runtime.NumCPU()
@@ -152,24 +155,73 @@ func (s *coordStore) Meta(ctx context.Context, filename string) (*backup.Distrib
 
 // uploader uploads backup artifacts. This includes db files and metadata
 type uploader struct {
-	sourcer   Sourcer
-	backend   nodeStore
-	backupID  string
+	sourcer     Sourcer
+	backend     nodeStore
+	backupID    string
+	compression Compression
+	// baseID and base identify the base backup if the backup is incremental
+	baseID    string
+	base      *nodeStore
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
-	backupID string, setstaus func(st backup.Status), l logrus.FieldLogger,
+	backupID string, compression Compression, baseID string, base *nodeStore,
+	setstaus func(st backup.Status), l logrus.FieldLogger,
 ) *uploader {
-	return &uploader{sourcer, backend, backupID, setstaus, l}
+	return &uploader{sourcer, backend, backupID, compression.withDefaults(), baseID, base, setstaus, l}
+}
+
+// baseShards returns the shards contained in the base backup grouped by class.
+// A nil map is returned if the backup is not incremental or if this node was not part of the base.
+func (u *uploader) baseShards(ctx context.Context) (map[string]map[string]*backup.ShardDescriptor, error) {
+	if u.base == nil {
+		return nil, nil
+	}
+	meta, err := u.base.Meta(ctx, u.baseID, false)
+	if err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			u.log.WithField("base_backup_id", u.baseID).
+				Info("node not part of base backup, uploading all files")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("base backup %q: %w", u.baseID, err)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("base backup %q has status %q", u.baseID, meta.Status)
+	}
+	shards := make(map[string]map[string]*backup.ShardDescriptor, len(meta.Classes))
+	for i := range meta.Classes {
+		class := &meta.Classes[i]
+		shards[class.Name] = make(map[string]*backup.ShardDescriptor, len(class.Shards))
+		for j := range class.Shards {
+			shards[class.Name][class.Shards[j].Name] = &class.Shards[j]
+		}
+	}
+	return shards, nil
 }
 
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor) (err error) {
 	u.setStatus(backup.Transferring)
 	desc.Status = string(backup.Transferring)
+	base, err := u.baseShards(ctx)
+	if err != nil {
+		desc.Error = err.Error()
+		return fmt.Errorf("upload %w: %v", err, u.backend.PutMeta(context.Background(), desc))
+	}
 	ch := u.sourcer.BackupDescriptors(ctx, desc.ID, classes)
+	defer func() {
+		// classes which haven't been uploaded because of an error still need to be released
+		go func() {
+			for cdesc := range ch {
+				if cdesc.Error == nil {
+					u.sourcer.ReleaseBackup(context.Background(), desc.ID, cdesc.Name)
+				}
+			}
+		}()
+	}()
 	defer func() {
 		//  make sure context is not cancelled when uploading metadata
 		ctx := context.Background()
@@ -196,7 +248,7 @@ Loop:
 				return cdesc.Error
 			}
 			u.log.WithField("class", cdesc.Name).Info("start uploading files")
-			if err := u.class(ctx, desc.ID, cdesc); err != nil {
+			if err := u.class(ctx, desc.ID, cdesc, base[cdesc.Name]); err != nil {
 				return err
 			}
 			desc.Classes = append(desc.Classes, cdesc)
@@ -212,7 +264,11 @@ Loop:
 }
 
 // class uploads one class
-func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescriptor) (err error) {
+//
+// base contains the shards of this class in the base backup of an incremental backup
+func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescriptor,
+	base map[string]*backup.ShardDescriptor,
+) (err error) {
 	metric, err := monitoring.GetMetrics().BackupStoreDurations.GetMetricWithLabelValues(getType(u.backend.b), desc.Name)
 	if err == nil {
 		timer := prometheus.NewTimer(metric)
@@ -225,27 +281,69 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
 	defer cancel()
 
+	srcDir := u.backend.SourceDataPath()
+	defer os.RemoveAll(path.Join(srcDir, _ChunkDirectory, id))
+
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.SetLimit(2 * _NUMCPU)
 
-	for _, shard := range desc.Shards {
-		shard := shard
+	for i := range desc.Shards {
+		shard := &desc.Shards[i]
 		eg.Go(func() error {
 			if err := ctx.Err(); err != nil {
 				return err
 			}
-			for _, fpath := range shard.Files {
-				if err := u.backend.PutFile(ctx, fpath, fpath); err != nil {
-					return fmt.Errorf("put shard: %q: %w", shard.Name, err)
-				}
+			if err := u.shard(ctx, srcDir, id, desc.Name, shard, base[shard.Name]); err != nil {
+				return fmt.Errorf("put shard: %q: %w", shard.Name, err)
 			}
-
 			return nil
 		})
 	}
 	return eg.Wait()
 }
 
+// shard packs the files of one shard into compressed chunks and uploads them.
+// The chunks are recorded in the shard descriptor.
+//
+// If base is not nil, files which haven't changed since the base backup
+// are not uploaded again. Instead, the chunks of the base containing them are referenced.
+func (u *uploader) shard(ctx context.Context, srcDir, id, class string,
+	desc, base *backup.ShardDescriptor,
+) (err error) {
+	if desc.FileInfos, err = fileInfos(srcDir, desc.Files); err != nil {
+		return err
+	}
+	files, reused := unchangedFiles(desc, base, u.baseID)
+	groups := splitChunks(files, desc.FileInfos, int64(u.compression.ChunkSize)<<20)
+	desc.Compression = u.compression.Algorithm
+	desc.Chunks = make([]backup.ChunkDescriptor, 0, len(groups)+len(reused))
+	for i, files := range groups {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("chunks/%s/%s/%d%s", class, desc.Name, i, u.compression.extension())
+		// PutFile expects a path relative to the data path
+		chunkPath := path.Join(_ChunkDirectory, id, key)
+		size, checksum, err := writeChunk(u.compression, srcDir, files, path.Join(srcDir, chunkPath))
+		if err != nil {
+			return fmt.Errorf("write chunk %s: %w", key, err)
+		}
+		err = u.backend.PutFile(ctx, key, chunkPath)
+		os.Remove(path.Join(srcDir, chunkPath))
+		if err != nil {
+			return fmt.Errorf("put chunk %s: %w", key, err)
+		}
+		desc.Chunks = append(desc.Chunks, backup.ChunkDescriptor{
+			Key:      key,
+			Files:    files,
+			Size:     size,
+			Checksum: checksum,
+		})
+	}
+	desc.Chunks = append(desc.Chunks, reused...)
+	return nil
+}
+
 // fileWriter downloads files from object store and writes files to the destination folder destDir
 type fileWriter struct {
 	sourcer    Sourcer
@@ -253,6 +351,9 @@ type fileWriter struct {
 	tempDir    string
 	destDir    string
 	movedFiles []string // files successfully moved to destination folder
+	// className is set if the class is restored under a new name.
+	// Files are renamed accordingly when they are moved to the destination folder.
+	className string
 }
 
 func newFileWriter(sourcer Sourcer, backend nodeStore,
@@ -281,12 +382,13 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor) (
 			}
 		}
 		os.RemoveAll(classTempDir)
+		os.RemoveAll(chunkDir(classTempDir))
 	}()
 
 	if err := fw.writeTempFiles(ctx, classTempDir, desc); err != nil {
 		return nil, fmt.Errorf("get files: %w", err)
 	}
-	if err := fw.moveAll(classTempDir); err != nil {
+	if err := fw.moveAll(classTempDir, desc.Name); err != nil {
 		return nil, fmt.Errorf("move files to destination: %w", err)
 	}
 	return func() error { return fw.rollBack(classTempDir) }, nil
@@ -313,14 +415,21 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 }
 
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd backup.ShardDescriptor, classTempDir string) error {
-	for _, key := range sd.Files {
-		destPath := path.Join(classTempDir, key)
-		destDir := path.Dir(destPath)
-		if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
-			return fmt.Errorf("create folder %s: %w", destDir, err)
+	if len(sd.Chunks) > 0 {
+		if err := fw.writeTempChunks(ctx, sd, classTempDir); err != nil {
+			return err
 		}
-		if err := fw.backend.WriteToFile(ctx, key, destPath); err != nil {
-			return fmt.Errorf("write file %s: %w", destPath, err)
+	} else {
+		// backups created before compression was introduced contain single files
+		for _, key := range sd.Files {
+			destPath := path.Join(classTempDir, key)
+			destDir := path.Dir(destPath)
+			if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
+				return fmt.Errorf("create folder %s: %w", destDir, err)
+			}
+			if err := fw.backend.WriteToFile(ctx, key, destPath); err != nil {
+				return fmt.Errorf("write file %s: %w", destPath, err)
+			}
 		}
 	}
 	destPath := path.Join(classTempDir, sd.DocIDCounterPath)
@@ -338,16 +447,70 @@ func (fw *fileWriter) writeTempShard(ctx context.Context, sd backup.ShardDescrip
 	return nil
 }
 
+// writeTempChunks downloads the chunks of a shard, verifies their checksums
+// and extracts them into classTempDir
+func (fw *fileWriter) writeTempChunks(ctx context.Context, sd backup.ShardDescriptor, classTempDir string) error {
+	for _, chunk := range sd.Chunks {
+		store, algorithm := fw.backend.objStore, sd.Compression
+		if chunk.BackupID != "" { // chunk is stored in a base backup
+			store = objStore{b: fw.backend.b, BasePath: fmt.Sprintf("%s/%s", chunk.BackupID, sd.Node)}
+		}
+		if chunk.Compression != "" {
+			algorithm = chunk.Compression
+		}
+		chunkPath := path.Join(chunkDir(classTempDir), chunk.BackupID, chunk.Key)
+		if err := os.MkdirAll(path.Dir(chunkPath), os.ModePerm); err != nil {
+			return fmt.Errorf("create folder %s: %w", path.Dir(chunkPath), err)
+		}
+		if err := store.WriteToFile(ctx, chunk.Key, chunkPath); err != nil {
+			return fmt.Errorf("write chunk %s: %w", chunkPath, err)
+		}
+		if err := verifyChunk(chunkPath, chunk.Checksum); err != nil {
+			return err
+		}
+		if err := extractChunk(algorithm, chunkPath, classTempDir, chunk.Files); err != nil {
+			return fmt.Errorf("extract chunk %s: %w", chunk.Key, err)
+		}
+		if err := os.Remove(chunkPath); err != nil {
+			return fmt.Errorf("remove chunk %s: %w", chunkPath, err)
+		}
+	}
+	for _, key := range sd.Files {
+		if _, err := os.Stat(path.Join(classTempDir, key)); err != nil {
+			return fmt.Errorf("file %s missing from chunks: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// chunkDir is the folder where downloaded chunks of a class are stored before extraction.
+// It must not be inside classTempDir since all files in classTempDir are moved to the destination.
+func chunkDir(classTempDir string) string {
+	return classTempDir + _ChunkDirectory
+}
+
 // moveAll moves all files to the destination
-func (fw *fileWriter) moveAll(classTempDir string) (err error) {
+//
+// All files of a class are prefixed by its lower case name. The prefix is replaced
+// if the class is restored under a different name.
+func (fw *fileWriter) moveAll(classTempDir, className string) (err error) {
 	files, err := os.ReadDir(classTempDir)
 	if err != nil {
 		return fmt.Errorf("read %s", classTempDir)
 	}
 	destDir := fw.destDir
+	oldPrefix := strings.ToLower(className) + "_"
+	newPrefix := oldPrefix
+	if fw.className != "" {
+		newPrefix = strings.ToLower(fw.className) + "_"
+	}
 	for _, key := range files {
 		from := path.Join(classTempDir, key.Name())
-		to := path.Join(destDir, key.Name())
+		name := key.Name()
+		if strings.HasPrefix(name, oldPrefix) {
+			name = newPrefix + strings.TrimPrefix(name, oldPrefix)
+		}
+		to := path.Join(destDir, name)
 		if err := os.Rename(from, to); err != nil {
 			return fmt.Errorf("move %s %s: %w", from, to, err)
 		}
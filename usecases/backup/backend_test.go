@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestIncrementalBackup(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		any       = mock.Anything
+		dataDir   = t.TempDir()
+		bucket    = t.TempDir()
+		logger, _ = test.NewNullLogger()
+		backend   = &fakeBackend{}
+	)
+	copyFile := func(src, dst string) {
+		require.Nil(t, os.MkdirAll(path.Dir(dst), os.ModePerm))
+		in, err := os.Open(src)
+		require.Nil(t, err)
+		defer in.Close()
+		out, err := os.Create(dst)
+		require.Nil(t, err)
+		defer out.Close()
+		_, err = io.Copy(out, in)
+		require.Nil(t, err)
+	}
+	writeFile := func(file, content string) {
+		fpath := path.Join(dataDir, file)
+		require.Nil(t, os.MkdirAll(path.Dir(fpath), os.ModePerm))
+		require.Nil(t, os.WriteFile(fpath, []byte(content), os.ModePerm))
+	}
+	backend.On("SourceDataPath").Return(dataDir)
+	backend.On("PutFile", any, any, any, any).Return(nil).Run(func(args mock.Arguments) {
+		copyFile(path.Join(dataDir, args.String(3)), path.Join(bucket, args.String(1), args.String(2)))
+	})
+	backend.On("WriteToFile", any, any, any, any).Return(nil).Run(func(args mock.Arguments) {
+		copyFile(path.Join(bucket, args.String(1), args.String(2)), args.String(3))
+	})
+	newShard := func(files ...string) *backup.ShardDescriptor {
+		return &backup.ShardDescriptor{
+			Name: "shard", Node: nodeName, Files: files,
+			DocIDCounterPath: "shard/counter", DocIDCounter: []byte("1"),
+			PropLengthTrackerPath: "shard/proplengths", PropLengthTracker: []byte("2"),
+			ShardVersionPath: "shard/version", Version: []byte("3"),
+		}
+	}
+	storeOf := func(id string) nodeStore {
+		return nodeStore{objStore{b: backend, BasePath: id + "/" + nodeName}}
+	}
+
+	// full backup
+	writeFile("shard/segment-1.db", "segment-1")
+	writeFile("shard/segment-2.db", "segment-2")
+	full := newShard("shard/segment-1.db", "shard/segment-2.db")
+	u := newUploader(nil, storeOf("full"), "full", Compression{}, "", nil, nil, logger)
+	require.Nil(t, u.shard(ctx, dataDir, "full", "Article", full, nil))
+	require.Len(t, full.Chunks, 1)
+
+	// the second segment is replaced by a compacted one and a new segment is written
+	require.Nil(t, os.Remove(path.Join(dataDir, "shard/segment-2.db")))
+	writeFile("shard/segment-2_3.db", "segment-2_3")
+	writeFile("shard/segment-4.db", "segment-4")
+
+	base := storeOf("full")
+	incr := newShard("shard/segment-1.db", "shard/segment-2_3.db", "shard/segment-4.db")
+	u = newUploader(nil, storeOf("incr"), "incr", Compression{Algorithm: CompressionZSTD}, "full", &base, nil, logger)
+	require.Nil(t, u.shard(ctx, dataDir, "incr", "Article", incr, full))
+	require.Len(t, incr.Chunks, 2)
+	assert.Equal(t, []string{"shard/segment-2_3.db", "shard/segment-4.db"}, incr.Chunks[0].Files)
+	assert.Equal(t, "", incr.Chunks[0].BackupID)
+	assert.Equal(t, []string{"shard/segment-1.db"}, incr.Chunks[1].Files)
+	assert.Equal(t, "full", incr.Chunks[1].BackupID)
+	assert.Equal(t, CompressionGZIP, incr.Chunks[1].Compression)
+
+	// the segment written after the incremental backup is the only file uploaded again
+	writeFile("shard/segment-5.db", "segment-5")
+	incr2 := newShard(append(incr.Files, "shard/segment-5.db")...)
+	base = storeOf("incr")
+	u = newUploader(nil, storeOf("incr2"), "incr2", Compression{}, "incr", &base, nil, logger)
+	require.Nil(t, u.shard(ctx, dataDir, "incr2", "Article", incr2, incr))
+	require.Len(t, incr2.Chunks, 3)
+	assert.Equal(t, []string{"shard/segment-5.db"}, incr2.Chunks[0].Files)
+	assert.Equal(t, "incr", incr2.Chunks[1].BackupID)
+	assert.Equal(t, "full", incr2.Chunks[2].BackupID)
+
+	// restoring resolves the whole chain
+	fw := newFileWriter(nil, storeOf("incr2"), "incr2")
+	classTempDir := t.TempDir()
+	require.Nil(t, fw.writeTempShard(ctx, *incr2, classTempDir))
+	for _, file := range incr2.Files {
+		want, err := os.ReadFile(path.Join(dataDir, file))
+		require.Nil(t, err)
+		got, err := os.ReadFile(path.Join(classTempDir, file))
+		require.Nil(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := os.Stat(path.Join(classTempDir, "shard/segment-2.db"))
+	assert.True(t, os.IsNotExist(err))
+}
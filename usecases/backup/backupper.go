@@ -45,15 +45,10 @@ func newBackupper(node string, logger logrus.FieldLogger, sourcer Sourcer, backe
 
 // Backup is called by the User
 func (b *backupper) Backup(ctx context.Context,
-	store nodeStore, id string, classes []string,
+	store nodeStore, req *Request,
 ) (*backup.CreateMeta, error) {
 	// make sure there is no active backup
-	req := Request{
-		Method:  OpCreate,
-		ID:      id,
-		Classes: classes,
-	}
-	if _, err := b.backup(ctx, store, &req); err != nil {
+	if _, err := b.backup(ctx, store, req); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
@@ -150,10 +145,16 @@ func (b *backupper) backup(ctx context.Context,
 			return
 
 		}
-		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger)
+		var base *nodeStore
+		if req.BaseBackupID != "" {
+			base = &nodeStore{objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", req.BaseBackupID, b.node)}}
+		}
+		provider := newUploader(b.sourcer, store, req.ID, req.Compression,
+			req.BaseBackupID, base, b.lastOp.set, b.logger)
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
 			ID:            id,
+			BaseBackupID:  req.BaseBackupID,
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
@@ -169,6 +170,13 @@ func (b *backupper) backup(ctx context.Context,
 				Error(err)
 			b.lastAsyncError = err
 
+			if ctx.Err() != nil {
+				// the coordinator aborted the backup, partial uploads are useless
+				if err := store.b.DeleteBackup(context.Background(), store.BasePath); err != nil {
+					b.logger.WithField("action", "create_backup").
+						WithField("backup_id", id).Errorf("delete partial backup: %v", err)
+				}
+			}
 		}
 		result.CompletedAt = time.Now().UTC()
 	}()
@@ -15,12 +15,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -415,6 +418,7 @@ func TestManagerCreateBackup(t *testing.T) {
 
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", any, nodeHome, BackupFile, mock.Anything).Return(nil).Twice()
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", any, nodeHome, mock.Anything, mock.Anything).Return(nil)
 		m := createManager(sourcer, nil, backend, nil)
 
@@ -440,6 +444,12 @@ func TestManagerCreateBackup(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, backend.meta.Status, string(backup.Success))
 		assert.Equal(t, backend.meta.Error, "")
+		require.Len(t, backend.meta.Classes, 2)
+		shard := backend.meta.Classes[0].Shards[0]
+		assert.Equal(t, CompressionGZIP, shard.Compression)
+		require.Len(t, shard.Chunks, 1)
+		assert.Equal(t, shard.Files, shard.Chunks[0].Files)
+		assert.NotEmpty(t, shard.Chunks[0].Checksum)
 	})
 
 	t.Run("PutFile", func(t *testing.T) {
@@ -455,6 +465,7 @@ func TestManagerCreateBackup(t *testing.T) {
 		backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.NewErrNotFound(errors.New("not found")))
 
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", any, nodeHome, any, any).Return(ErrAny).Once()
 		backend.On("PutObject", any, nodeHome, BackupFile, any).Return(nil).Once()
 		m := createManager(sourcer, nil, backend, nil)
@@ -497,6 +508,7 @@ func TestManagerCreateBackup(t *testing.T) {
 		backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.NewErrNotFound(errors.New("not found")))
 
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
 		m := createManager(sourcer, nil, backend, nil)
@@ -618,6 +630,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(nil, backup.NewErrNotFound(errors.New("not found")))
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
 		m := createManager(sourcer, nil, backend, nil)
 
@@ -652,6 +665,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(nil, backup.NewErrNotFound(errors.New("not found")))
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
 		m := createManager(sourcer, nil, backend, nil)
 
@@ -691,7 +705,9 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(nil, backup.NewErrNotFound(errors.New("not found")))
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
+		backend.On("DeleteBackup", mock.Anything, nodeHome).Return(nil)
 
 		req := req
 		req.Duration = time.Hour
@@ -712,6 +728,8 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		assert.Equal(t, string(backup.Transferring), backend.meta.Status)
 		assert.Equal(t, errMsg, backend.meta.Error)
 		assert.Contains(t, m.backupper.lastAsyncError.Error(), errMsg)
+		// partial uploads are deleted
+		backend.AssertCalled(t, "DeleteBackup", mock.Anything, nodeHome)
 	})
 
 	t.Run("ExpirationTimeout", func(t *testing.T) {
@@ -726,6 +744,7 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(nil, backup.NewErrNotFound(errors.New("not found")))
 		backend.On("Initialize", ctx, nodeHome).Return(nil)
 		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
+		backend.On("SourceDataPath").Return(fakeDataPath(t))
 		backend.On("PutFile", mock.Anything, backupID, mock.Anything, mock.Anything).Return(nil)
 		m := createManager(sourcer, nil, backend, nil)
 
@@ -769,6 +788,17 @@ func genClassDescriptions(classes ...string) []backup.ClassDescriptor {
 	return ret
 }
 
+// fakeDataPath creates the shard files listed by genClassDescriptions
+func fakeDataPath(t *testing.T) string {
+	dir := t.TempDir()
+	for _, fpath := range []string{"dir1/file1", "dir2/file2"} {
+		fpath = path.Join(dir, fpath)
+		require.Nil(t, os.MkdirAll(path.Dir(fpath), os.ModePerm))
+		require.Nil(t, os.WriteFile(fpath, []byte(fpath), os.ModePerm))
+	}
+	return dir
+}
+
 func fakeBackupDescriptor(descs ...backup.ClassDescriptor) <-chan backup.ClassDescriptor {
 	ch := make(chan backup.ClassDescriptor, len(descs))
 	go func() {
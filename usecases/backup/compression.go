@@ -0,0 +1,355 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+const (
+	// CompressionGZIP packs shard files into gzip compressed tar archives
+	CompressionGZIP = "gzip"
+	// CompressionZSTD packs shard files into zstd compressed tar archives
+	CompressionZSTD = "zstd"
+
+	// DefaultChunkSize is the default size of uncompressed data in MB packed into one chunk
+	DefaultChunkSize = 128
+	minChunkSize     = 1
+	maxChunkSize     = 512
+)
+
+// Compression configures how shard files are packed into compressed archive chunks.
+// Zero values are replaced by defaults.
+type Compression struct {
+	// Algorithm is either gzip (default) or zstd
+	Algorithm string `json:"compression,omitempty"`
+	// Level of the compression algorithm: 1-9 for gzip and 1-22 for zstd,
+	// 0 selects the default level of the algorithm
+	Level int `json:"compressionLevel,omitempty"`
+	// ChunkSize is the amount of uncompressed data in MB packed into one chunk.
+	// A file larger than ChunkSize is stored in a chunk of its own.
+	ChunkSize int `json:"chunkSize,omitempty"`
+}
+
+// Validate checks that the algorithm is supported and level and chunk size are within range
+func (c Compression) Validate() error {
+	maxLevel := gzip.BestCompression
+	switch c.Algorithm {
+	case "", CompressionGZIP:
+	case CompressionZSTD:
+		maxLevel = 22
+	default:
+		return fmt.Errorf("invalid compression %q: must be one of [%s %s]",
+			c.Algorithm, CompressionGZIP, CompressionZSTD)
+	}
+	if c.Level < 0 || c.Level > maxLevel {
+		return fmt.Errorf("invalid compression level %d: must be between 1 and %d, or 0 for the default",
+			c.Level, maxLevel)
+	}
+	if c.ChunkSize != 0 && (c.ChunkSize < minChunkSize || c.ChunkSize > maxChunkSize) {
+		return fmt.Errorf("invalid chunk size %d: must be between %d and %d",
+			c.ChunkSize, minChunkSize, maxChunkSize)
+	}
+	return nil
+}
+
+// withDefaults returns a copy of c where zero values are replaced by defaults
+func (c Compression) withDefaults() Compression {
+	if c.Algorithm == "" {
+		c.Algorithm = CompressionGZIP
+	}
+	if c.ChunkSize == 0 {
+		c.ChunkSize = DefaultChunkSize
+	}
+	return c
+}
+
+// extension returns the file extension of a chunk
+func (c Compression) extension() string {
+	if c.Algorithm == CompressionZSTD {
+		return ".tar.zst"
+	}
+	return ".tar.gz"
+}
+
+func (c Compression) newWriter(w io.Writer) (io.WriteCloser, error) {
+	if c.Algorithm == CompressionZSTD {
+		level := zstd.SpeedDefault
+		if c.Level > 0 {
+			level = zstd.EncoderLevelFromZstd(c.Level)
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(level))
+	}
+	level := gzip.DefaultCompression
+	if c.Level > 0 {
+		level = c.Level
+	}
+	return gzip.NewWriterLevel(w, level)
+}
+
+func newDecompressor(algorithm string, r io.Reader) (io.ReadCloser, error) {
+	switch algorithm {
+	case CompressionZSTD:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	case CompressionGZIP:
+		return gzip.NewReader(r)
+	default:
+		return nil, fmt.Errorf("unknown compression %q", algorithm)
+	}
+}
+
+// splitChunks groups files so that the uncompressed size of every group
+// does not exceed chunkSize bytes, unless a group consists of a single file.
+func splitChunks(files []string, infos map[string]backup.FileInfo, chunkSize int64) [][]string {
+	var (
+		chunks  [][]string
+		current []string
+		size    int64
+	)
+	for _, file := range files {
+		fsize := infos[file].Size
+		if len(current) > 0 && size+fsize > chunkSize {
+			chunks = append(chunks, current)
+			current, size = nil, 0
+		}
+		current = append(current, file)
+		size += fsize
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// fileInfos returns the size and modification time of files relative to srcDir
+func fileInfos(srcDir string, files []string) (map[string]backup.FileInfo, error) {
+	infos := make(map[string]backup.FileInfo, len(files))
+	for _, file := range files {
+		info, err := os.Stat(path.Join(srcDir, file))
+		if err != nil {
+			return nil, fmt.Errorf("stat %s: %w", file, err)
+		}
+		infos[file] = backup.FileInfo{Size: info.Size(), ModTime: info.ModTime().UnixNano()}
+	}
+	return infos, nil
+}
+
+// unchangedFiles compares the files of a shard with the same shard in a base backup.
+// It returns the files which need to be uploaded and the chunks of the base backup
+// containing the remaining files. A file is unchanged if neither its size nor its
+// modification time have changed.
+func unchangedFiles(desc, base *backup.ShardDescriptor, baseID string) ([]string, []backup.ChunkDescriptor) {
+	if base == nil {
+		return desc.Files, nil
+	}
+	location := make(map[string]int, len(base.Files))
+	for i, chunk := range base.Chunks {
+		for _, file := range chunk.Files {
+			location[file] = i
+		}
+	}
+	var (
+		upload []string
+		reused = make(map[int][]string, len(base.Chunks))
+	)
+	for _, file := range desc.Files {
+		i, ok := location[file]
+		if info, found := base.FileInfos[file]; ok && found && info == desc.FileInfos[file] {
+			reused[i] = append(reused[i], file)
+			continue
+		}
+		upload = append(upload, file)
+	}
+
+	chunks := make([]backup.ChunkDescriptor, 0, len(reused))
+	for i, chunk := range base.Chunks {
+		files, ok := reused[i]
+		if !ok {
+			continue
+		}
+		chunk.Files = files
+		// chunks referenced by the base itself are stored in an earlier backup of the chain
+		if chunk.BackupID == "" {
+			chunk.BackupID = baseID
+		}
+		if chunk.Compression == "" {
+			chunk.Compression = base.Compression
+		}
+		chunks = append(chunks, chunk)
+	}
+	return upload, chunks
+}
+
+// writeChunk packs files relative to srcDir into a compressed tar archive at destPath.
+// It returns the size and the hex encoded sha256 checksum of the archive.
+func writeChunk(c Compression, srcDir string, files []string, destPath string) (_ int64, _ string, err error) {
+	if err := os.MkdirAll(path.Dir(destPath), os.ModePerm); err != nil {
+		return 0, "", fmt.Errorf("create folder %s: %w", path.Dir(destPath), err)
+	}
+	f, err := os.Create(destPath)
+	if err != nil {
+		return 0, "", fmt.Errorf("create chunk %s: %w", destPath, err)
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close chunk %s: %w", destPath, cerr)
+		}
+	}()
+
+	hash := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(f, hash)}
+	zw, err := c.newWriter(counter)
+	if err != nil {
+		return 0, "", fmt.Errorf("create compressor: %w", err)
+	}
+	tw := tar.NewWriter(zw)
+	for _, file := range files {
+		if err := addToArchive(tw, srcDir, file); err != nil {
+			return 0, "", err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return 0, "", fmt.Errorf("close archive: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return 0, "", fmt.Errorf("close compressor: %w", err)
+	}
+	return counter.n, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func addToArchive(tw *tar.Writer, srcDir, file string) error {
+	f, err := os.Open(path.Join(srcDir, file))
+	if err != nil {
+		return fmt.Errorf("open %s: %w", file, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", file, err)
+	}
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return fmt.Errorf("archive header %s: %w", file, err)
+	}
+	header.Name = file
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("write archive header %s: %w", file, err)
+	}
+	// only copy the size recorded in the header since the file might still grow
+	if _, err := io.CopyN(tw, f, header.Size); err != nil {
+		return fmt.Errorf("archive %s: %w", file, err)
+	}
+	return nil
+}
+
+// verifyChunk makes sure the sha256 checksum of the file at srcPath matches checksum
+func verifyChunk(srcPath, checksum string) error {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("open chunk %s: %w", srcPath, err)
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return fmt.Errorf("read chunk %s: %w", srcPath, err)
+	}
+	if got := hex.EncodeToString(hash.Sum(nil)); got != checksum {
+		return fmt.Errorf("checksum mismatch for chunk %s: want %s got %s", srcPath, checksum, got)
+	}
+	return nil
+}
+
+// extractChunk unpacks files from the compressed tar archive at srcPath into destDir.
+// Any other files contained in the archive are skipped.
+func extractChunk(algorithm, srcPath, destDir string, files []string) error {
+	wanted := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		wanted[path.Clean(file)] = struct{}{}
+	}
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("open chunk %s: %w", srcPath, err)
+	}
+	defer f.Close()
+	zr, err := newDecompressor(algorithm, f)
+	if err != nil {
+		return fmt.Errorf("create decompressor: %w", err)
+	}
+	defer zr.Close()
+
+	tr := tar.NewReader(zr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read archive %s: %w", srcPath, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := filepath.Clean(header.Name)
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid file path %q in archive %s", header.Name, srcPath)
+		}
+		if _, ok := wanted[name]; !ok {
+			continue
+		}
+		if err := extractFile(tr, path.Join(destDir, name), header); err != nil {
+			return err
+		}
+	}
+}
+
+func extractFile(r io.Reader, destPath string, header *tar.Header) error {
+	if err := os.MkdirAll(path.Dir(destPath), os.ModePerm); err != nil {
+		return fmt.Errorf("create folder %s: %w", path.Dir(destPath), err)
+	}
+	f, err := os.OpenFile(destPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode))
+	if err != nil {
+		return fmt.Errorf("create file %s: %w", destPath, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("write file %s: %w", destPath, err)
+	}
+	return f.Close()
+}
+
+// countingWriter counts the number of bytes written to w
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestSplitChunks(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]int{"a": 40, "b": 40, "c": 100, "d": 10}
+	for name, size := range files {
+		require.Nil(t, os.WriteFile(path.Join(dir, name), make([]byte, size), os.ModePerm))
+	}
+
+	infos, err := fileInfos(dir, []string{"a", "b", "c", "d"})
+	require.Nil(t, err)
+	chunks := splitChunks([]string{"a", "b", "c", "d"}, infos, 100)
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}, {"d"}}, chunks)
+
+	_, err = fileInfos(dir, []string{"missing"})
+	assert.NotNil(t, err)
+}
+
+func TestUnchangedFiles(t *testing.T) {
+	info := func(size int64) backup.FileInfo { return backup.FileInfo{Size: size, ModTime: 1} }
+	base := &backup.ShardDescriptor{
+		Files:       []string{"a", "b", "c", "d"},
+		Compression: CompressionZSTD,
+		Chunks: []backup.ChunkDescriptor{
+			{Key: "0", Files: []string{"a", "b"}, Checksum: "c0"},
+			{Key: "1", Files: []string{"c"}, Checksum: "c1", BackupID: "first", Compression: CompressionGZIP},
+			{Key: "2", Files: []string{"d"}, Checksum: "c2"},
+		},
+		FileInfos: map[string]backup.FileInfo{"a": info(1), "b": info(1), "c": info(1), "d": info(1)},
+	}
+
+	t.Run("full backup", func(t *testing.T) {
+		desc := &backup.ShardDescriptor{Files: []string{"a", "b"}}
+		upload, reused := unchangedFiles(desc, nil, "")
+		assert.Equal(t, desc.Files, upload)
+		assert.Empty(t, reused)
+	})
+
+	t.Run("incremental backup", func(t *testing.T) {
+		desc := &backup.ShardDescriptor{
+			Files:     []string{"a", "b", "c", "d", "e"},
+			FileInfos: map[string]backup.FileInfo{"a": info(1), "b": info(2), "c": info(1), "d": info(3), "e": info(1)},
+		}
+		upload, reused := unchangedFiles(desc, base, "second")
+		assert.Equal(t, []string{"b", "d", "e"}, upload)
+		want := []backup.ChunkDescriptor{
+			{Key: "0", Files: []string{"a"}, Checksum: "c0", BackupID: "second", Compression: CompressionZSTD},
+			{Key: "1", Files: []string{"c"}, Checksum: "c1", BackupID: "first", Compression: CompressionGZIP},
+		}
+		assert.Equal(t, want, reused)
+	})
+}
+
+func TestChunkRoundTrip(t *testing.T) {
+	for _, algorithm := range []string{CompressionGZIP, CompressionZSTD} {
+		t.Run(algorithm, func(t *testing.T) {
+			srcDir, destDir := t.TempDir(), t.TempDir()
+			files := []string{"shard/lsm/objects/segment-1.db", "shard/indexcount"}
+			for _, file := range files {
+				fpath := path.Join(srcDir, file)
+				require.Nil(t, os.MkdirAll(path.Dir(fpath), os.ModePerm))
+				require.Nil(t, os.WriteFile(fpath, []byte("content of "+file), os.ModePerm))
+			}
+
+			c := Compression{Algorithm: algorithm}.withDefaults()
+			chunkPath := path.Join(t.TempDir(), "chunk"+c.extension())
+			size, checksum, err := writeChunk(c, srcDir, files, chunkPath)
+			require.Nil(t, err)
+			info, err := os.Stat(chunkPath)
+			require.Nil(t, err)
+			assert.Equal(t, info.Size(), size)
+
+			require.Nil(t, verifyChunk(chunkPath, checksum))
+			require.Nil(t, extractChunk(algorithm, chunkPath, destDir, files))
+			for _, file := range files {
+				content, err := os.ReadFile(path.Join(destDir, file))
+				require.Nil(t, err)
+				assert.Equal(t, "content of "+file, string(content))
+			}
+		})
+	}
+
+	t.Run("corrupted chunk", func(t *testing.T) {
+		srcDir := t.TempDir()
+		require.Nil(t, os.WriteFile(path.Join(srcDir, "file"), []byte("content"), os.ModePerm))
+		chunkPath := path.Join(t.TempDir(), "chunk")
+		_, checksum, err := writeChunk(Compression{}.withDefaults(), srcDir, []string{"file"}, chunkPath)
+		require.Nil(t, err)
+
+		f, err := os.OpenFile(chunkPath, os.O_APPEND|os.O_WRONLY, os.ModePerm)
+		require.Nil(t, err)
+		_, err = f.Write([]byte("garbage"))
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		err = verifyChunk(chunkPath, checksum)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "checksum mismatch")
+	})
+}
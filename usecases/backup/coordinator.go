@@ -134,21 +134,30 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 	if prevID := c.lastOp.renew(req.ID, store.HomeDir()); prevID != "" {
 		return fmt.Errorf("backup %s already in progress", prevID)
 	}
+	// the backup can be cancelled by the user until it's completed
+	opCtx := c.lastOp.withCancel(req.ID)
 
 	c.descriptor = &backup.DistributedBackupDescriptor{
 		StartedAt:     time.Now().UTC(),
 		Status:        backup.Started,
 		ID:            req.ID,
+		BaseBackupID:  req.BaseBackupID,
 		Nodes:         groups,
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
+		Coordinator:   c.nodeResolver.LocalName(),
 	}
 
 	for key := range c.Participants {
 		delete(c.Participants, key)
 	}
 
-	nodes, err := c.canCommit(ctx, OpCreate, req.Backend)
+	nodes, err := c.canCommit(ctx, &Request{
+		Method:       OpCreate,
+		Backend:      req.Backend,
+		Compression:  req.Compression,
+		BaseBackupID: req.BaseBackupID,
+	})
 	if err != nil {
 		c.lastOp.reset()
 		return err
@@ -167,8 +176,11 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 
 	go func() {
 		defer c.lastOp.reset()
+		c.commit(opCtx, &statusReq, nodes, false)
 		ctx := context.Background()
-		c.commit(ctx, &statusReq, nodes, false)
+		if c.descriptor.Status == backup.Cancelled {
+			c.deleteNodeFiles(ctx, store, req.ID)
+		}
 		if err := store.PutMeta(ctx, GlobalBackupFile, c.descriptor); err != nil {
 			c.log.WithField("action", OpCreate).
 				WithField("backup_id", req.ID).Errorf("put_meta: %v", err)
@@ -178,8 +190,27 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 	return nil
 }
 
+// Cancel cancels the running operation with the given ID.
+// It returns false if no such operation is in progress
+func (c *coordinator) Cancel(id string) bool {
+	return c.lastOp.cancelOp(id)
+}
+
+// deleteNodeFiles deletes the files which have been uploaded by participants.
+// The global metadata file is kept so that the outcome of the backup remains known
+func (c *coordinator) deleteNodeFiles(ctx context.Context, store coordStore, id string) {
+	for node := range c.descriptor.Nodes {
+		if err := store.b.DeleteBackup(ctx, fmt.Sprintf("%s/%s", id, node)); err != nil {
+			c.log.WithField("action", OpCreate).
+				WithField("backup_id", id).
+				WithField("node", node).Errorf("delete node files: %v", err)
+		}
+	}
+}
+
 // Restore coordinates a distributed restoration among participants
-func (c *coordinator) Restore(ctx context.Context, store coordStore, backend string, desc *backup.DistributedBackupDescriptor) error {
+// Nodes of the backup are mapped onto nodes of this cluster according to req.NodeMapping
+func (c *coordinator) Restore(ctx context.Context, store coordStore, req *Request, desc *backup.DistributedBackupDescriptor) error {
 	// make sure there is no active backup
 	if prevID := c.lastOp.renew(desc.ID, store.HomeDir()); prevID != "" {
 		return fmt.Errorf("restoration %s already in progress", prevID)
@@ -190,7 +221,13 @@ func (c *coordinator) Restore(ctx context.Context, store coordStore, backend str
 	}
 	c.descriptor = desc.ResetStatus()
 
-	nodes, err := c.canCommit(ctx, OpRestore, backend)
+	backend := req.Backend
+	nodes, err := c.canCommit(ctx, &Request{
+		Method:       OpRestore,
+		Backend:      backend,
+		ClassMapping: req.ClassMapping,
+		NodeMapping:  req.NodeMapping,
+	})
 	if err != nil {
 		c.lastOp.reset()
 		return err
@@ -246,7 +283,10 @@ func (c *coordinator) OnStatus(ctx context.Context, store coordStore, req *Statu
 
 // canCommit asks candidates if they agree to participate in DBRO
 // It returns and error if any candidates refuses to participate
-func (c *coordinator) canCommit(ctx context.Context, method Op, backend string) (map[string]string, error) {
+//
+// Only method, backend and compression are taken from req,
+// the ID and the classes of each node come from the descriptor
+func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]string, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeoutCanCommit)
 	defer cancel()
 
@@ -274,19 +314,27 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 			default:
 			}
 
-			host, found := c.nodeResolver.NodeHostname(node)
+			target := node
+			if to, ok := req.NodeMapping[node]; ok {
+				target = to
+			}
+			host, found := c.nodeResolver.NodeHostname(target)
 			if !found {
-				return fmt.Errorf("cannot resolve hostname for %q", node)
+				return fmt.Errorf("cannot resolve hostname for %q", target)
 			}
 
 			reqChan <- pair{
 				nodeHost{node, host},
 				&Request{
-					Method:   method,
-					ID:       id,
-					Backend:  backend,
-					Classes:  gr.Classes,
-					Duration: _BookingPeriod,
+					Method:       req.Method,
+					ID:           id,
+					Backend:      req.Backend,
+					Classes:      gr.Classes,
+					Duration:     _BookingPeriod,
+					Compression:  req.Compression,
+					BaseBackupID: req.BaseBackupID,
+					ClassMapping: req.ClassMapping,
+					NodeMapping:  req.NodeMapping,
 				},
 			}
 		}
@@ -311,9 +359,8 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 			return nil
 		})
 	}
-	req := &AbortRequest{Method: method, ID: id, Backend: backend}
 	if err := g.Wait(); err != nil {
-		c.abortAll(ctx, req, nodes)
+		c.abortAll(ctx, &AbortRequest{Method: req.Method, ID: id, Backend: req.Backend}, nodes)
 		return nil, err
 	}
 	return nodes, nil
@@ -321,6 +368,8 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 
 // commit tells each participant to commit its backup operation
 // It stores the final result in the provided backend
+//
+// All participants are told to abort if ctx is cancelled before they are done
 func (c *coordinator) commit(ctx context.Context,
 	req *StatusRequest,
 	node2Addr map[string]string,
@@ -334,11 +383,18 @@ func (c *coordinator) commit(ctx context.Context,
 	nFailures := c.commitAll(ctx, req, node2Host)
 	retryAfter := c.timeoutNextRound / 5 // 2s for first time
 	canContinue := len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
-	for canContinue {
-		<-time.After(retryAfter)
-		retryAfter = c.timeoutNextRound
-		nFailures += c.queryAll(ctx, req, node2Host)
-		canContinue = len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
+	for canContinue && ctx.Err() == nil {
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryAfter):
+			retryAfter = c.timeoutNextRound
+			nFailures += c.queryAll(ctx, req, node2Host)
+			canContinue = len(node2Host) > 0 && (toleratePartialFailure || nFailures == 0)
+		}
+	}
+	if ctx.Err() != nil {
+		c.cancelAll(req, node2Addr)
+		return
 	}
 	if !toleratePartialFailure && nFailures > 0 {
 		req := &AbortRequest{Method: req.Method, ID: req.ID, Backend: req.Backend}
@@ -361,6 +417,20 @@ func (c *coordinator) commit(ctx context.Context,
 	c.descriptor.Error = reason
 }
 
+// cancelAll tells all participants to abort and marks the operation as cancelled
+func (c *coordinator) cancelAll(req *StatusRequest, nodes map[string]string) {
+	abortReq := &AbortRequest{Method: req.Method, ID: req.ID, Backend: req.Backend}
+	c.abortAll(context.Background(), abortReq, nodes)
+
+	c.descriptor.CompletedAt = time.Now().UTC()
+	c.descriptor.Status = backup.Cancelled
+	c.descriptor.Error = "operation cancelled by user"
+	for node, st := range c.descriptor.Nodes {
+		st.Status = backup.Cancelled
+		c.descriptor.Nodes[node] = st
+	}
+}
+
 // queryAll queries all participant and store their statuses internally
 //
 // It returns the number of failed node backups
@@ -55,7 +55,7 @@ func TestCoordinatedBackup(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		t.Parallel()
-		fc := newFakeCoordinator(nodeResolver)
+		fc := newFakeCoordinator(&fakeNodeResolver{hosts: nodeResolver.hosts, local: nodes[0]})
 		fc.selector.On("Shards", ctx, classes[0]).Return(nodes)
 		fc.selector.On("Shards", ctx, classes[1]).Return(nodes)
 
@@ -84,6 +84,7 @@ func TestCoordinatedBackup(t *testing.T) {
 			Status:        backup.Success,
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			Coordinator:   nodes[0],
 			Nodes: map[string]*backup.NodeDescriptor{
 				nodes[0]: {
 					Classes: classes,
@@ -183,6 +184,47 @@ func TestCoordinatedBackup(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 
+	t.Run("Cancel", func(t *testing.T) {
+		t.Parallel()
+		var (
+			fc          = newFakeCoordinator(nodeResolver)
+			coordinator = *fc.coordinator()
+			store       = coordStore{objStore{fc.backend, req.ID}}
+			inProgress  = &StatusResponse{Status: backup.Transferring, ID: backupID, Method: OpCreate}
+		)
+		fc.selector.On("Shards", ctx, classes[0]).Return(nodes)
+		fc.selector.On("Shards", ctx, classes[1]).Return(nodes)
+
+		fc.client.On("CanCommit", any, nodes[0], creq).Return(cresp, nil)
+		fc.client.On("CanCommit", any, nodes[1], creq).Return(cresp, nil)
+		fc.client.On("Commit", any, nodes[0], sReq).Return(nil)
+		fc.client.On("Commit", any, nodes[1], sReq).Return(nil)
+		fc.client.On("Status", any, nodes[0], sReq).Return(inProgress, nil).Maybe()
+		fc.client.On("Status", any, nodes[1], sReq).Return(inProgress, nil).Maybe()
+		fc.client.On("Abort", any, nodes[0], abortReq).Return(nil)
+		fc.client.On("Abort", any, nodes[1], abortReq).Return(nil)
+		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
+		fc.backend.On("DeleteBackup", any, backupID+"/"+nodes[0]).Return(nil)
+		fc.backend.On("DeleteBackup", any, backupID+"/"+nodes[1]).Return(nil)
+		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+
+		err := coordinator.Backup(ctx, store, &req)
+		assert.Nil(t, err)
+		assert.False(t, coordinator.Cancel("unknown"))
+		assert.True(t, coordinator.Cancel(backupID))
+		<-fc.backend.doneChan
+
+		got := fc.backend.glMeta
+		assert.Equal(t, backup.Cancelled, got.Status)
+		assert.NotEmpty(t, got.Error)
+		for _, node := range nodes {
+			assert.Equal(t, backup.Cancelled, got.Nodes[node].Status)
+		}
+		fc.client.AssertCalled(t, "Abort", any, nodes[0], abortReq)
+		fc.client.AssertCalled(t, "Abort", any, nodes[1], abortReq)
+		fc.backend.AssertExpectations(t)
+	})
+
 	t.Run("NodeDisconnect", func(t *testing.T) {
 		t.Parallel()
 		var (
@@ -276,6 +318,7 @@ func TestCoordinatedRestore(t *testing.T) {
 			Classes:  classes,
 			Duration: _BookingPeriod,
 		}
+		rReq     = &Request{Method: OpRestore, ID: backupID, Backend: backendName}
 		cresp    = &CanCommitResponse{Method: OpRestore, ID: backupID, Timeout: 1}
 		sReq     = &StatusRequest{OpRestore, backupID, backendName}
 		sresp    = &StatusResponse{Status: backup.Success, ID: backupID, Method: OpRestore}
@@ -300,7 +343,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, rReq, genReq())
 		assert.Nil(t, err)
 	})
 
@@ -315,7 +358,7 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, rReq, genReq())
 		assert.ErrorIs(t, err, errCannotCommit)
 		assert.Contains(t, err.Error(), nodes[1])
 	})
@@ -333,10 +376,36 @@ func TestCoordinatedRestore(t *testing.T) {
 
 		coordinator := *fc.coordinator()
 		store := coordStore{objStore{fc.backend, backupID}}
-		err := coordinator.Restore(ctx, store, backendName, genReq())
+		err := coordinator.Restore(ctx, store, rReq, genReq())
 		assert.ErrorIs(t, err, ErrAny)
 		assert.Contains(t, err.Error(), "initial")
 	})
+
+	t.Run("NodeMapping", func(t *testing.T) {
+		t.Parallel()
+		targets := []string{"N3", "N4"}
+		mapping := map[string]string{nodes[0]: targets[0], nodes[1]: targets[1]}
+		req := &Request{Method: OpRestore, ID: backupID, Backend: backendName, NodeMapping: mapping}
+		creq := *creq
+		creq.NodeMapping = mapping
+
+		fc := newFakeCoordinator(newFakeNodeResolver(targets))
+		fc.client.On("CanCommit", any, targets[0], &creq).Return(cresp, nil)
+		fc.client.On("CanCommit", any, targets[1], &creq).Return(cresp, nil)
+		fc.client.On("Commit", any, targets[0], sReq).Return(nil)
+		fc.client.On("Commit", any, targets[1], sReq).Return(nil)
+		fc.client.On("Status", any, targets[0], sReq).Return(sresp, nil)
+		fc.client.On("Status", any, targets[1], sReq).Return(sresp, nil)
+		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
+		fc.backend.On("PutObject", any, backupID, GlobalRestoreFile, any).Return(nil).Twice()
+
+		coordinator := *fc.coordinator()
+		store := coordStore{objStore{fc.backend, backupID}}
+		err := coordinator.Restore(ctx, store, req, genReq())
+		assert.Nil(t, err)
+		fc.client.AssertCalled(t, "CanCommit", any, targets[0], &creq)
+		fc.client.AssertCalled(t, "CanCommit", any, targets[1], &creq)
+	})
 }
 
 type fakeSelector struct {
@@ -377,10 +446,16 @@ func newFakeCoordinator(resolver nodeResolver) *fakeCoordinator {
 
 type fakeNodeResolver struct {
 	hosts map[string]string
+	local string
+}
+
+func (r *fakeNodeResolver) LocalName() string {
+	return r.local
 }
 
 func (r *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
-	return r.hosts[nodeName], true
+	host, ok := r.hosts[nodeName]
+	return host, ok
 }
 
 func (r *fakeNodeResolver) NodeCount() int {
@@ -433,3 +508,8 @@ func (f *fakeClient) Abort(ctx context.Context, node string, req *AbortRequest)
 	args := f.Called(ctx, node, req)
 	return args.Error(0)
 }
+
+func (f *fakeClient) Cancel(ctx context.Context, node string, req *AbortRequest) error {
+	args := f.Called(ctx, node, req)
+	return args.Error(0)
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, expr := range []string{
+		"* * * * *", "0 3 * * *", "*/15 * * * *", "0 0-23/6 * * 1-5",
+		"5,35 1,13 1 */3 0,7", "@daily", "@hourly", " @weekly ",
+	} {
+		_, err := parseCron(expr)
+		assert.Nil(t, err, expr)
+	}
+	for _, expr := range []string{
+		"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *",
+		"* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@every",
+	} {
+		_, err := parseCron(expr)
+		assert.NotNil(t, err, expr)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	at := func(s string) time.Time {
+		tm, err := time.Parse("2006-01-02 15:04", s)
+		require.Nil(t, err)
+		return tm
+	}
+	tests := []struct {
+		expr string
+		from string
+		want string
+	}{
+		{"* * * * *", "2023-05-10 10:15", "2023-05-10 10:16"},
+		{"0 3 * * *", "2023-05-10 10:15", "2023-05-11 03:00"},
+		{"0 3 * * *", "2023-05-10 02:59", "2023-05-10 03:00"},
+		{"0 3 * * *", "2023-05-10 03:00", "2023-05-11 03:00"},
+		{"*/20 * * * *", "2023-05-10 10:41", "2023-05-10 11:00"},
+		{"30 2 * * 1-5", "2023-05-12 03:00", "2023-05-15 02:30"}, // friday -> monday
+		{"0 0 1 * *", "2023-12-15 00:00", "2024-01-01 00:00"},
+		{"0 0 29 2 *", "2023-03-01 00:00", "2024-02-29 00:00"},
+		{"0 0 * * 7", "2023-05-10 00:00", "2023-05-14 00:00"},  // 7 is sunday
+		{"0 0 13 * 5", "2023-05-10 00:00", "2023-05-12 00:00"}, // day of month or day of week
+		{"@monthly", "2023-05-10 00:00", "2023-06-01 00:00"},
+	}
+	for _, tc := range tests {
+		s, err := parseCron(tc.expr)
+		require.Nil(t, err, tc.expr)
+		assert.Equal(t, at(tc.want), s.next(at(tc.from)), tc.expr)
+	}
+
+	s, err := parseCron("0 0 31 2 *") // never
+	require.Nil(t, err)
+	assert.True(t, s.next(at("2023-05-10 00:00")).IsZero())
+}
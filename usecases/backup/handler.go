@@ -12,7 +12,9 @@
 package backup
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"time"
@@ -22,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 // Version of backup structure
@@ -48,6 +51,7 @@ type schemaManger interface {
 type nodeResolver interface {
 	NodeHostname(nodeName string) (string, bool)
 	NodeCount() int
+	LocalName() string
 }
 
 type Status struct {
@@ -105,6 +109,91 @@ type BackupRequest struct {
 	// Exclude means include all classes but those specified in Exclude
 	// The same class cannot appear in both Include and Exclude in the same request
 	Exclude []string
+
+	// Compression configures how shard files are compressed.
+	// It is only used when creating a backup.
+	Compression Compression
+	// BaseBackupID makes a backup incremental.
+	// Only files which are not part of the base backup are uploaded.
+	BaseBackupID string
+
+	// ClassMapping restores classes of the backup under new names.
+	// It is only used when restoring a backup.
+	ClassMapping map[string]string
+	// NodeMapping restores the data of a node in the backup onto a node with a different name.
+	// It is only used when restoring a backup.
+	NodeMapping map[string]string
+}
+
+// CreateConfig is the user supplied configuration of a backup creation request
+type CreateConfig struct {
+	Compression
+	BaseBackupID string `json:"incrementalBaseBackupId,omitempty"`
+}
+
+// ParseCreateConfig parses the user supplied config of a backup creation request
+func ParseCreateConfig(cfg interface{}) (CreateConfig, error) {
+	var c CreateConfig
+	if cfg == nil {
+		return c, nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return c, fmt.Errorf("invalid config: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return c, fmt.Errorf("invalid config: %w", err)
+	}
+	if c.BaseBackupID != "" {
+		if err := validateID(c.BaseBackupID); err != nil {
+			return c, fmt.Errorf("invalid incremental base backup: %w", err)
+		}
+	}
+	return c, c.Compression.Validate()
+}
+
+// RestoreConfig is the user supplied configuration of a backup restoration request
+type RestoreConfig struct {
+	ClassMapping map[string]string `json:"classMapping,omitempty"`
+	NodeMapping  map[string]string `json:"nodeMapping,omitempty"`
+}
+
+// ParseRestoreConfig parses the user supplied config of a backup restoration request
+func ParseRestoreConfig(cfg interface{}) (RestoreConfig, error) {
+	var c RestoreConfig
+	if cfg == nil {
+		return c, nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return c, fmt.Errorf("invalid config: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return c, fmt.Errorf("invalid config: %w", err)
+	}
+	for from, to := range c.ClassMapping {
+		name, err := schema.ValidateClassName(schema.UppercaseClassName(to))
+		if err != nil {
+			return c, fmt.Errorf("invalid class mapping %s: %w", from, err)
+		}
+		c.ClassMapping[from] = string(name)
+	}
+	if dup := findDuplicate(mapValues(c.ClassMapping)); dup != "" {
+		return c, fmt.Errorf("invalid class mapping: class %s is the target of multiple classes", dup)
+	}
+	for from, to := range c.NodeMapping {
+		if to == "" {
+			return c, fmt.Errorf("invalid node mapping %s: empty node name", from)
+		}
+	}
+	if dup := findDuplicate(mapValues(c.NodeMapping)); dup != "" {
+		return c, fmt.Errorf("invalid node mapping: node %s is the target of multiple nodes", dup)
+	}
+	return c, nil
 }
 
 func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupRequest,
@@ -123,7 +212,14 @@ func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupR
 	if err := store.Initialize(ctx); err != nil {
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
-	if meta, err := m.backupper.Backup(ctx, store, req.ID, classes); err != nil {
+	breq := Request{
+		Method:       OpCreate,
+		ID:           req.ID,
+		Classes:      classes,
+		Compression:  req.Compression,
+		BaseBackupID: req.BaseBackupID,
+	}
+	if meta, err := m.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, err
 	} else {
 		status := string(meta.Status)
@@ -150,15 +246,23 @@ func (m *Manager) Restore(ctx context.Context, pr *models.Principal,
 		return nil, err
 	}
 	cs := meta.List()
-	if cls := m.restorer.AnyExists(cs); cls != "" {
+	targets := make([]string, len(cs))
+	for i, cls := range cs {
+		targets[i] = cls
+		if name, ok := req.ClassMapping[cls]; ok {
+			targets[i] = name
+		}
+	}
+	if cls := m.restorer.AnyExists(targets); cls != "" {
 		err := fmt.Errorf("cannot restore class %q because it already exists", cls)
 		return nil, backup.NewErrUnprocessable(err)
 	}
 	rreq := Request{
-		Method:  OpRestore,
-		ID:      meta.ID,
-		Backend: req.Backend,
-		Classes: cs,
+		Method:       OpRestore,
+		ID:           meta.ID,
+		Backend:      req.Backend,
+		Classes:      cs,
+		ClassMapping: req.ClassMapping,
 	}
 	data, err := m.restorer.Restore(ctx, &rreq, meta, store)
 	if err != nil {
@@ -183,7 +287,7 @@ func (m *Manager) RestorationStatus(ctx context.Context, principal *models.Princ
 // in a distributed backup operation
 func (m *Manager) OnCanCommit(ctx context.Context, req *Request) *CanCommitResponse {
 	ret := &CanCommitResponse{Method: req.Method, ID: req.ID}
-	store, err := nodeBackend(m.node, m.backends, req.Backend, req.ID)
+	store, err := nodeBackend(sourceNode(m.node, req.NodeMapping), m.backends, req.Backend, req.ID)
 	if err != nil {
 		ret.Err = fmt.Sprintf("no backup backend %q, did you enable the right module?", req.Backend)
 		return ret
@@ -288,6 +392,9 @@ func (m *Manager) validateBackupRequest(ctx context.Context, store nodeStore, re
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, fmt.Errorf("malformed request: 'include' and 'exclude' cannot both contain values")
 	}
+	if err := req.Compression.Validate(); err != nil {
+		return nil, err
+	}
 	classes := req.Include
 	if len(classes) == 0 {
 		classes = m.backupper.sourcer.ListBackupable()
@@ -308,6 +415,19 @@ func (m *Manager) validateBackupRequest(ctx context.Context, store nodeStore, re
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseBackupID != "" {
+		if req.BaseBackupID == req.ID {
+			return nil, fmt.Errorf("backup %q cannot be its own incremental base", req.ID)
+		}
+		base := nodeStore{objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", req.BaseBackupID, m.node)}}
+		meta, err := base.Meta(ctx, req.BaseBackupID, false)
+		if err != nil {
+			return nil, fmt.Errorf("incremental base backup %q: %w", req.BaseBackupID, err)
+		}
+		if meta.Status != string(backup.Success) {
+			return nil, fmt.Errorf("incremental base backup %q has status %q", req.BaseBackupID, meta.Status)
+		}
+	}
 	return classes, nil
 }
 
@@ -338,6 +458,16 @@ func validateID(backupID string) error {
 	return nil
 }
 
+// sourceNode returns the node of the backup whose data is restored on node
+func sourceNode(node string, nodeMapping map[string]string) string {
+	for from, to := range nodeMapping {
+		if to == node {
+			return from
+		}
+	}
+	return node
+}
+
 func nodeBackend(node string, provider BackupBackendProvider, backend, id string) (nodeStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -108,3 +109,139 @@ func TestHandlerValidateCoordinationOperation(t *testing.T) {
 		assert.Contains(t, ret.Err, errUnknownOp.Error())
 	}
 }
+
+func TestParseCreateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  interface{}
+		want CreateConfig
+		err  string
+	}{
+		{name: "nil config", cfg: nil},
+		{name: "empty config", cfg: map[string]interface{}{}},
+		{
+			name: "zstd",
+			cfg:  map[string]interface{}{"compression": "zstd", "compressionLevel": 19.0, "chunkSize": 64.0},
+			want: CreateConfig{Compression: Compression{Algorithm: CompressionZSTD, Level: 19, ChunkSize: 64}},
+		},
+		{
+			name: "gzip",
+			cfg:  map[string]interface{}{"compression": "gzip", "compressionLevel": 9.0},
+			want: CreateConfig{Compression: Compression{Algorithm: CompressionGZIP, Level: 9}},
+		},
+		{
+			name: "unknown algorithm",
+			cfg:  map[string]interface{}{"compression": "lz4"},
+			err:  "invalid compression",
+		},
+		{
+			name: "gzip default level",
+			cfg:  map[string]interface{}{"compression": "gzip", "compressionLevel": 0.0},
+			want: CreateConfig{Compression: Compression{Algorithm: CompressionGZIP}},
+		},
+		{
+			name: "gzip level out of range",
+			cfg:  map[string]interface{}{"compression": "gzip", "compressionLevel": 19.0},
+			err:  "invalid compression level 19: must be between 1 and 9, or 0 for the default",
+		},
+		{
+			name: "chunk size out of range",
+			cfg:  map[string]interface{}{"chunkSize": 1024.0},
+			err:  "invalid chunk size",
+		},
+		{
+			name: "incremental",
+			cfg:  map[string]interface{}{"incrementalBaseBackupId": "base-1"},
+			want: CreateConfig{BaseBackupID: "base-1"},
+		},
+		{
+			name: "invalid base backup",
+			cfg:  map[string]interface{}{"incrementalBaseBackupId": "Base/1"},
+			err:  "invalid incremental base backup",
+		},
+		{
+			name: "unknown key",
+			cfg:  map[string]interface{}{"level": 1.0},
+			err:  "invalid config",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseCreateConfig(tc.cfg)
+			if tc.err != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseRestoreConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  interface{}
+		want RestoreConfig
+		err  string
+	}{
+		{name: "nil config", cfg: nil},
+		{name: "empty config", cfg: map[string]interface{}{}},
+		{
+			name: "class mapping",
+			cfg:  map[string]interface{}{"classMapping": map[string]interface{}{"Article": "stagingArticle"}},
+			want: RestoreConfig{ClassMapping: map[string]string{"Article": "StagingArticle"}},
+		},
+		{
+			name: "node mapping",
+			cfg:  map[string]interface{}{"nodeMapping": map[string]interface{}{"node1": "staging1", "node2": "staging2"}},
+			want: RestoreConfig{NodeMapping: map[string]string{"node1": "staging1", "node2": "staging2"}},
+		},
+		{
+			name: "invalid class name",
+			cfg:  map[string]interface{}{"classMapping": map[string]interface{}{"Article": "Not-A-Class"}},
+			err:  "invalid class mapping",
+		},
+		{
+			name: "duplicate class target",
+			cfg:  map[string]interface{}{"classMapping": map[string]interface{}{"A": "C", "B": "C"}},
+			err:  "class C is the target of multiple classes",
+		},
+		{
+			name: "empty node name",
+			cfg:  map[string]interface{}{"nodeMapping": map[string]interface{}{"node1": ""}},
+			err:  "empty node name",
+		},
+		{
+			name: "duplicate node target",
+			cfg:  map[string]interface{}{"nodeMapping": map[string]interface{}{"node1": "n", "node2": "n"}},
+			err:  "node n is the target of multiple nodes",
+		},
+		{
+			name: "unknown key",
+			cfg:  map[string]interface{}{"compression": "gzip"},
+			err:  "invalid config",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseRestoreConfig(tc.cfg)
+			if tc.err != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSourceNode(t *testing.T) {
+	mapping := map[string]string{"N1": "N3", "N2": "N1"}
+	assert.Equal(t, "N2", sourceNode("N1", mapping))
+	assert.Equal(t, "N1", sourceNode("N3", mapping))
+	assert.Equal(t, "N4", sourceNode("N4", mapping))
+	assert.Equal(t, "N1", sourceNode("N1", nil))
+}
@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// scheduledBackupPrefix is the ID prefix of periodically created backups
+	scheduledBackupPrefix = "scheduled-"
+	// scheduledBackupLayout formats the scheduled time as part of the backup ID
+	scheduledBackupLayout = "20060102-1504"
+
+	_PeriodicCheckInterval = time.Minute
+)
+
+type clusterNodes interface {
+	AllNames() []string
+	LocalName() string
+}
+
+// PeriodicBackups creates backups according to a cron schedule and deletes old
+// scheduled backups according to a retention policy.
+//
+// Only the node with the lexicographically smallest name among all cluster members
+// creates backups. The ID of a scheduled backup is derived from its scheduled time.
+// This way a backup which was missed, e.g. because nodes were restarting, is created
+// as soon as possible afterwards, but it's never created twice. If several backups
+// were missed, only the most recent one is created.
+type PeriodicBackups struct {
+	scheduler *Scheduler
+	nodes     clusterNodes
+	cfg       config.BackupSchedule
+	cron      *cronSchedule
+	logger    logrus.FieldLogger
+	now       func() time.Time
+
+	// last is the scheduled time of the latest scheduled backup.
+	// It is loaded from the backend whenever this node takes over creating backups.
+	last    time.Time
+	started time.Time
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewPeriodicBackups creates backups through scheduler as configured by cfg
+func NewPeriodicBackups(scheduler *Scheduler, nodes clusterNodes,
+	cfg config.BackupSchedule, logger logrus.FieldLogger,
+) (*PeriodicBackups, error) {
+	cron, err := parseCron(cfg.Cron)
+	if err != nil {
+		return nil, err
+	}
+	return &PeriodicBackups{
+		scheduler: scheduler,
+		nodes:     nodes,
+		cfg:       cfg,
+		cron:      cron,
+		logger:    logger,
+		now:       func() time.Time { return time.Now().UTC() },
+	}, nil
+}
+
+// Start checks periodically in the background whether a backup is due
+func (p *PeriodicBackups) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel, p.done = cancel, make(chan struct{})
+	p.started = p.now()
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(_PeriodicCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.tick(ctx)
+			}
+		}
+	}()
+}
+
+// Shutdown stops creating backups. A backup which is in progress is not affected
+func (p *PeriodicBackups) Shutdown(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isResponsible returns true if this node is the one creating scheduled backups
+func (p *PeriodicBackups) isResponsible() bool {
+	local := p.nodes.LocalName()
+	for _, name := range p.nodes.AllNames() {
+		if name < local {
+			return false
+		}
+	}
+	return true
+}
+
+// tick creates a backup if the next scheduled time has passed.
+// Each scheduled time is attempted only once. If the backup fails, e.g. because
+// another backup is in progress, it is skipped until the next scheduled time.
+func (p *PeriodicBackups) tick(ctx context.Context) {
+	if !p.isResponsible() {
+		p.last = time.Time{} // reload from backend after taking over
+		return
+	}
+	if p.last.IsZero() {
+		last, err := p.latest(ctx)
+		if err != nil {
+			p.logger.WithField("action", "periodic_backup").
+				WithField("backend", p.cfg.Backend).Error(err)
+			return
+		}
+		if last.IsZero() { // no scheduled backup yet
+			last = p.started
+		}
+		p.last = last
+	}
+
+	now := p.now()
+	slot := p.cron.next(p.last)
+	if slot.IsZero() || slot.After(now) {
+		return
+	}
+	// skip older missed slots, the most recent one is the only one created
+	for next := p.cron.next(slot); !next.IsZero() && !next.After(now); next = p.cron.next(next) {
+		slot = next
+	}
+	p.last = slot
+	req := &BackupRequest{
+		ID:      scheduledBackupID(slot),
+		Backend: p.cfg.Backend,
+		Include: p.cfg.Include,
+		Exclude: p.cfg.Exclude,
+	}
+	if _, err := p.scheduler.backup(ctx, req); err != nil {
+		p.logger.WithField("action", "periodic_backup").
+			WithField("backup_id", req.ID).WithField("backend", req.Backend).
+			Errorf("create scheduled backup: %v", err)
+		return
+	}
+	p.prune(ctx)
+}
+
+// latest returns the scheduled time of the latest scheduled backup in the backend
+func (p *PeriodicBackups) latest(ctx context.Context) (time.Time, error) {
+	store, err := coordBackend(p.scheduler.backends, p.cfg.Backend, "")
+	if err != nil {
+		return time.Time{}, err
+	}
+	ids, err := store.b.AllBackups(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var last time.Time
+	for _, id := range ids {
+		if t, ok := scheduledTime(id); ok && t.After(last) {
+			last = t
+		}
+	}
+	return last, nil
+}
+
+// prune deletes finished scheduled backups which exceed the retention policy.
+// Only successful backups count towards the retention count, so failed ones
+// never push out a backup which could be restored.
+// Backups which weren't created by the schedule are never deleted.
+func (p *PeriodicBackups) prune(ctx context.Context) {
+	if p.cfg.RetentionCount == 0 && p.cfg.RetentionMaxAge == 0 {
+		return
+	}
+	logger := p.logger.WithField("action", "periodic_backup_prune").
+		WithField("backend", p.cfg.Backend)
+	store, err := coordBackend(p.scheduler.backends, p.cfg.Backend, "")
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+	metas, err := p.scheduler.allBackups(ctx, store)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+	now, kept := p.now(), 0
+	for i := len(metas) - 1; i >= 0; i-- { // newest first
+		meta := metas[i]
+		if _, ok := scheduledTime(meta.ID); !ok || !isFinished(meta.Status) {
+			continue
+		}
+		expired := (p.cfg.RetentionCount > 0 && kept >= p.cfg.RetentionCount) ||
+			(p.cfg.RetentionMaxAge > 0 && now.Sub(meta.StartedAt) > p.cfg.RetentionMaxAge)
+		if !expired {
+			if meta.Status == backup.Success {
+				kept++
+			}
+			continue
+		}
+		if err := p.scheduler.delete(ctx, p.cfg.Backend, meta.ID); err != nil {
+			logger.WithField("backup_id", meta.ID).Error(err)
+			continue
+		}
+		logger.WithField("backup_id", meta.ID).Info("deleted expired scheduled backup")
+	}
+}
+
+func isFinished(st backup.Status) bool {
+	return st == backup.Success || st == backup.Failed || st == backup.Cancelled
+}
+
+func scheduledBackupID(slot time.Time) string {
+	return scheduledBackupPrefix + slot.UTC().Format(scheduledBackupLayout)
+}
+
+// scheduledTime returns the scheduled time of a backup created by the schedule
+func scheduledTime(id string) (time.Time, bool) {
+	if !strings.HasPrefix(id, scheduledBackupPrefix) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(scheduledBackupLayout, strings.TrimPrefix(id, scheduledBackupPrefix))
+	return t, err == nil
+}
@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeClusterNodes struct {
+	names []string
+	local string
+}
+
+func (n *fakeClusterNodes) AllNames() []string { return n.names }
+func (n *fakeClusterNodes) LocalName() string  { return n.local }
+
+func TestPeriodicBackupsConfig(t *testing.T) {
+	fs := newFakeScheduler(nil)
+	_, err := NewPeriodicBackups(fs.scheduler(), &fakeClusterNodes{},
+		config.BackupSchedule{Cron: "0 25 * * *", Backend: "s3"}, fs.log)
+	assert.ErrorContains(t, err, "hour")
+}
+
+func TestPeriodicBackupsTick(t *testing.T) {
+	var (
+		cls         = "Class-A"
+		node        = "Node-A"
+		backendName = "gcs"
+		ctx         = context.Background()
+		now         = time.Date(2023, 5, 17, 12, 0, 0, 0, time.UTC)
+		cfg         = config.BackupSchedule{Cron: "0 3 * * *", Backend: backendName, Include: []string{cls}}
+	)
+	newPeriodic := func(fs *fakeScheduler, cfg config.BackupSchedule, nodes ...string) *PeriodicBackups {
+		p, err := NewPeriodicBackups(fs.scheduler(), &fakeClusterNodes{nodes, node}, cfg, fs.log)
+		require.Nil(t, err)
+		p.now = func() time.Time { return now }
+		p.started = now.Add(-time.Hour)
+		return p
+	}
+
+	t.Run("NotResponsible", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		p := newPeriodic(fs, cfg, "A-Node", node)
+		p.last = now.Add(-48 * time.Hour)
+		p.tick(ctx)
+		assert.True(t, p.last.IsZero())
+		fs.backend.AssertNotCalled(t, "AllBackups", mock.Anything)
+	})
+
+	t.Run("NotDue", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("AllBackups", ctx).Return([]string{"manual", "scheduled-20230517-0300"}, nil)
+		p := newPeriodic(fs, cfg, node, "Node-B")
+		p.tick(ctx)
+		assert.Equal(t, time.Date(2023, 5, 17, 3, 0, 0, 0, time.UTC), p.last)
+		fs.backend.AssertNotCalled(t, "Initialize", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NoScheduledBackupYet", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("AllBackups", ctx).Return([]string{"manual"}, nil)
+		p := newPeriodic(fs, cfg, node)
+		p.tick(ctx)
+		assert.Equal(t, p.started, p.last)
+		fs.backend.AssertNotCalled(t, "Initialize", mock.Anything, mock.Anything)
+	})
+
+	t.Run("MissedBackupAndRetention", func(t *testing.T) {
+		var (
+			backupID = "scheduled-20230517-0300"
+			cresp    = &CanCommitResponse{Method: OpCreate, ID: backupID, Timeout: 1}
+			sReq     = &StatusRequest{OpCreate, backupID, backendName}
+			sresp    = &StatusResponse{Status: backup.Success, ID: backupID, Method: OpCreate}
+			old      = []string{"scheduled-20230515-0300", "scheduled-20230516-0300"}
+		)
+		meta := func(id string, startedAt time.Time) []byte {
+			return marshalCoordinatorMeta(backup.DistributedBackupDescriptor{
+				ID: id, Status: backup.Success, StartedAt: startedAt,
+			})
+		}
+		fs := newFakeScheduler(newFakeNodeResolver([]string{node}))
+		fs.backend.On("AllBackups", ctx).Return([]string{"manual", old[0], old[1]}, nil)
+		fs.backend.On("GetObject", ctx, "manual", GlobalBackupFile).Return(meta("manual", now.Add(-96*time.Hour)), nil)
+		fs.backend.On("GetObject", ctx, old[0], GlobalBackupFile).Return(meta(old[0], now.Add(-57*time.Hour)), nil)
+		fs.backend.On("GetObject", ctx, old[1], GlobalBackupFile).Return(meta(old[1], now.Add(-33*time.Hour)), nil)
+		fs.backend.On("DeleteBackup", ctx, old[0]).Return(nil)
+
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.selector.On("Shards", ctx, cls).Return([]string{node})
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("HomeDir", mock.Anything).Return("dst/path")
+		fs.backend.On("Initialize", ctx, mock.Anything).Return(nil)
+		fs.client.On("CanCommit", any, node, any).Return(cresp, nil)
+		fs.client.On("Commit", any, node, sReq).Return(nil)
+		fs.client.On("Status", any, node, sReq).Return(sresp, nil)
+		fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil)
+
+		cfg := cfg
+		cfg.RetentionCount = 1
+		p := newPeriodic(fs, cfg, node)
+		p.tick(ctx)
+
+		assert.Equal(t, time.Date(2023, 5, 17, 3, 0, 0, 0, time.UTC), p.last)
+		fs.client.AssertCalled(t, "CanCommit", any, node, any)
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, old[0])
+		fs.backend.AssertNotCalled(t, "DeleteBackup", ctx, old[1])
+		fs.backend.AssertNotCalled(t, "DeleteBackup", ctx, "manual")
+
+		// the next backup is not due before tomorrow
+		p.tick(ctx)
+		fs.client.AssertNumberOfCalls(t, "CanCommit", 1)
+	})
+}
+
+func TestPeriodicBackupsSkipsOlderMissedSlots(t *testing.T) {
+	var (
+		ctx = context.Background()
+		now = time.Date(2023, 5, 17, 12, 0, 0, 0, time.UTC)
+	)
+	fs := newFakeScheduler(nil)
+	fs.backend.On("AllBackups", ctx).Return([]string{"scheduled-20230514-0300"}, nil)
+	fs.backend.On("GetObject", ctx, "scheduled-20230517-0300", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+	fs.backend.On("GetObject", ctx, "scheduled-20230517-0300", BackupFile).Return(nil, backup.ErrNotFound{})
+	fs.selector.On("Backupable", ctx, []string{"Class-A"}).Return(errors.New("not backupable"))
+
+	p, err := NewPeriodicBackups(fs.scheduler(), &fakeClusterNodes{[]string{"Node-A"}, "Node-A"},
+		config.BackupSchedule{Cron: "0 3 * * *", Backend: "s3", Include: []string{"Class-A"}}, fs.log)
+	require.Nil(t, err)
+	p.now = func() time.Time { return now }
+	p.tick(ctx)
+
+	// the backups of the 15th and 16th are not created anymore
+	assert.Equal(t, time.Date(2023, 5, 17, 3, 0, 0, 0, time.UTC), p.last)
+	fs.backend.AssertNotCalled(t, "GetObject", ctx, "scheduled-20230515-0300", GlobalBackupFile)
+	fs.backend.AssertNotCalled(t, "GetObject", ctx, "scheduled-20230516-0300", GlobalBackupFile)
+}
+
+func TestPeriodicBackupsPruneByCount(t *testing.T) {
+	var (
+		ctx = context.Background()
+		now = time.Date(2023, 5, 17, 12, 0, 0, 0, time.UTC)
+		ids = []string{"scheduled-20230515-0300", "scheduled-20230516-0300", "scheduled-20230517-0300"}
+	)
+	fs := newFakeScheduler(nil)
+	fs.backend.On("AllBackups", ctx).Return(ids, nil)
+	fs.backend.On("GetObject", ctx, ids[0], GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{ID: ids[0], Status: backup.Success, StartedAt: now.Add(-57 * time.Hour)}), nil)
+	fs.backend.On("GetObject", ctx, ids[1], GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{ID: ids[1], Status: backup.Success, StartedAt: now.Add(-33 * time.Hour)}), nil)
+	fs.backend.On("GetObject", ctx, ids[2], GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{ID: ids[2], Status: backup.Failed, StartedAt: now.Add(-9 * time.Hour)}), nil)
+	fs.backend.On("DeleteBackup", ctx, ids[0]).Return(nil)
+
+	p, err := NewPeriodicBackups(fs.scheduler(), &fakeClusterNodes{},
+		config.BackupSchedule{Cron: "@daily", Backend: "s3", RetentionCount: 1}, fs.log)
+	require.Nil(t, err)
+	p.now = func() time.Time { return now }
+	p.prune(ctx)
+
+	// the failed backup doesn't count, so the latest successful one is kept
+	fs.backend.AssertCalled(t, "DeleteBackup", ctx, ids[0])
+	fs.backend.AssertNumberOfCalls(t, "DeleteBackup", 1)
+}
+
+func TestPeriodicBackupsPruneByAge(t *testing.T) {
+	var (
+		ctx = context.Background()
+		now = time.Date(2023, 5, 17, 12, 0, 0, 0, time.UTC)
+		ids = []string{"scheduled-20230510-0300", "scheduled-20230516-0300", "scheduled-20230517-0300"}
+	)
+	fs := newFakeScheduler(nil)
+	fs.backend.On("AllBackups", ctx).Return(ids, nil)
+	fs.backend.On("GetObject", ctx, ids[0], GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{ID: ids[0], Status: backup.Failed, StartedAt: now.Add(-7 * 24 * time.Hour)}), nil)
+	fs.backend.On("GetObject", ctx, ids[1], GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{ID: ids[1], Status: backup.Success, StartedAt: now.Add(-33 * time.Hour)}), nil)
+	fs.backend.On("GetObject", ctx, ids[2], GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{ID: ids[2], Status: backup.Transferring, StartedAt: now.Add(-9 * time.Hour)}), nil)
+	fs.backend.On("DeleteBackup", ctx, ids[0]).Return(nil)
+
+	p, err := NewPeriodicBackups(fs.scheduler(), &fakeClusterNodes{},
+		config.BackupSchedule{Cron: "@daily", Backend: "s3", RetentionMaxAge: 48 * time.Hour}, fs.log)
+	require.Nil(t, err)
+	p.now = func() time.Time { return now }
+	p.prune(ctx)
+	fs.backend.AssertCalled(t, "DeleteBackup", ctx, ids[0])
+	fs.backend.AssertNumberOfCalls(t, "DeleteBackup", 1)
+}
+
+func TestScheduledBackupID(t *testing.T) {
+	slot := time.Date(2023, 5, 17, 3, 30, 0, 0, time.UTC)
+	id := scheduledBackupID(slot)
+	assert.Equal(t, "scheduled-20230517-0330", id)
+	assert.Nil(t, validateID(id))
+	got, ok := scheduledTime(id)
+	assert.True(t, ok)
+	assert.Equal(t, slot, got)
+	_, ok = scheduledTime("manual-20230517-0330")
+	assert.False(t, ok)
+}
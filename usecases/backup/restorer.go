@@ -13,6 +13,7 @@ package backup
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -24,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type restorer struct {
@@ -125,7 +127,7 @@ func (r *restorer) restore(ctx context.Context,
 			return
 		}
 
-		err = r.restoreAll(context.Background(), desc, store)
+		err = r.restoreAll(context.Background(), req, desc, store)
 		if err != nil {
 			r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).Error(err)
 		}
@@ -135,12 +137,13 @@ func (r *restorer) restore(ctx context.Context,
 }
 
 func (r *restorer) restoreAll(ctx context.Context,
+	req *Request,
 	desc *backup.BackupDescriptor,
 	store nodeStore,
 ) (err error) {
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, desc.ID, &cdesc, store); err != nil {
+		if err := r.restoreOne(ctx, req, desc.ID, &cdesc, store); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -159,6 +162,7 @@ func getType(myvar interface{}) string {
 }
 
 func (r *restorer) restoreOne(ctx context.Context,
+	req *Request,
 	backupID string, desc *backup.ClassDescriptor,
 	store nodeStore,
 ) (err error) {
@@ -168,15 +172,24 @@ func (r *restorer) restoreOne(ctx context.Context,
 		defer timer.ObserveDuration()
 	}
 
-	if r.sourcer.ClassExists(desc.Name) {
+	className := desc.Name
+	if name, ok := req.ClassMapping[desc.Name]; ok {
+		className = name
+	}
+	if r.sourcer.ClassExists(className) {
 		return fmt.Errorf("already exists")
 	}
+	mapped, err := mapClassDescriptor(desc, className, req.ClassMapping, req.NodeMapping)
+	if err != nil {
+		return err
+	}
 	fw := newFileWriter(r.sourcer, store, backupID)
+	fw.className = className
 	rollback, err := fw.Write(ctx, desc)
 	if err != nil {
 		return fmt.Errorf("write files: %w", err)
 	}
-	if err := r.schema.RestoreClass(ctx, desc); err != nil {
+	if err := r.schema.RestoreClass(ctx, mapped); err != nil {
 		if rerr := rollback(); rerr != nil {
 			r.logger.WithField("className", desc.Name).WithField("action", "rollback").Error(rerr)
 		}
@@ -185,6 +198,67 @@ func (r *restorer) restoreOne(ctx context.Context,
 	return nil
 }
 
+// mapClassDescriptor returns a copy of d which is restored as class className.
+// The schema and sharding state of the copy refer to renamed classes and
+// to the nodes of this cluster as specified by the class and node mappings.
+func mapClassDescriptor(d *backup.ClassDescriptor, className string,
+	classMapping, nodeMapping map[string]string,
+) (*backup.ClassDescriptor, error) {
+	if len(classMapping) == 0 && len(nodeMapping) == 0 {
+		return d, nil
+	}
+	ret := *d
+	ret.Name = className
+
+	class := models.Class{}
+	if err := json.Unmarshal(d.Schema, &class); err != nil {
+		return nil, fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	class.Class = className
+	for _, prop := range class.Properties {
+		for i, dt := range prop.DataType {
+			if name, ok := classMapping[dt]; ok { // cross-reference to a renamed class
+				prop.DataType[i] = name
+			}
+		}
+	}
+	schema, err := json.Marshal(&class)
+	if err != nil {
+		return nil, fmt.Errorf("marshal class schema: %w", err)
+	}
+	ret.Schema = schema
+
+	if d.ShardingState == nil {
+		return &ret, nil
+	}
+	var ss sharding.State
+	if err := json.Unmarshal(d.ShardingState, &ss); err != nil {
+		return nil, fmt.Errorf("unmarshal sharding state: %w", err)
+	}
+	ss.IndexID = className
+	mapNode := func(node string) string {
+		if to, ok := nodeMapping[node]; ok {
+			return to
+		}
+		return node
+	}
+	for name, shard := range ss.Physical {
+		if shard.LegacyBelongsToNodeForBackwardCompat != "" {
+			shard.LegacyBelongsToNodeForBackwardCompat = mapNode(shard.LegacyBelongsToNodeForBackwardCompat)
+		}
+		nodes := make([]string, len(shard.BelongsToNodes))
+		for i, node := range shard.BelongsToNodes {
+			nodes[i] = mapNode(node)
+		}
+		shard.BelongsToNodes = nodes
+		ss.Physical[name] = shard
+	}
+	if ret.ShardingState, err = json.Marshal(&ss); err != nil {
+		return nil, fmt.Errorf("marshal sharding state: %w", err)
+	}
+	return &ret, nil
+}
+
 // AnyExists checks if any classes of cs exists in DB
 func (r *restorer) AnyExists(cs []string) string {
 	for _, cls := range cs {
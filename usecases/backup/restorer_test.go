@@ -15,14 +15,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 // ErrAny represent a random error
@@ -590,6 +593,109 @@ func TestManagerCoordinatedRestore(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, lastStatus.Status, backup.Failed)
 	})
+
+	t.Run("ClassAndNodeMapping", func(t *testing.T) {
+		req := req
+		req.Duration = time.Hour
+		req.Classes = []string{"Article"}
+		req.ClassMapping = map[string]string{"Article": "Staging"}
+		req.NodeMapping = map[string]string{"prod-1": nodeName}
+		sourceHome := backupID + "/prod-1"
+		metadata := backup.BackupDescriptor{
+			ID:            backupID,
+			StartedAt:     timept,
+			Version:       "1",
+			ServerVersion: "1",
+			Status:        string(backup.Success),
+			Classes: []backup.ClassDescriptor{{
+				Name:          "Article",
+				Schema:        []byte(`{"class":"Article"}`),
+				ShardingState: []byte(`{"indexID":"Article","physical":{"shard1":{"name":"shard1","belongsToNodes":["prod-1"]}}}`),
+				Shards: []backup.ShardDescriptor{{
+					Name: "shard1", Node: "prod-1",
+					Files:                 []string{"article_shard1_lsm/objects/segment-1.db"},
+					DocIDCounterPath:      "article_shard1.indexcount",
+					ShardVersionPath:      "article_shard1.version",
+					PropLengthTrackerPath: "article_shard1.proplengths",
+					DocIDCounter:          rawbytes,
+					Version:               rawbytes,
+					PropLengthTracker:     rawbytes,
+				}},
+			}},
+		}
+		dataPath := t.TempDir()
+		backend := newFakeBackend()
+		sourcer := &fakeSourcer{}
+		sourcer.On("ClassExists", "Staging").Return(false)
+		backend.On("GetObject", ctx, sourceHome, BackupFile).Return(marshalMeta(metadata), nil)
+		backend.On("HomeDir", mock.Anything).Return(path)
+		backend.On("SourceDataPath").Return(dataPath)
+		backend.On("WriteToFile", any, sourceHome, mock.Anything, mock.Anything).Return(nil)
+		m := createManager(sourcer, nil, backend, nil)
+		resp := m.OnCanCommit(ctx, &req)
+		assert.Equal(t, "", resp.Err)
+		err := m.OnCommit(ctx, &StatusRequest{Method: OpRestore, ID: req.ID, Backend: req.Backend})
+		assert.Nil(t, err)
+		var lastStatus Status
+		for i := 0; i < 10; i++ {
+			time.Sleep(time.Millisecond * 50)
+			lastStatus, err = m.RestorationStatus(ctx, nil, req.Backend, req.ID)
+			if err != nil {
+				continue
+			}
+			if lastStatus.Status == backup.Success || lastStatus.Status == backup.Failed {
+				break
+			}
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, backup.Success, lastStatus.Status)
+		assert.DirExists(t, filepath.Join(dataPath, "staging_shard1_lsm"))
+		assert.FileExists(t, filepath.Join(dataPath, "staging_shard1.indexcount"))
+		assert.FileExists(t, filepath.Join(dataPath, "staging_shard1.version"))
+		assert.NoFileExists(t, filepath.Join(dataPath, "article_shard1.indexcount"))
+	})
+}
+
+func TestMapClassDescriptor(t *testing.T) {
+	desc := backup.ClassDescriptor{
+		Name: "Article",
+		Schema: []byte(`{"class":"Article","properties":[` +
+			`{"name":"author","dataType":["Author"]},{"name":"title","dataType":["text"]}]}`),
+		ShardingState: []byte(`{"indexID":"Article","physical":{"s1":{"name":"s1","belongsToNodes":["N1","N2"]}}}`),
+	}
+
+	t.Run("NoMapping", func(t *testing.T) {
+		got, err := mapClassDescriptor(&desc, desc.Name, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, &desc, got)
+	})
+
+	t.Run("ClassAndNodeMapping", func(t *testing.T) {
+		classMapping := map[string]string{"Article": "NewArticle", "Author": "NewAuthor"}
+		nodeMapping := map[string]string{"N1": "N3"}
+		got, err := mapClassDescriptor(&desc, "NewArticle", classMapping, nodeMapping)
+		require.Nil(t, err)
+		assert.Equal(t, "NewArticle", got.Name)
+		assert.Equal(t, "Article", desc.Name)
+
+		class := models.Class{}
+		require.Nil(t, json.Unmarshal(got.Schema, &class))
+		assert.Equal(t, "NewArticle", class.Class)
+		assert.Equal(t, []string{"NewAuthor"}, class.Properties[0].DataType)
+		assert.Equal(t, []string{"text"}, class.Properties[1].DataType)
+
+		var ss sharding.State
+		require.Nil(t, json.Unmarshal(got.ShardingState, &ss))
+		assert.Equal(t, "NewArticle", ss.IndexID)
+		assert.Equal(t, []string{"N3", "N2"}, ss.Physical["s1"].BelongsToNodes)
+	})
+
+	t.Run("InvalidSchema", func(t *testing.T) {
+		d := desc
+		d.Schema = []byte("hello")
+		_, err := mapClassDescriptor(&d, "NewArticle", map[string]string{"Article": "NewArticle"}, nil)
+		assert.ErrorContains(t, err, "class schema")
+	})
 }
 
 func TestRestoreOnStatus(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -72,6 +73,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, "add", path); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+// backup creates a backup without authorizing the request
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest,
+) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -87,10 +94,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:  OpCreate,
-		ID:      req.ID,
-		Backend: req.Backend,
-		Classes: classes,
+		Method:       OpCreate,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Classes:      classes,
+		Compression:  req.Compression,
+		BaseBackupID: req.BaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -136,7 +145,14 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		Path:    store.HomeDir(),
 		Classes: meta.Classes(),
 	}
-	err = s.restorer.Restore(ctx, store, req.Backend, meta)
+	rReq := Request{
+		Method:       OpRestore,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		ClassMapping: req.ClassMapping,
+		NodeMapping:  req.NodeMapping,
+	}
+	err = s.restorer.Restore(ctx, store, &rReq, meta)
 	if err != nil {
 		status = string(backup.Failed)
 		data.Error = err.Error()
@@ -193,6 +209,195 @@ func (s *Scheduler) RestorationStatus(ctx context.Context, principal *models.Pri
 	return st, nil
 }
 
+// List returns all backups stored in the given backend
+func (s *Scheduler) List(ctx context.Context, principal *models.Principal, backend string,
+) (_ *models.BackupListResponse, err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "list_backups", "", backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s", backend)
+	if err := s.authorizer.Authorize(principal, "get", path); err != nil {
+		return nil, err
+	}
+	store, err := coordBackend(s.backends, backend, "")
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return nil, backup.NewErrUnprocessable(err)
+	}
+
+	metas, err := s.allBackups(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	resp := make(models.BackupListResponse, 0, len(metas))
+	for _, meta := range metas {
+		resp = append(resp, &models.BackupListResponseItems0{
+			ID:                      meta.ID,
+			Classes:                 meta.Classes(),
+			Status:                  string(meta.Status),
+			IncrementalBaseBackupID: meta.BaseBackupID,
+		})
+	}
+	return &resp, nil
+}
+
+// Cancel cancels a backup which is still in progress.
+// Files which have already been uploaded are deleted.
+func (s *Scheduler) Cancel(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "cancel_backup", backupID, backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s/%s", backend, backupID)
+	if err := s.authorizer.Authorize(principal, "delete", path); err != nil {
+		return err
+	}
+	store, err := coordBackend(s.backends, backend, backupID)
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+	if st := s.backupper.lastOp.get(); st.ID == backupID && st.Path == store.HomeDir() {
+		if s.backupper.Cancel(backupID) {
+			return nil
+		}
+	}
+
+	meta, err := store.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return backup.NewErrNotFound(fmt.Errorf("backup %q not found", backupID))
+		}
+		return backup.NewErrUnprocessable(fmt.Errorf("find backup %q: %w", backupID, err))
+	}
+	switch meta.Status {
+	case backup.Started, backup.Transferring, backup.Transferred:
+	default:
+		return backup.NewErrUnprocessable(
+			fmt.Errorf("backup %q is not in progress, its status is %q", backupID, meta.Status))
+	}
+
+	// only the coordinator can cancel the backup, forward the request to it
+	coordinator := meta.Coordinator
+	if coordinator == "" {
+		return backup.NewErrUnprocessable(fmt.Errorf("backup %q is not coordinated by this node, "+
+			"send the request to the node which created it", backupID))
+	}
+	if coordinator == s.backupper.nodeResolver.LocalName() {
+		return backup.NewErrUnprocessable(fmt.Errorf("backup %q is not in progress on its coordinator %q, "+
+			"its status %q is outdated", backupID, coordinator, meta.Status))
+	}
+	host, ok := s.backupper.nodeResolver.NodeHostname(coordinator)
+	if !ok {
+		return backup.NewErrUnprocessable(
+			fmt.Errorf("coordinator %q of backup %q is not part of the cluster", coordinator, backupID))
+	}
+	req := &AbortRequest{Method: OpCreate, ID: backupID, Backend: backend}
+	if err := s.backupper.client.Cancel(ctx, host, req); err != nil {
+		return backup.NewErrUnprocessable(
+			fmt.Errorf("cancel backup %q on coordinator %q: %w", backupID, coordinator, err))
+	}
+	return nil
+}
+
+// OnCancel cancels a backup coordinated by this node on behalf of
+// another node, which received the cancel request
+func (s *Scheduler) OnCancel(ctx context.Context, req *AbortRequest) error {
+	store, err := coordBackend(s.backends, req.Backend, req.ID)
+	if err != nil {
+		return fmt.Errorf("no backup provider %q: %w", req.Backend, err)
+	}
+	if st := s.backupper.lastOp.get(); st.ID == req.ID && st.Path == store.HomeDir() {
+		if s.backupper.Cancel(req.ID) {
+			return nil
+		}
+	}
+	return fmt.Errorf("backup %q is not in progress on this node", req.ID)
+}
+
+// Delete deletes a backup from the given backend.
+//
+// Backups which are in progress and backups which are the base of
+// an incremental backup cannot be deleted.
+func (s *Scheduler) Delete(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "delete_backup", backupID, backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s/%s", backend, backupID)
+	if err := s.authorizer.Authorize(principal, "delete", path); err != nil {
+		return err
+	}
+	return s.delete(ctx, backend, backupID)
+}
+
+// delete deletes a backup without authorizing the request
+func (s *Scheduler) delete(ctx context.Context, backend, backupID string) error {
+	if err := validateID(backupID); err != nil {
+		return backup.NewErrUnprocessable(err)
+	}
+	store, err := coordBackend(s.backends, backend, backupID)
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+	if s.backupper.lastOp.get().ID == backupID {
+		return backup.NewErrUnprocessable(
+			fmt.Errorf("backup %q is in progress, cancel it before deleting it", backupID))
+	}
+	if s.restorer.lastOp.get().ID == backupID {
+		return backup.NewErrUnprocessable(fmt.Errorf("backup %q is being restored", backupID))
+	}
+
+	if _, err := store.Meta(ctx, GlobalBackupFile); err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return backup.NewErrNotFound(fmt.Errorf("backup %q not found", backupID))
+		}
+		return backup.NewErrUnprocessable(fmt.Errorf("find backup %q: %w", backupID, err))
+	}
+	metas, err := s.allBackups(ctx, store)
+	if err != nil {
+		return err
+	}
+	for _, meta := range metas {
+		if meta.BaseBackupID == backupID {
+			return backup.NewErrUnprocessable(
+				fmt.Errorf("backup %q is the incremental base of backup %q", backupID, meta.ID))
+		}
+	}
+
+	if err := store.b.DeleteBackup(ctx, backupID); err != nil {
+		return fmt.Errorf("delete backup %q: %w", backupID, err)
+	}
+	return nil
+}
+
+// allBackups returns the metadata of all backups stored in the backend of store.
+// Entries without backup metadata are skipped
+func (s *Scheduler) allBackups(ctx context.Context, store coordStore,
+) ([]*backup.DistributedBackupDescriptor, error) {
+	ids, err := store.b.AllBackups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list backups: %w", err)
+	}
+	metas := make([]*backup.DistributedBackupDescriptor, 0, len(ids))
+	for _, id := range ids {
+		st := coordStore{objStore{b: store.b, BasePath: id}}
+		meta, err := st.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			if errors.As(err, &backup.ErrNotFound{}) {
+				continue
+			}
+			return nil, fmt.Errorf("backup %q: %w", id, err)
+		}
+		metas = append(metas, meta)
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].StartedAt.Before(metas[j].StartedAt)
+	})
+	return metas, nil
+}
+
 func coordBackend(provider BackupBackendProvider, backend, id string) (coordStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
@@ -212,6 +417,9 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, errIncludeExclude
 	}
+	if err := req.Compression.Validate(); err != nil {
+		return nil, err
+	}
 	if dup := findDuplicate(req.Include); dup != "" {
 		return nil, fmt.Errorf("class list 'include' contains duplicate: %s", dup)
 	}
@@ -239,9 +447,30 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseBackupID != "" {
+		if err := s.validateBaseBackup(ctx, store, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// validateBaseBackup makes sure the base of an incremental backup has been created successfully
+func (s *Scheduler) validateBaseBackup(ctx context.Context, store coordStore, req *BackupRequest) error {
+	if req.BaseBackupID == req.ID {
+		return fmt.Errorf("backup %q cannot be its own incremental base", req.ID)
+	}
+	base := coordStore{objStore{b: store.b, BasePath: req.BaseBackupID}}
+	meta, err := base.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		return fmt.Errorf("incremental base backup %q: %w", req.BaseBackupID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("incremental base backup %q has status %q", req.BaseBackupID, meta.Status)
+	}
+	return nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.b.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
@@ -271,6 +500,14 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 		return nil, fmt.Errorf("corrupted backup file: %w", err)
 	}
 	cs := meta.Classes()
+	if err := s.validateNodeMapping(meta, req.NodeMapping); err != nil {
+		return nil, err
+	}
+	for from := range req.ClassMapping {
+		if first := meta.AllExist([]string{from}); first != "" {
+			return nil, fmt.Errorf("class mapping: class %s doesn't exist in the backup, but does have %v", first, cs)
+		}
+	}
 	if len(req.Include) > 0 {
 		if first := meta.AllExist(req.Include); first != "" {
 			err = fmt.Errorf("class %s doesn't exist in the backup, but does have %v: ", first, cs)
@@ -283,9 +520,45 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if meta.RemoveEmpty().Count() == 0 {
 		return nil, fmt.Errorf("nothing left to restore: please choose from : %v", cs)
 	}
+	// a renamed class must not collide with a class restored under its original name
+	targets := make(map[string]struct{}, len(req.ClassMapping))
+	for _, to := range req.ClassMapping {
+		targets[to] = struct{}{}
+	}
+	for _, cls := range meta.Classes() {
+		if _, ok := req.ClassMapping[cls]; ok {
+			continue
+		}
+		if _, ok := targets[cls]; ok {
+			return nil, fmt.Errorf("class mapping: class %s is also restored under its original name", cls)
+		}
+	}
 	return meta, nil
 }
 
+// validateNodeMapping makes sure that each node of the backup is restored onto a distinct node of this cluster
+func (s *Scheduler) validateNodeMapping(meta *backup.DistributedBackupDescriptor, nodeMapping map[string]string) error {
+	targets := make(map[string]struct{}, len(nodeMapping))
+	for from, to := range nodeMapping {
+		if _, ok := meta.Nodes[from]; !ok {
+			return fmt.Errorf("node mapping: node %s is not part of the backup", from)
+		}
+		if _, ok := s.restorer.nodeResolver.NodeHostname(to); !ok {
+			return fmt.Errorf("node mapping: unknown node %s", to)
+		}
+		targets[to] = struct{}{}
+	}
+	for node := range meta.Nodes {
+		if _, ok := nodeMapping[node]; ok {
+			continue
+		}
+		if _, ok := targets[node]; ok {
+			return fmt.Errorf("node mapping: node %s would receive the data of multiple nodes", node)
+		}
+	}
+	return nil
+}
+
 func logOperation(logger logrus.FieldLogger, name, id, backend string, begin time.Time, err error) {
 	le := logger.WithField("action", name).
 		WithField("backup_id", id).WithField("backend", backend).
@@ -308,3 +581,11 @@ func findDuplicate(xs []string) string {
 	}
 	return ""
 }
+
+func mapValues(m map[string]string) []string {
+	xs := make([]string, 0, len(m))
+	for _, v := range m {
+		xs = append(xs, v)
+	}
+	return xs
+}
@@ -135,6 +135,46 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+	t.Run("IncrementalBaseNotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "base", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "base", BackupFile).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: "base",
+		})
+
+		assert.Nil(t, meta)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "incremental base backup \"base\"")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("IncrementalBaseFailed", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+		bytes := marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: "base", Status: backup.Failed})
+		fs.backend.On("GetObject", ctx, "base", GlobalBackupFile).Return(bytes, nil)
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: "base",
+		})
+
+		assert.Nil(t, meta)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "has status \"FAILED\"")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -659,6 +699,291 @@ func TestSchedulerRestoreRequestValidation(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), cls)
 	})
+
+	t.Run("ClassMappingUnknownClass", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{nodeName}))
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, ClassMapping: map[string]string{"Unknown": "Other"},
+		})
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "class mapping: class Unknown")
+	})
+
+	t.Run("ClassMappingCollision", func(t *testing.T) {
+		meta := meta
+		meta.Nodes = map[string]*backup.NodeDescriptor{nodeName: {Classes: []string{cls, "Other"}}}
+		fs := newFakeScheduler(newFakeNodeResolver([]string{nodeName}))
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, ClassMapping: map[string]string{cls: "Other"},
+		})
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "class Other is also restored under its original name")
+
+		// no collision if the other class is not restored
+		fs.backend.On("PutObject", any, id, GlobalRestoreFile, any).Return(nil)
+		fs.client.On("CanCommit", any, nodeName, any).Return(nil, ErrAny)
+		fs.client.On("Abort", any, any, any).Return(nil)
+		_, err = fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, Include: []string{cls}, ClassMapping: map[string]string{cls: "Other"},
+		})
+		assert.ErrorContains(t, err, ErrAny.Error())
+	})
+
+	t.Run("NodeMappingUnknownNode", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{nodeName}))
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, NodeMapping: map[string]string{"N9": nodeName},
+		})
+		assert.ErrorContains(t, err, "node N9 is not part of the backup")
+		_, err = fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, NodeMapping: map[string]string{nodeName: "N9"},
+		})
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "unknown node N9")
+	})
+
+	t.Run("NodeMappingCollision", func(t *testing.T) {
+		meta := meta
+		meta.Nodes = map[string]*backup.NodeDescriptor{
+			"N1": {Classes: []string{cls}},
+			"N2": {Classes: []string{cls}},
+		}
+		fs := newFakeScheduler(newFakeNodeResolver([]string{"N1", "N2"}))
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("IsExternal").Return(true)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID: id, NodeMapping: map[string]string{"N1": "N2"},
+		})
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, "node N2 would receive the data of multiple nodes")
+	})
+}
+
+func TestSchedulerListBackups(t *testing.T) {
+	t.Parallel()
+	var (
+		backendName = "s3"
+		ctx         = context.Background()
+		starTime    = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+	)
+	meta := func(id, base string, st backup.Status, startedAt time.Time) []byte {
+		return marshalCoordinatorMeta(backup.DistributedBackupDescriptor{
+			ID: id, BaseBackupID: base, StartedAt: startedAt, Status: st,
+			Nodes: map[string]*backup.NodeDescriptor{"N1": {Classes: []string{"C1"}}},
+		})
+	}
+
+	t.Run("ListBackups", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("AllBackups", ctx).Return([]string{"b2", "b1", "offload"}, nil)
+		fs.backend.On("GetObject", ctx, "b1", GlobalBackupFile).
+			Return(meta("b1", "", backup.Success, starTime), nil)
+		fs.backend.On("GetObject", ctx, "b2", GlobalBackupFile).
+			Return(meta("b2", "b1", backup.Cancelled, starTime.Add(time.Hour)), nil)
+		fs.backend.On("GetObject", ctx, "offload", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "offload", BackupFile).Return(nil, backup.ErrNotFound{})
+
+		got, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.Nil(t, err)
+		want := &models.BackupListResponse{
+			{ID: "b1", Classes: []string{"C1"}, Status: string(backup.Success)},
+			{ID: "b2", Classes: []string{"C1"}, Status: string(backup.Cancelled), IncrementalBaseBackupID: "b1"},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("AllBackups", ctx).Return(nil, ErrAny)
+		_, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.ErrorIs(t, err, ErrAny)
+	})
+}
+
+func TestSchedulerCancelBackup(t *testing.T) {
+	t.Parallel()
+	var (
+		backendName = "s3"
+		id          = "1234"
+		ctx         = context.Background()
+		path        = "bucket/backups/" + id
+	)
+
+	t.Run("InProgress", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("HomeDir", id).Return(path)
+		s := fs.scheduler()
+		s.backupper.lastOp.renew(id, path)
+		opCtx := s.backupper.lastOp.withCancel(id)
+
+		err := s.Cancel(ctx, nil, backendName, id)
+		assert.Nil(t, err)
+		assert.ErrorIs(t, opCtx.Err(), context.Canceled)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("HomeDir", id).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+
+		err := fs.scheduler().Cancel(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("AlreadyCompleted", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("HomeDir", id).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).
+			Return(marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: id, Status: backup.Success}), nil)
+
+		err := fs.scheduler().Cancel(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), string(backup.Success))
+	})
+
+	t.Run("ForwardedToCoordinator", func(t *testing.T) {
+		fs := newFakeScheduler(&fakeNodeResolver{hosts: map[string]string{"N2": "host2"}, local: "N1"})
+		fs.backend.On("HomeDir", id).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: id, Status: backup.Started, Coordinator: "N2"}), nil)
+		req := &AbortRequest{Method: OpCreate, ID: id, Backend: backendName}
+		fs.client.On("Cancel", ctx, "host2", req).Return(nil)
+
+		err := fs.scheduler().Cancel(ctx, nil, backendName, id)
+		assert.Nil(t, err)
+		fs.client.AssertCalled(t, "Cancel", ctx, "host2", req)
+	})
+
+	t.Run("CoordinatorFailsToCancel", func(t *testing.T) {
+		fs := newFakeScheduler(&fakeNodeResolver{hosts: map[string]string{"N2": "host2"}, local: "N1"})
+		fs.backend.On("HomeDir", id).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: id, Status: backup.Transferring, Coordinator: "N2"}), nil)
+		fs.client.On("Cancel", ctx, "host2", mock.Anything).Return(errors.New("not in progress"))
+
+		err := fs.scheduler().Cancel(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "coordinator \"N2\"")
+	})
+
+	t.Run("OutdatedStatus", func(t *testing.T) {
+		fs := newFakeScheduler(&fakeNodeResolver{local: "N1"})
+		fs.backend.On("HomeDir", id).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: id, Status: backup.Started, Coordinator: "N1"}), nil)
+
+		err := fs.scheduler().Cancel(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "outdated")
+		fs.client.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownCoordinator", func(t *testing.T) {
+		fs := newFakeScheduler(&fakeNodeResolver{local: "N1"})
+		fs.backend.On("HomeDir", id).Return(path)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: id, Status: backup.Started}), nil)
+
+		err := fs.scheduler().Cancel(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "not coordinated by this node")
+	})
+}
+
+func TestSchedulerOnCancel(t *testing.T) {
+	var (
+		backendName = "s3"
+		id          = "1234"
+		ctx         = context.Background()
+		path        = "bucket/backups/" + id
+		req         = &AbortRequest{Method: OpCreate, ID: id, Backend: backendName}
+	)
+
+	t.Run("InProgress", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("HomeDir", id).Return(path)
+		s := fs.scheduler()
+		s.backupper.lastOp.renew(id, path)
+		opCtx := s.backupper.lastOp.withCancel(id)
+
+		assert.Nil(t, s.OnCancel(ctx, req))
+		assert.ErrorIs(t, opCtx.Err(), context.Canceled)
+	})
+
+	t.Run("NotInProgress", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("HomeDir", id).Return(path)
+
+		err := fs.scheduler().OnCancel(ctx, req)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not in progress")
+	})
+}
+
+func TestSchedulerDeleteBackup(t *testing.T) {
+	t.Parallel()
+	var (
+		backendName = "s3"
+		id          = "1234"
+		ctx         = context.Background()
+		path        = "bucket/backups/" + id
+		successMeta = marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: id, Status: backup.Success})
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(successMeta, nil)
+		fs.backend.On("AllBackups", ctx).Return([]string{id}, nil)
+		fs.backend.On("DeleteBackup", ctx, id).Return(nil)
+
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.Nil(t, err)
+		fs.backend.AssertCalled(t, "DeleteBackup", ctx, id)
+	})
+
+	t.Run("InProgress", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		s := fs.scheduler()
+		s.backupper.lastOp.renew(id, path)
+
+		err := s.Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "in progress")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, id, BackupFile).Return(nil, backup.ErrNotFound{})
+
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("IncrementalBase", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(successMeta, nil)
+		fs.backend.On("GetObject", ctx, "incr", GlobalBackupFile).Return(
+			marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: "incr", BaseBackupID: id}), nil)
+		fs.backend.On("AllBackups", ctx).Return([]string{id, "incr"}, nil)
+
+		err := fs.scheduler().Delete(ctx, nil, backendName, id)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "incr")
+		fs.backend.AssertNotCalled(t, "DeleteBackup", ctx, id)
+	})
 }
 
 type fakeScheduler struct {
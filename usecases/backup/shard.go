@@ -35,6 +35,8 @@ type reqStat struct {
 type backupStat struct {
 	sync.Mutex
 	reqStat
+	// cancel stops the running operation if it supports cancellation
+	cancel context.CancelFunc
 }
 
 func (s *backupStat) get() reqStat {
@@ -63,9 +65,38 @@ func (s *backupStat) reset() {
 	s.reqStat.ID = ""
 	s.reqStat.Path = ""
 	s.reqStat.Status = ""
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
 	s.Unlock()
 }
 
+// withCancel returns a context which is cancelled by cancelOp(id) or reset()
+func (s *backupStat) withCancel(id string) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Lock()
+	defer s.Unlock()
+	if s.reqStat.ID != id {
+		cancel()
+		return ctx
+	}
+	s.cancel = cancel
+	return ctx
+}
+
+// cancelOp cancels the running operation with the given id.
+// It returns false if no such operation is running
+func (s *backupStat) cancelOp(id string) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.reqStat.ID != id || s.cancel == nil {
+		return false
+	}
+	s.cancel()
+	return true
+}
+
 func (s *backupStat) set(st backup.Status) {
 	s.Lock()
 	s.reqStat.Status = st
@@ -27,6 +27,8 @@ type client interface {
 	Status(_ context.Context, node string, _ *StatusRequest) (*StatusResponse, error)
 	// Abort tells a node to abort the previous backup operation
 	Abort(_ context.Context, node string, _ *AbortRequest) error
+	// Cancel tells the coordinator of a backup to cancel it
+	Cancel(_ context.Context, node string, _ *AbortRequest) error
 }
 
 type Request struct {
@@ -42,6 +44,15 @@ type Request struct {
 
 	// Duration
 	Duration time.Duration
+
+	// Compression configures how shard files are compressed (create only)
+	Compression Compression
+	// BaseBackupID is the base of an incremental backup (create only)
+	BaseBackupID string
+	// ClassMapping maps classes of the backup to new class names (restore only)
+	ClassMapping map[string]string
+	// NodeMapping maps nodes of the backup to nodes of this cluster (restore only)
+	NodeMapping map[string]string
 }
 
 type CanCommitResponse struct {
@@ -62,8 +62,8 @@ func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	return ss.Physical[shard].BelongsToNodes[0], nil
 }
 
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string {
-	return tenant
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
 }
 
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
@@ -409,7 +409,7 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
+	shardName string, vector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -551,6 +551,18 @@ func (c *fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int,
+) ([]uint64, error) {
+	return nil, nil
+}
+
+func (c *fakeReplicationClient) DigestObjectsInRange(ctx context.Context,
+	host, index, shard string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeReplicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
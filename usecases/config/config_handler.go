@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
@@ -68,33 +69,38 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string         `json:"name" yaml:"name"`
-	Debug                               bool           `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
-	Contextionary                       Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                              string         `json:"origin" yaml:"origin"`
-	Persistence                         Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string         `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath                         string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema                          AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config `json:"cluster" yaml:"cluster"`
-	Monitoring                          Monitoring     `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC           `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling      `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int            `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	TrackVectorDimensions               bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup    bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	RecountPropertiesAtStartup          bool           `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	IndexMissingTextFilterableAtStartup bool           `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
-	DisableGraphQL                      bool           `json:"disable_graphql" yaml:"disable_graphql"`
+	Name                                string              `json:"name" yaml:"name"`
+	Debug                               bool                `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults       `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64               `json:"query_maximum_results" yaml:"query_maximum_results"`
+	Contextionary                       Contextionary       `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication      `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization       `json:"authorization" yaml:"authorization"`
+	Origin                              string              `json:"origin" yaml:"origin"`
+	Persistence                         Persistence         `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string              `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string              `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string              `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath                         string              `json:"modules_path" yaml:"modules_path"`
+	AutoSchema                          AutoSchema          `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config      `json:"cluster" yaml:"cluster"`
+	Monitoring                          Monitoring          `json:"monitoring" yaml:"monitoring"`
+	GRPC                                GRPC                `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling           `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage       `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64             `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int                 `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	TrackVectorDimensions               bool                `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ReindexVectorDimensionsAtStartup    bool                `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	RecountPropertiesAtStartup          bool                `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool                `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	IndexMissingTextFilterableAtStartup bool                `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	ReindexTokenizationAtStartup        map[string][]string `json:"reindex_tokenization_at_startup" yaml:"reindex_tokenization_at_startup"`
+	DisableGraphQL                      bool                `json:"disable_graphql" yaml:"disable_graphql"`
+	TenantOffload                       TenantOffload       `json:"tenant_offload" yaml:"tenant_offload"`
+	BackupSchedule                      BackupSchedule      `json:"backup_schedule" yaml:"backup_schedule"`
+	ChangeStream                        ChangeStream        `json:"change_stream" yaml:"change_stream"`
+	AntiEntropy                         AntiEntropy         `json:"anti_entropy" yaml:"anti_entropy"`
 }
 
 type moduleProvider interface {
@@ -176,6 +182,65 @@ type GRPC struct {
 	Port int `json:"port" yaml:"port"`
 }
 
+// TenantOffload configures where the files of FROZEN tenants are stored.
+// Backend is the name of a backup backend module, e.g. "s3" or "gcs"
+type TenantOffload struct {
+	Backend string `json:"backend" yaml:"backend"`
+}
+
+// ChangeStream configures the change data capture stream of object mutations.
+// Every shard keeps its latest MaxEventsPerShard events, so that consumers can
+// resume the stream from a sequence number after they disconnected.
+type ChangeStream struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	MaxEventsPerShard int  `json:"max_events_per_shard" yaml:"max_events_per_shard"`
+}
+
+// AntiEntropy configures the background repair of replicated shards. Every
+// Interval, each node compares its replicas with the other replicas of the
+// same shards and pushes the objects which are missing or stale on them.
+type AntiEntropy struct {
+	Enabled  bool          `json:"enabled" yaml:"enabled"`
+	Interval time.Duration `json:"interval" yaml:"interval"`
+}
+
+// BackupSchedule configures backups which are created periodically.
+// Cron is a standard cron expression which is evaluated in UTC, e.g. "0 3 * * *".
+// Old scheduled backups are deleted once there are more than RetentionCount of
+// them or once they are older than RetentionMaxAge. Zero values disable either limit.
+type BackupSchedule struct {
+	Cron            string        `json:"cron" yaml:"cron"`
+	Backend         string        `json:"backend" yaml:"backend"`
+	Include         []string      `json:"include" yaml:"include"`
+	Exclude         []string      `json:"exclude" yaml:"exclude"`
+	RetentionCount  int           `json:"retention_count" yaml:"retention_count"`
+	RetentionMaxAge time.Duration `json:"retention_max_age" yaml:"retention_max_age"`
+}
+
+// Enabled returns true if backups should be created periodically
+func (b BackupSchedule) Enabled() bool {
+	return b.Cron != ""
+}
+
+func (b BackupSchedule) Validate() error {
+	if !b.Enabled() {
+		return nil
+	}
+	if b.Backend == "" {
+		return fmt.Errorf("backup_schedule.backend must be set")
+	}
+	if len(b.Include) > 0 && len(b.Exclude) > 0 {
+		return fmt.Errorf("backup_schedule.include and backup_schedule.exclude are mutually exclusive")
+	}
+	if b.RetentionCount < 0 {
+		return fmt.Errorf("backup_schedule.retention_count must not be negative")
+	}
+	if b.RetentionMaxAge < 0 {
+		return fmt.Errorf("backup_schedule.retention_max_age must not be negative")
+	}
+	return nil
+}
+
 type Profiling struct {
 	BlockProfileRate     int `json:"blockProfileRate" yaml:"blockProfileRate"`
 	MutexProfileFraction int `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
@@ -187,6 +252,7 @@ type Persistence struct {
 	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
 	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
 	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	ObjectsCompression                string `json:"objectsCompression" yaml:"objectsCompression"`
 }
 
 func (p Persistence) Validate() error {
@@ -194,6 +260,13 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	switch p.ObjectsCompression {
+	case "", "none", "snappy", "zstd":
+	default:
+		return fmt.Errorf("persistence.objectsCompression must be one of none, snappy or zstd, got %q",
+			p.ObjectsCompression)
+	}
+
 	return nil
 }
 
@@ -321,6 +394,10 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
+	if err := f.Config.BackupSchedule.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -67,6 +68,14 @@ func FromEnv(config *Config) error {
 		config.IndexMissingTextFilterableAtStartup = true
 	}
 
+	if v := os.Getenv("REINDEX_TOKENIZATION_AT_STARTUP"); v != "" {
+		class2Props, err := parseClassProperties(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse REINDEX_TOKENIZATION_AT_STARTUP")
+		}
+		config.ReindexTokenizationAtStartup = class2Props
+	}
+
 	if v := os.Getenv("PROMETHEUS_MONITORING_PORT"); v != "" {
 		asInt, err := strconv.Atoi(v)
 		if err != nil {
@@ -136,6 +145,12 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	// roles and their assignments are too structured to be set through the
+	// environment, they need to be defined in the config file
+	if enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+	}
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -269,6 +284,66 @@ func FromEnv(config *Config) error {
 	}
 
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
+
+	if v := os.Getenv("TENANT_OFFLOAD_BACKEND"); v != "" {
+		config.TenantOffload.Backend = v
+	}
+
+	if err := parseBackupScheduleEnvVars(&config.BackupSchedule); err != nil {
+		return err
+	}
+
+	config.ChangeStream.Enabled = enabled(os.Getenv("CHANGE_STREAM_ENABLED"))
+	if err := parsePositiveInt(
+		"CHANGE_STREAM_MAX_EVENTS_PER_SHARD",
+		func(val int) { config.ChangeStream.MaxEventsPerShard = val },
+		DefaultChangeStreamMaxEventsPerShard,
+	); err != nil {
+		return err
+	}
+
+	config.AntiEntropy.Enabled = enabled(os.Getenv("REPLICATION_ANTI_ENTROPY_ENABLED"))
+	config.AntiEntropy.Interval = DefaultAntiEntropyInterval
+	if v := os.Getenv("REPLICATION_ANTI_ENTROPY_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse REPLICATION_ANTI_ENTROPY_INTERVAL as duration")
+		}
+		if d <= 0 {
+			return errors.Errorf("REPLICATION_ANTI_ENTROPY_INTERVAL must be positive, got %s", v)
+		}
+		config.AntiEntropy.Interval = d
+	}
+	return nil
+}
+
+func parseBackupScheduleEnvVars(b *BackupSchedule) error {
+	if v := os.Getenv("BACKUP_SCHEDULE_CRON"); v != "" {
+		b.Cron = v
+	}
+	if v := os.Getenv("BACKUP_SCHEDULE_BACKEND"); v != "" {
+		b.Backend = v
+	}
+	if v := os.Getenv("BACKUP_SCHEDULE_INCLUDE"); v != "" {
+		b.Include = strings.Split(v, ",")
+	}
+	if v := os.Getenv("BACKUP_SCHEDULE_EXCLUDE"); v != "" {
+		b.Exclude = strings.Split(v, ",")
+	}
+	if v := os.Getenv("BACKUP_SCHEDULE_RETENTION_COUNT"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse BACKUP_SCHEDULE_RETENTION_COUNT as int")
+		}
+		b.RetentionCount = asInt
+	}
+	if v := os.Getenv("BACKUP_SCHEDULE_RETENTION_MAX_AGE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse BACKUP_SCHEDULE_RETENTION_MAX_AGE as duration")
+		}
+		b.RetentionMaxAge = d
+	}
 	return nil
 }
 
@@ -315,6 +390,10 @@ func (c *Config) parseMemtableConfig() error {
 		return err
 	}
 
+	if v := os.Getenv("PERSISTENCE_OBJECTS_COMPRESSION"); v != "" {
+		c.Persistence.ObjectsCompression = v
+	}
+
 	return nil
 }
 
@@ -344,6 +423,8 @@ const (
 	DefaultPersistenceMemtablesMaxDuration    = 45
 	DefaultMaxConcurrentGetRequests           = 0
 	DefaultGRPCPort                           = 50051
+	DefaultChangeStreamMaxEventsPerShard      = 100000
+	DefaultAntiEntropyInterval                = 5 * time.Minute
 )
 
 const VectorizerModuleNone = "none"
@@ -355,6 +436,25 @@ const DefaultGossipBindPort = 7946
 // TODO: This should be retrieved dynamically from all installed modules
 const VectorizerModuleText2VecContextionary = "text2vec-contextionary"
 
+// parseClassProperties parses a comma separated list of properties in the form
+// of "Class.property" and groups them by class name
+func parseClassProperties(value string) (map[string][]string, error) {
+	class2Props := map[string][]string{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		className, propName, ok := strings.Cut(entry, ".")
+		if !ok || className == "" || propName == "" {
+			return nil, fmt.Errorf("invalid property %q, expected format Class.property", entry)
+		}
+		class2Props[className] = append(class2Props[className], propName)
+	}
+	return class2Props, nil
+}
+
 func enabled(value string) bool {
 	if value == "" {
 		return false
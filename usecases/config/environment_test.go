@@ -15,6 +15,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -217,6 +218,29 @@ func TestEnvironmentMemtable_MaxDuration(t *testing.T) {
 	}
 }
 
+func TestEnvironmentObjectsCompression(t *testing.T) {
+	factors := []struct {
+		name     string
+		value    []string
+		expected string
+	}{
+		{"Valid", []string{"zstd"}, "zstd"},
+		{"not given", []string{}, ""},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_OBJECTS_COMPRESSION", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.Persistence.ObjectsCompression)
+		})
+	}
+}
+
 func TestEnvironmentParseClusterConfig(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -472,3 +496,158 @@ func TestEnvironmentPrometheusGroupClasses_NewName(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentTenantOffloadBackend(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		FromEnv(&conf)
+		require.Equal(t, "", conf.TenantOffload.Backend)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("TENANT_OFFLOAD_BACKEND", "s3")
+		conf := Config{}
+		FromEnv(&conf)
+		require.Equal(t, "s3", conf.TenantOffload.Backend)
+	})
+}
+
+func TestEnvironmentBackupSchedule(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.BackupSchedule.Enabled())
+		assert.Nil(t, conf.BackupSchedule.Validate())
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("BACKUP_SCHEDULE_CRON", "0 3 * * *")
+		t.Setenv("BACKUP_SCHEDULE_BACKEND", "s3")
+		t.Setenv("BACKUP_SCHEDULE_EXCLUDE", "Article,Author")
+		t.Setenv("BACKUP_SCHEDULE_RETENTION_COUNT", "7")
+		t.Setenv("BACKUP_SCHEDULE_RETENTION_MAX_AGE", "168h")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BackupSchedule{
+			Cron:            "0 3 * * *",
+			Backend:         "s3",
+			Exclude:         []string{"Article", "Author"},
+			RetentionCount:  7,
+			RetentionMaxAge: 7 * 24 * time.Hour,
+		}, conf.BackupSchedule)
+		assert.True(t, conf.BackupSchedule.Enabled())
+		assert.Nil(t, conf.BackupSchedule.Validate())
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		for name, value := range map[string]string{
+			"BACKUP_SCHEDULE_RETENTION_COUNT":   "many",
+			"BACKUP_SCHEDULE_RETENTION_MAX_AGE": "7 days",
+		} {
+			t.Run(name, func(t *testing.T) {
+				t.Setenv(name, value)
+				require.NotNil(t, FromEnv(&Config{}))
+			})
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		valid := BackupSchedule{Cron: "@daily", Backend: "gcs"}
+		assert.Nil(t, valid.Validate())
+		for name, invalid := range map[string]BackupSchedule{
+			"missing backend":     {Cron: "@daily"},
+			"include and exclude": {Cron: "@daily", Backend: "gcs", Include: []string{"A"}, Exclude: []string{"B"}},
+			"negative count":      {Cron: "@daily", Backend: "gcs", RetentionCount: -1},
+			"negative age":        {Cron: "@daily", Backend: "gcs", RetentionMaxAge: -time.Hour},
+		} {
+			assert.NotNil(t, invalid.Validate(), name)
+		}
+	})
+}
+
+func TestEnvironmentChangeStream(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.ChangeStream.Enabled)
+		assert.Equal(t, DefaultChangeStreamMaxEventsPerShard, conf.ChangeStream.MaxEventsPerShard)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("CHANGE_STREAM_ENABLED", "true")
+		t.Setenv("CHANGE_STREAM_MAX_EVENTS_PER_SHARD", "500")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.ChangeStream.Enabled)
+		assert.Equal(t, 500, conf.ChangeStream.MaxEventsPerShard)
+	})
+
+	t.Run("invalid max events", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("CHANGE_STREAM_MAX_EVENTS_PER_SHARD", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentAntiEntropy(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.AntiEntropy.Enabled)
+		assert.Equal(t, DefaultAntiEntropyInterval, conf.AntiEntropy.Interval)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("REPLICATION_ANTI_ENTROPY_ENABLED", "true")
+		t.Setenv("REPLICATION_ANTI_ENTROPY_INTERVAL", "30s")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.AntiEntropy.Enabled)
+		assert.Equal(t, 30*time.Second, conf.AntiEntropy.Interval)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		for _, v := range []string{"soon", "-1m", "0s"} {
+			os.Clearenv()
+			t.Setenv("REPLICATION_ANTI_ENTROPY_INTERVAL", v)
+			conf := Config{}
+			require.NotNil(t, FromEnv(&conf), v)
+		}
+	})
+}
+
+func TestEnvironmentReindexTokenization(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		os.Clearenv()
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Empty(t, conf.ReindexTokenizationAtStartup)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		os.Clearenv()
+		t.Setenv("REINDEX_TOKENIZATION_AT_STARTUP", "Article.title, Article.body,Paragraph.content")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, map[string][]string{
+			"Article":   {"title", "body"},
+			"Paragraph": {"content"},
+		}, conf.ReindexTokenizationAtStartup)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, v := range []string{"Article", "Article.", ".title"} {
+			os.Clearenv()
+			t.Setenv("REINDEX_TOKENIZATION_AT_STARTUP", v)
+			conf := Config{}
+			require.NotNil(t, FromEnv(&conf), v)
+		}
+	})
+}
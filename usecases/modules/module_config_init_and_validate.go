@@ -23,6 +23,24 @@ import (
 // SetClassDefaults sets the module-specific defaults for the class itself, but
 // also for each prop
 func (p *Provider) SetClassDefaults(class *models.Class) {
+	for targetVector, vc := range class.VectorConfig {
+		view, err := namedVectorClass(class, targetVector)
+		if err != nil {
+			continue
+		}
+		p.setClassDefaults(view)
+		if view.Vectorizer != "none" {
+			vc.Vectorizer = map[string]interface{}{
+				view.Vectorizer: view.ModuleConfig.(map[string]interface{})[view.Vectorizer],
+			}
+			class.VectorConfig[targetVector] = vc
+		}
+	}
+
+	p.setClassDefaults(class)
+}
+
+func (p *Provider) setClassDefaults(class *models.Class) {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return
@@ -45,6 +63,18 @@ func (p *Provider) SetClassDefaults(class *models.Class) {
 // as part of merging in a ref prop after a class has already been created
 func (p *Provider) SetSinglePropertyDefaults(class *models.Class,
 	prop *models.Property,
+) {
+	for targetVector := range class.VectorConfig {
+		if view, err := namedVectorClass(class, targetVector); err == nil {
+			p.setSinglePropertyDefaults(view, prop)
+		}
+	}
+
+	p.setSinglePropertyDefaults(class, prop)
+}
+
+func (p *Provider) setSinglePropertyDefaults(class *models.Class,
+	prop *models.Property,
 ) {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
@@ -99,7 +129,11 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 	userSpecified := make(map[string]interface{})
 
 	if prop.ModuleConfig != nil {
-		userSpecified = prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{})
+		// with named vectors a property may only be configured for some of
+		// the vectorizers of the class
+		if cfg, ok := prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{}); ok {
+			userSpecified = cfg
+		}
 	}
 
 	for key, value := range modDefaults {
@@ -117,6 +151,20 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 }
 
 func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error {
+	for targetVector := range class.VectorConfig {
+		view, err := namedVectorClass(class, targetVector)
+		if err != nil {
+			return err
+		}
+		if err := p.validateClass(ctx, view); err != nil {
+			return errors.Wrapf(err, "named vector %q", targetVector)
+		}
+	}
+
+	return p.validateClass(ctx, class)
+}
+
+func (p *Provider) validateClass(ctx context.Context, class *models.Class) error {
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return nil
@@ -257,7 +257,7 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 	moduleType modulecapabilities.ModuleType,
 ) bool {
 	if p.isVectorizerModule(moduleType) {
-		return class.Vectorizer == module
+		return class.Vectorizer == module || usesNamedVectorizer(class, module)
 	}
 	if moduleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
 		existsConfigForModule := moduleConfig[module] != nil
@@ -603,15 +603,20 @@ func (p *Provider) RestApiAdditionalProperties(includeProp string, class *models
 }
 
 // VectorFromSearchParam gets a vector for a given argument. This is used in
-// Get { Class() } for example
+// Get { Class() } for example. For classes with named vectors the argument is
+// vectorized by the vectorizer of the target vector.
 func (p *Provider) VectorFromSearchParam(ctx context.Context,
-	className string, param string, params interface{},
+	className, targetVector string, param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn, tenant string,
 ) ([]float32, error) {
 	class, err := p.getClass(className)
 	if err != nil {
 		return nil, err
 	}
+	class, err = targetVectorClass(class, targetVector)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, mod := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
@@ -668,12 +673,16 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 }
 
 func (p *Provider) VectorFromInput(ctx context.Context,
-	className string, input string,
+	className, targetVector string, input string,
 ) ([]float32, error) {
 	class, err := p.getClass(className)
 	if err != nil {
 		return nil, err
 	}
+	class, err = targetVectorClass(class, targetVector)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, mod := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
@@ -531,6 +531,14 @@ func (m *dummyBackupModuleWithAltNames) PutObject(ctx context.Context, backupID,
 	return nil
 }
 
+func (m *dummyBackupModuleWithAltNames) AllBackups(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (m *dummyBackupModuleWithAltNames) DeleteBackup(ctx context.Context, backupID string) error {
+	return nil
+}
+
 func (m *dummyBackupModuleWithAltNames) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// namedVectorClass returns a shallow copy of the class as it is seen by the
+// vectorizer of a named vector: the vectorizer, its module config and the
+// vector index config are the ones of the named vector. This allows to reuse
+// all the class based module logic for each of the named vectors.
+func namedVectorClass(class *models.Class, targetVector string) (*models.Class, error) {
+	vc, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("class %s does not have named vector %q",
+			class.Class, targetVector)
+	}
+
+	moduleName, moduleCfg := schema.NamedVectorizer(vc)
+	if moduleName == "" {
+		moduleName = config.VectorizerModuleNone
+	}
+
+	moduleConfig := map[string]interface{}{}
+	if classModuleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		for name, cfg := range classModuleConfig {
+			moduleConfig[name] = cfg
+		}
+	}
+	if moduleName != config.VectorizerModuleNone {
+		if moduleCfg == nil {
+			moduleCfg = map[string]interface{}{}
+		}
+		moduleConfig[moduleName] = moduleCfg
+	}
+
+	view := *class
+	view.Vectorizer = moduleName
+	view.ModuleConfig = moduleConfig
+	view.VectorIndexType = vc.VectorIndexType
+	view.VectorIndexConfig = vc.VectorIndexConfig
+	view.VectorConfig = nil
+	return &view, nil
+}
+
+// targetVectorClass returns the class as it is seen by the vectorizer of the
+// given target vector. Classes without named vectors are returned unchanged.
+func targetVectorClass(class *models.Class, targetVector string) (*models.Class, error) {
+	if len(class.VectorConfig) == 0 {
+		if targetVector != "" {
+			return nil, fmt.Errorf("class %s does not have named vectors, "+
+				"cannot use target vector %q", class.Class, targetVector)
+		}
+		return class, nil
+	}
+
+	if targetVector == "" {
+		targetVector = schema.DefaultTargetVector(class)
+		if targetVector == "" {
+			return nil, fmt.Errorf("class %s has multiple named vectors, "+
+				"a target vector needs to be specified", class.Class)
+		}
+	}
+
+	return namedVectorClass(class, targetVector)
+}
+
+// usesNamedVectorizer returns true if any of the named vectors of the class is
+// vectorized by the given module
+func usesNamedVectorizer(class *models.Class, module string) bool {
+	for _, vc := range class.VectorConfig {
+		if moduleName, _ := schema.NamedVectorizer(vc); moduleName == module {
+			return true
+		}
+	}
+	return false
+}
@@ -68,7 +68,7 @@ func TestModulesWithSearchers(t *testing.T) {
 		)
 		p.Init(context.Background(), nil, logger)
 
-		res, err := p.VectorFromSearchParam(context.Background(), "MyClass",
+		res, err := p.VectorFromSearchParam(context.Background(), "MyClass", "",
 			"nearGrape", nil, fakeFindVector, "")
 
 		require.Nil(t, err)
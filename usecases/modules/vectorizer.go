@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -29,8 +30,8 @@ const (
 	errorVectorizerCapability = "module %q exists, but does not provide the " +
 		"Vectorizer or ReferenceVectorizer capability"
 
-	errorVectorIndexType = "vector index config (%T) is not of type HNSW, " +
-		"but objects manager is restricted to HNSW"
+	errorVectorIndexType = "vector index config (%T) is not of type HNSW or flat, " +
+		"but objects manager is restricted to HNSW and flat"
 
 	warningVectorIgnored = "This vector will be ignored. If you meant to index " +
 		"the vector, make sure to set vectorIndexConfig.skip to 'false'. If the previous " +
@@ -65,6 +66,16 @@ func (p *Provider) UsingRef2Vec(className string) bool {
 		return false
 	}
 
+	for targetVector := range class.VectorConfig {
+		if view, err := namedVectorClass(class, targetVector); err == nil && p.usingRef2Vec(view) {
+			return true
+		}
+	}
+
+	return p.usingRef2Vec(class)
+}
+
+func (p *Provider) usingRef2Vec(class *models.Class) bool {
 	cfg := class.ModuleConfig
 	if cfg == nil {
 		return false
@@ -84,13 +95,69 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
+	if len(class.VectorConfig) > 0 {
+		return p.updateNamedVectors(ctx, object, class, objectDiff, findObjectFn, logger)
+	}
+
+	return p.updateVector(ctx, object, class, objectDiff, findObjectFn, logger)
+}
+
+// updateNamedVectors vectorizes each named vector of the object which was not
+// provided by the user. Every named vector is vectorized by its own module, so
+// the object is vectorized once per named vector.
+func (p *Provider) updateNamedVectors(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	for targetVector := range class.VectorConfig {
+		view, err := namedVectorClass(class, targetVector)
+		if err != nil {
+			return err
+		}
+
+		named := *object
+		named.Vector = object.Vectors[targetVector]
+		named.Vectors = nil
+
+		var diff *moduletools.ObjectDiff
+		if objectDiff != nil {
+			diff = objectDiff.ForTargetVector(targetVector)
+		}
+
+		if err := p.updateVector(ctx, &named, view, diff, findObjectFn, logger); err != nil {
+			return fmt.Errorf("named vector %q: %w", targetVector, err)
+		}
+
+		if len(named.Vector) > 0 {
+			if object.Vectors == nil {
+				object.Vectors = models.Vectors{}
+			}
+			object.Vectors[targetVector] = named.Vector
+		}
+		if named.Additional != nil {
+			object.Additional = named.Additional
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) updateVector(ctx context.Context, object *models.Object, class *models.Class,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) error {
+	var skip bool
+	switch vectorIndexConfig := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		skip = vectorIndexConfig.Skip
+	case flat.UserConfig:
+		// the flat index cannot be skipped
+	default:
 		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
 	}
 
 	if class.Vectorizer == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
@@ -98,7 +165,7 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 		return nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", class.Vectorizer).
 			Warningf(warningSkipVectorGenerated, class.Vectorizer)
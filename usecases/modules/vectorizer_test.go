@@ -250,12 +250,115 @@ func TestProvider_UpdateVector(t *testing.T) {
 
 		obj := &models.Object{Class: className, ID: newUUID()}
 		err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
-		expectedErr := "vector index config (struct {}) is not of type HNSW, " +
-			"but objects manager is restricted to HNSW"
+		expectedErr := "vector index config (struct {}) is not of type HNSW or flat, " +
+			"but objects manager is restricted to HNSW and flat"
 		assert.EqualError(t, err, expectedErr)
 	})
 }
 
+func TestProvider_UpdateNamedVectors(t *testing.T) {
+	ctx := context.Background()
+	modName := "some-vzr"
+	className := "SomeClass"
+	class := &models.Class{
+		Class:             className,
+		Vectorizer:        "none",
+		VectorIndexConfig: hnsw.UserConfig{Skip: true},
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:        map[string]interface{}{modName: map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.UserConfig{},
+			},
+			"custom": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: hnsw.UserConfig{},
+			},
+		},
+	}
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{class},
+	}}
+	repo := &fakeObjectsRepo{}
+	logger, _ := test.NewNullLogger()
+
+	p := NewProvider()
+	p.Register(newDummyModule(modName, modulecapabilities.Text2Vec))
+	p.SetSchemaGetter(&fakeSchemaGetter{sch})
+
+	obj := &models.Object{
+		Class:   className,
+		ID:      newUUID(),
+		Vectors: models.Vectors{"custom": []float32{4, 5}},
+	}
+	err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
+	assert.Nil(t, err)
+	assert.Nil(t, obj.Vector)
+	assert.Equal(t, models.Vectors{
+		"title":  []float32{1, 2, 3},
+		"custom": []float32{4, 5},
+	}, obj.Vectors)
+}
+
+func TestTargetVectorClass(t *testing.T) {
+	class := &models.Class{
+		Class:        "SomeClass",
+		ModuleConfig: map[string]interface{}{"other-module": map[string]interface{}{}},
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer: map[string]interface{}{
+					"some-vzr": map[string]interface{}{"vectorizeClassName": false},
+				},
+				VectorIndexType:   "flat",
+				VectorIndexConfig: hnsw.UserConfig{Distance: "dot"},
+			},
+			"custom": {},
+		},
+	}
+
+	t.Run("named vector", func(t *testing.T) {
+		view, err := targetVectorClass(class, "title")
+		assert.Nil(t, err)
+		assert.Equal(t, "some-vzr", view.Vectorizer)
+		assert.Equal(t, "flat", view.VectorIndexType)
+		assert.Equal(t, hnsw.UserConfig{Distance: "dot"}, view.VectorIndexConfig)
+		assert.Equal(t, map[string]interface{}{
+			"other-module": map[string]interface{}{},
+			"some-vzr":     map[string]interface{}{"vectorizeClassName": false},
+		}, view.ModuleConfig)
+		assert.Nil(t, view.VectorConfig)
+	})
+
+	t.Run("named vector without vectorizer", func(t *testing.T) {
+		view, err := targetVectorClass(class, "custom")
+		assert.Nil(t, err)
+		assert.Equal(t, "none", view.Vectorizer)
+	})
+
+	t.Run("missing target vector", func(t *testing.T) {
+		_, err := targetVectorClass(class, "")
+		assert.EqualError(t, err, "class SomeClass has multiple named vectors, "+
+			"a target vector needs to be specified")
+	})
+
+	t.Run("unknown target vector", func(t *testing.T) {
+		_, err := targetVectorClass(class, "summary")
+		assert.EqualError(t, err, "class SomeClass does not have named vector \"summary\"")
+	})
+
+	t.Run("class without named vectors", func(t *testing.T) {
+		plain := &models.Class{Class: "Plain", Vectorizer: "some-vzr"}
+		view, err := targetVectorClass(plain, "")
+		assert.Nil(t, err)
+		assert.Equal(t, plain, view)
+
+		_, err = targetVectorClass(plain, "title")
+		assert.EqualError(t, err, "class Plain does not have named vectors, "+
+			"cannot use target vector \"title\"")
+	})
+}
+
 func newUUID() strfmt.UUID {
 	return strfmt.UUID(uuid.NewString())
 }
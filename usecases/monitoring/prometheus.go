@@ -30,6 +30,7 @@ type PrometheusMetrics struct {
 	LSMSegmentObjects                  *prometheus.GaugeVec
 	LSMSegmentSize                     *prometheus.GaugeVec
 	LSMMemtableSize                    *prometheus.GaugeVec
+	LSMCompactionBytesRewritten        *prometheus.CounterVec
 	LSMMemtableDurations               *prometheus.SummaryVec
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
@@ -55,6 +56,9 @@ type PrometheusMetrics struct {
 	BackupRestoreDataTransferred       *prometheus.CounterVec
 	BackupStoreDataTransferred         *prometheus.CounterVec
 	VectorDimensionsSum                *prometheus.GaugeVec
+	AntiEntropyObjects                 *prometheus.CounterVec
+	AntiEntropyRanges                  *prometheus.CounterVec
+	AntiEntropyDurations               *prometheus.SummaryVec
 
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
@@ -152,6 +156,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_segment_count",
 			Help: "Number of segments by level",
 		}, []string{"strategy", "class_name", "shard_name", "path", "level"}),
+		LSMCompactionBytesRewritten: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_compaction_bytes_rewritten",
+			Help: "Number of bytes written by compactions by target level",
+		}, []string{"strategy", "class_name", "shard_name", "path", "level"}),
 		LSMMemtableSize: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "lsm_memtable_size",
 			Help: "Size of memtable by path",
@@ -194,6 +202,20 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help: "Total dimensions in a shard",
 		}, []string{"class_name", "shard_name"}),
 
+		// Replication anti-entropy
+		AntiEntropyObjects: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replication_anti_entropy_objects_total",
+			Help: "Number of objects pushed to other replicas by the anti-entropy process, by result",
+		}, []string{"class_name", "shard_name", "result"}),
+		AntiEntropyRanges: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replication_anti_entropy_ranges_total",
+			Help: "Number of uuid ranges found to differ between replicas by the anti-entropy process",
+		}, []string{"class_name", "shard_name"}),
+		AntiEntropyDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "replication_anti_entropy_durations_ms",
+			Help: "Duration of an anti-entropy run over all replicas of a shard",
+		}, []string{"class_name", "shard_name"}),
+
 		StartupProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "startup_progress",
 			Help: "A ratio (percentage) of startup progress for a particular component in a shard",
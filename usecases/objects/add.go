@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
@@ -39,7 +40,7 @@ type schemaManager interface {
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, object *models.Object,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "create", "objects")
+	err := m.authorizer.Authorize(principal, "create", objectResource(object))
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +57,16 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, ob
 	return m.addObjectToConnectorAndSchema(ctx, principal, object, repl)
 }
 
+// objectResource is the resource which is authorized when creating the
+// object, it is scoped to the class and tenant of the object
+func objectResource(object *models.Object) string {
+	if object == nil {
+		return authorization.Objects("", "", "")
+	}
+	return authorization.Objects(schema.UppercaseClassName(object.Class),
+		object.Tenant, "")
+}
+
 func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
 ) (strfmt.UUID, error) {
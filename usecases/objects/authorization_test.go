@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -118,6 +119,14 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedResource: "objects",
 		},
 
+		// change stream
+		{
+			methodName:       "ChangeStream",
+			additionalArgs:   []interface{}{"class", "tenant", uint64(0), func([]cdc.Event) error { return nil }},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/tenants/tenant",
+		},
+
 		{ // list objects is deprecated by query
 			methodName:       "GetObjects",
 			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), (*string)(nil), (*string)(nil), additional.Properties{}},
@@ -270,18 +279,74 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 	})
 }
 
+func Test_BatchKinds_ClassAuthorization(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	newManager := func(authorizer authorizer) *BatchManager {
+		return NewBatchManager(&fakeVectorRepo{}, getFakeModulesProvider(),
+			&fakeLocks{}, &fakeSchemaManager{}, &config.WeaviateConfig{}, logger,
+			authorizer, nil)
+	}
+
+	t.Run("objects are authorized once per class and tenant", func(t *testing.T) {
+		authorizer := &authDenier{deny: "objects/Other/tenants/acme"}
+		_, err := newManager(authorizer).AddObjects(context.Background(), principal,
+			[]*models.Object{
+				{Class: "article"},
+				{Class: "Article"},
+				{Class: "Other", Tenant: "acme"},
+			}, nil, nil)
+		assert.NotNil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "create", "batch/objects"},
+			{principal, "create", "objects/Article"},
+			{principal, "create", "objects/Other/tenants/acme"},
+		}, authorizer.calls)
+	})
+
+	t.Run("references are authorized on their source class", func(t *testing.T) {
+		authorizer := &authDenier{deny: "objects/Article"}
+		_, err := newManager(authorizer).AddReferences(context.Background(), principal,
+			[]*models.BatchReference{
+				{From: "weaviate://localhost/Article/8c6d2b62-23bc-4dd4-a5c8-8a8d2bd9bd1f/hasAuthor"},
+			}, nil)
+		assert.NotNil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "update", "batch/*"},
+			{principal, "update", "objects/Article"},
+		}, authorizer.calls)
+	})
+
+	t.Run("batch delete is authorized on the class and tenant", func(t *testing.T) {
+		authorizer := &authDenier{deny: "objects/Article/tenants/acme"}
+		_, err := newManager(authorizer).DeleteObjects(context.Background(), principal,
+			&models.BatchDeleteMatch{Class: "Article"}, nil, nil, nil, "acme")
+		assert.NotNil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "delete", "batch/objects"},
+			{principal, "delete", "objects/Article/tenants/acme"},
+		}, authorizer.calls)
+	})
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
 	resource  string
 }
 
+// authDenier denies every resource, unless deny is set. Then only that
+// resource is denied.
 type authDenier struct {
 	calls []authorizeCall
+	deny  string
 }
 
 func (a *authDenier) Authorize(principal *models.Principal, verb, resource string) error {
 	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	if a.deny != "" && a.deny != resource {
+		return nil
+	}
 	return errors.New("just a test fake")
 }
 
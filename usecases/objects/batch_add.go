@@ -35,6 +35,10 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 		return nil, err
 	}
 
+	if err := b.authorizeObjects(principal, objects); err != nil {
+		return nil, err
+	}
+
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -49,6 +53,25 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	return b.addObjects(ctx, principal, objects, fields, repl)
 }
 
+// authorizeObjects makes sure the principal may create objects in each of
+// the classes and tenants of the batch
+func (b *BatchManager) authorizeObjects(principal *models.Principal,
+	objects []*models.Object,
+) error {
+	authorized := map[string]struct{}{}
+	for _, object := range objects {
+		resource := objectResource(object)
+		if _, ok := authorized[resource]; ok {
+			continue
+		}
+		if err := b.authorizer.Authorize(principal, "create", resource); err != nil {
+			return err
+		}
+		authorized[resource] = struct{}{}
+	}
+	return nil
+}
+
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
 	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
@@ -140,6 +163,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	object.LastUpdateTimeUnix = 0
 	object.ID = id
 	object.Vector = concept.Vector
+	object.Vectors = concept.Vectors
 	object.Tenant = concept.Tenant
 
 	if _, ok := fieldsToKeep["class"]; ok {
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 const (
@@ -38,6 +39,13 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 		return nil, err
 	}
 
+	if match != nil {
+		resource := authorization.Objects(schema.UppercaseClassName(match.Class), tenant, "")
+		if err := b.authorizer.Authorize(principal, "delete", resource); err != nil {
+			return nil, err
+		}
+	}
+
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // AddReferences Class Instances in batch to the connected DB
@@ -33,6 +34,10 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 		return nil, err
 	}
 
+	if err := b.authorizeReferences(principal, refs); err != nil {
+		return nil, err
+	}
+
 	unlock, err := b.locks.LockSchema()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -45,6 +50,33 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 	return b.addReferences(ctx, principal, refs, repl)
 }
 
+// authorizeReferences makes sure the principal may update the source objects
+// of all references. References with an invalid source are skipped, they
+// fail the validation of the individual reference anyway.
+func (b *BatchManager) authorizeReferences(principal *models.Principal,
+	refs []*models.BatchReference,
+) error {
+	authorized := map[string]struct{}{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		source, err := crossref.ParseSource(string(ref.From))
+		if err != nil {
+			continue
+		}
+		resource := authorization.Objects(string(source.Class), ref.Tenant, "")
+		if _, ok := authorized[resource]; ok {
+			continue
+		}
+		if err := b.authorizer.Authorize(principal, "update", resource); err != nil {
+			return err
+		}
+		authorized[resource] = struct{}{}
+	}
+	return nil
+}
+
 func (b *BatchManager) addReferences(ctx context.Context, principal *models.Principal,
 	refs []*models.BatchReference, repl *additional.ReplicationProperties,
 ) (BatchReferences, error) {
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/cdc"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ChangeStream passes the change events of a class, or of a single tenant of
+// it, with a sequence number larger than after to send. It blocks until the
+// context is cancelled or send returns an error. Only the events of the
+// shards stored on this node are streamed.
+//
+// Unlike other reads, the stream does not hold the connector lock, as it would
+// block schema changes for as long as the stream is open.
+func (m *Manager) ChangeStream(ctx context.Context, principal *models.Principal,
+	class, tenant string, after uint64, send func([]cdc.Event) error,
+) error {
+	path := authorization.Objects(class, tenant, "")
+	if err := m.authorizer.Authorize(principal, "get", path); err != nil {
+		return err
+	}
+
+	return m.vectorRepo.ChangeStream(ctx, class, tenant, after, send)
+}
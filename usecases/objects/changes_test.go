@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cdc"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_ChangeStream(t *testing.T) {
+	events := []cdc.Event{
+		{Sequence: 3, Type: cdc.EventTypeCreate, Class: "MyClass", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+		{Sequence: 4, Type: cdc.EventTypeDelete, Class: "MyClass", ID: "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+	}
+
+	t.Run("events are passed to send", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		m.repo.On("ChangeStream", "MyClass", "", uint64(2)).Return(events, nil).Once()
+
+		var received []cdc.Event
+		err := m.Manager.ChangeStream(context.Background(), nil, "MyClass", "", 2,
+			func(in []cdc.Event) error {
+				received = append(received, in...)
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, events, received)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("errors of the repo are returned", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		m.repo.On("ChangeStream", "MyClass", "tenant", uint64(0)).
+			Return(nil, cdc.ErrSequenceTrimmed).Once()
+
+		err := m.Manager.ChangeStream(context.Background(), nil, "MyClass", "tenant", 0,
+			func(in []cdc.Event) error { return nil })
+		assert.ErrorIs(t, err, cdc.ErrSequenceTrimmed)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		m.authorizer.Err = errors.New("forbidden")
+
+		err := m.Manager.ChangeStream(context.Background(), nil, "MyClass", "", 0,
+			func(in []cdc.Event) error { return nil })
+		require.NotNil(t, err)
+		m.repo.AssertNotCalled(t, "ChangeStream")
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/tailor-inc/graphql/language/ast"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -67,7 +68,9 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 }
 
 func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) { return "", nil }
-func (f *fakeSchemaManager) TenantShard(class, tenant string) string        { return tenant }
+func (f *fakeSchemaManager) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
+}
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string { return "" }
 
 func (f *fakeSchemaManager) GetClass(ctx context.Context, principal *models.Principal,
@@ -183,6 +186,18 @@ func (f *fakeVectorRepo) Query(ctx context.Context, q *QueryInput) (search.Resul
 	return res, err
 }
 
+func (f *fakeVectorRepo) ChangeStream(ctx context.Context, class, tenant string,
+	after uint64, send func([]cdc.Event) error,
+) error {
+	args := f.Called(class, tenant, after)
+	if events, ok := args.Get(0).([]cdc.Event); ok && len(events) > 0 {
+		if err := send(events); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
 func (f *fakeVectorRepo) PutObject(ctx context.Context, concept *models.Object, vector []float32,
 	repl *additional.ReplicationProperties,
 ) error {
@@ -24,6 +24,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cdc"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -109,6 +110,8 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	ChangeStream(ctx context.Context, class, tenant string, after uint64,
+		send func([]cdc.Event) error) error
 }
 
 type ModulesProvider interface {
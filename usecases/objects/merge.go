@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -31,6 +32,7 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              map[string][]float32        `json:"vectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -43,7 +45,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		return &Error{"bad request", StatusBadRequest, err}
 	}
 	cls, id := updates.Class, updates.ID
-	path := fmt.Sprintf("objects/%s/%s", cls, id)
+	path := authorization.Objects(cls, updates.Tenant, id)
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
@@ -93,7 +95,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+		primitive, principal, obj.Vector, updates.Vector, obj.Vectors, updates.Vectors)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
@@ -103,6 +105,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
+		Vectors:            vectorsToMap(objWithVec.Vectors),
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -134,14 +137,22 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
 	old interface{}, new map[string]interface{},
 	principal *models.Principal, oldVec, newVec []float32,
+	oldVecs, newVecs models.Vectors,
 ) (*models.Object, error) {
 	var merged map[string]interface{}
 	var vector []float32
+	var vectors models.Vectors
 	var objDiff *moduletools.ObjectDiff
 
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, err
+	}
+
 	if old == nil {
 		merged = new
 		vector = newVec
+		vectors = newVecs
 	} else {
 		oldMap, ok := old.(map[string]interface{})
 		if !ok {
@@ -149,11 +160,16 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 		}
 
 		objDiff = moduletools.NewObjectDiff(oldVec)
+		for targetVector, oldVec := range oldVecs {
+			objDiff.WithTargetVec(targetVector, oldVec)
+		}
 		for key, value := range new {
 			objDiff.WithProp(key, oldMap[key], value)
 			oldMap[key] = value
 		}
 
+		vectors = mergeNamedVectors(class, oldVecs, newVecs)
+
 		merged = oldMap
 		if newVec != nil {
 			vector = newVec
@@ -170,11 +186,7 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 
 	// Note: vector could be a nil vector in case a vectorizer is configured,
 	// then the vectorizer will set it
-	obj := &models.Object{Class: className, Properties: merged, Vector: vector}
-	class, err := m.schemaManager.GetClass(ctx, principal, className)
-	if err != nil {
-		return nil, err
-	}
+	obj := &models.Object{Class: className, Properties: merged, Vector: vector, Vectors: vectors}
 	if err := m.modulesProvider.UpdateVector(ctx, obj, class, objDiff, m.findObject, m.logger); err != nil {
 		return nil, err
 	}
@@ -182,6 +194,40 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	return obj, nil
 }
 
+// mergeNamedVectors keeps the previous named vectors which are not updated
+// and not vectorized by a module. Named vectors which are vectorized by a
+// module are left out, so that the module vectorizes them again.
+func mergeNamedVectors(class *models.Class, oldVecs, newVecs models.Vectors) models.Vectors {
+	if class == nil || len(class.VectorConfig) == 0 {
+		return newVecs
+	}
+
+	merged := models.Vectors{}
+	for targetVector, vc := range class.VectorConfig {
+		if vec, ok := newVecs[targetVector]; ok {
+			merged[targetVector] = vec
+			continue
+		}
+		moduleName, _ := schema.NamedVectorizer(vc)
+		if vec, ok := oldVecs[targetVector]; ok && moduleName == config.VectorizerModuleNone {
+			merged[targetVector] = vec
+		}
+	}
+	return merged
+}
+
+func vectorsToMap(vectors models.Vectors) map[string][]float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	out := make(map[string][]float32, len(vectors))
+	for targetVector, vec := range vectors {
+		out[targetVector] = vec
+	}
+	return out
+}
+
 func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass string,
 	sourceID strfmt.UUID,
 ) (map[string]interface{}, BatchReferences) {
@@ -13,11 +13,11 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 type QueryInput struct {
@@ -66,7 +66,11 @@ func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
 
 func (m *Manager) Query(ctx context.Context, principal *models.Principal, params *QueryParams,
 ) ([]*models.Object, *Error) {
-	path := fmt.Sprintf("objects/%s", params.Class)
+	tenant := ""
+	if params.Tenant != nil {
+		tenant = *params.Tenant
+	}
+	path := authorization.Objects(params.Class, tenant, "")
 	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
 		return nil, &Error{path, StatusForbidden, err}
 	}
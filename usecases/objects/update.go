@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // UpdateObject updates object of class.
@@ -27,10 +28,11 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	path := fmt.Sprintf("objects/%s/%s", class, id)
-	if class == "" {
-		path = fmt.Sprintf("objects/%s", id)
+	tenant := ""
+	if updates != nil {
+		tenant = updates.Tenant
 	}
+	path := authorization.Objects(class, tenant, id)
 	err := m.authorizer.Authorize(principal, "update", path)
 	if err != nil {
 		return nil, err
@@ -23,7 +23,7 @@ import (
 func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principal,
 	obj *models.Object, repl *additional.ReplicationProperties,
 ) error {
-	err := m.authorizer.Authorize(principal, "validate", "objects")
+	err := m.authorizer.Authorize(principal, "validate", objectResource(obj))
 	if err != nil {
 		return err
 	}
@@ -84,9 +84,44 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
+	if err := validateVectors(class, incoming); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, class, incoming, existing)
 }
 
+// validateVectors makes sure that the vectors of an object match the vector
+// configuration of its class. Classes with named vectors don't have a
+// class-level vector and vice versa.
+func validateVectors(class *models.Class, incoming *models.Object) error {
+	if class == nil {
+		return nil
+	}
+
+	if len(class.VectorConfig) == 0 {
+		if len(incoming.Vectors) > 0 {
+			return fmt.Errorf("class %s does not have named vectors, "+
+				"use the 'vector' field instead of 'vectors'", class.Class)
+		}
+		return nil
+	}
+
+	if len(incoming.Vector) > 0 {
+		return fmt.Errorf("class %s has named vectors, "+
+			"use the 'vectors' field instead of 'vector'", class.Class)
+	}
+
+	for targetVector := range incoming.Vectors {
+		if _, ok := class.VectorConfig[targetVector]; !ok {
+			return fmt.Errorf("class %s does not have named vector %q",
+				class.Class, targetVector)
+		}
+	}
+
+	return nil
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateVectors(t *testing.T) {
+	plain := &models.Class{Class: "Plain"}
+	named := &models.Class{
+		Class: "Named",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		class       *models.Class
+		object      *models.Object
+		expectedErr string
+	}{
+		{
+			name:   "class-level vector",
+			class:  plain,
+			object: &models.Object{Vector: []float32{1, 2}},
+		},
+		{
+			name:        "named vectors on a class without named vectors",
+			class:       plain,
+			object:      &models.Object{Vectors: models.Vectors{"title": {1, 2}}},
+			expectedErr: "class Plain does not have named vectors, use the 'vector' field instead of 'vectors'",
+		},
+		{
+			name:   "named vector",
+			class:  named,
+			object: &models.Object{Vectors: models.Vectors{"title": {1, 2}}},
+		},
+		{
+			name:        "class-level vector on a class with named vectors",
+			class:       named,
+			object:      &models.Object{Vector: []float32{1, 2}},
+			expectedErr: "class Named has named vectors, use the 'vectors' field instead of 'vector'",
+		},
+		{
+			name:        "unknown named vector",
+			class:       named,
+			object:      &models.Object{Vectors: models.Vectors{"description": {1, 2}}},
+			expectedErr: "class Named does not have named vector \"description\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateVectors(test.class, test.object)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// antiEntropyDigestBatchSize is the number of object digests compared at once
+	antiEntropyDigestBatchSize = 1000
+	// antiEntropyPushBatchSize is the number of objects pushed to a replica at once
+	antiEntropyPushBatchSize = 100
+)
+
+// LocalReplica gives the anti-entropy process access to the replica of a
+// shard which is stored on this node
+type LocalReplica interface {
+	HashTreeLevel(ctx context.Context, level int) ([]uint64, error)
+	DigestObjectsInRange(ctx context.Context, initialUUID, finalUUID strfmt.UUID,
+		limit int) ([]RepairResponse, error)
+	FetchObjects(ctx context.Context, ids []strfmt.UUID) ([]objects.Replica, error)
+}
+
+// AntiEntropyStats summarizes how much data an anti-entropy run has repaired
+type AntiEntropyStats struct {
+	// Ranges is the number of uuid ranges which differed between replicas
+	Ranges int
+	// Repaired is the number of objects pushed to other replicas
+	Repaired int
+	// Conflicts is the number of objects which could not be repaired, because
+	// they were deleted on, or changed concurrently on another replica
+	Conflicts int
+}
+
+// RepairShard compares the local replica of a shard with all other replicas
+// and pushes the objects which are missing or stale on them. Objects which
+// are more recent on another replica are repaired when that replica runs its
+// own anti-entropy process.
+//
+// Replicas are compared by the root of a hash tree over their objects first.
+// Only if the roots differ, the leaves are compared and the objects within
+// the differing uuid ranges are exchanged as digests.
+func (r *Replicator) RepairShard(ctx context.Context, shard string,
+	local LocalReplica,
+) (AntiEntropyStats, error) {
+	nodes, err := r.stateGetter.ResolveParentNodes(r.class, shard)
+	if err != nil {
+		return AntiEntropyStats{}, fmt.Errorf("resolve replicas of shard %q: %w", shard, err)
+	}
+
+	ae := &antiEntropy{
+		class:  r.class,
+		shard:  shard,
+		client: r.client,
+		local:  local,
+	}
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for name, host := range nodes {
+		if name == r.stateGetter.NodeName() {
+			continue
+		}
+		if host == "" {
+			ec.Add(fmt.Errorf("%w : %q", errUnresolvedName, name))
+			continue
+		}
+		if err := ae.repairReplica(ctx, host); err != nil {
+			ec.Add(fmt.Errorf("replica %q: %w", name, err))
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return ae.stats, ec.ToError()
+}
+
+// antiEntropy compares the local replica of a shard with the other replicas
+// one by one. The hash tree of the local replica is computed at most once.
+type antiEntropy struct {
+	class  string
+	shard  string
+	client rClient
+	local  LocalReplica
+	root   []uint64
+	leaves []uint64
+	stats  AntiEntropyStats
+}
+
+func (ae *antiEntropy) repairReplica(ctx context.Context, host string) error {
+	if ae.root == nil {
+		root, err := ae.local.HashTreeLevel(ctx, 0)
+		if err != nil {
+			return fmt.Errorf("local hash tree root: %w", err)
+		}
+		ae.root = root
+	}
+
+	root, err := ae.client.HashTreeLevel(ctx, host, ae.class, ae.shard, 0)
+	if err != nil {
+		return fmt.Errorf("hash tree root: %w", err)
+	}
+	if equalDigests(ae.root, root) {
+		return nil
+	}
+
+	if ae.leaves == nil {
+		leaves, err := ae.local.HashTreeLevel(ctx, HashTreeLeafLevel)
+		if err != nil {
+			return fmt.Errorf("local hash tree leaves: %w", err)
+		}
+		ae.leaves = leaves
+	}
+
+	leaves, err := ae.client.HashTreeLevel(ctx, host, ae.class, ae.shard, HashTreeLeafLevel)
+	if err != nil {
+		return fmt.Errorf("hash tree leaves: %w", err)
+	}
+	if len(leaves) != len(ae.leaves) {
+		return fmt.Errorf("malformed hash tree: %d leaves expected got %d",
+			len(ae.leaves), len(leaves))
+	}
+
+	// adjacent differing leaves are repaired as a single range
+	for start := 0; start < len(leaves); {
+		if leaves[start] == ae.leaves[start] {
+			start++
+			continue
+		}
+		end := start
+		for end+1 < len(leaves) && leaves[end+1] != ae.leaves[end+1] {
+			end++
+		}
+		ae.stats.Ranges += end - start + 1
+
+		initial, _ := hashTreeRange(HashTreeLeafLevel, start)
+		_, final := hashTreeRange(HashTreeLeafLevel, end)
+		if err := ae.repairRange(ctx, host, initial, final); err != nil {
+			return fmt.Errorf("range %s - %s: %w", initial, final, err)
+		}
+		start = end + 1
+	}
+
+	return nil
+}
+
+// repairRange compares the digests of the objects within a uuid range page by
+// page and pushes the objects which are more recent locally
+func (ae *antiEntropy) repairRange(ctx context.Context, host string,
+	initial, final strfmt.UUID,
+) error {
+	for {
+		remote, err := ae.client.DigestObjectsInRange(ctx, host, ae.class, ae.shard,
+			initial, final, antiEntropyDigestBatchSize)
+		if err != nil {
+			return fmt.Errorf("remote digests: %w", err)
+		}
+		local, err := ae.local.DigestObjectsInRange(ctx, initial, final,
+			antiEntropyDigestBatchSize)
+		if err != nil {
+			return fmt.Errorf("local digests: %w", err)
+		}
+
+		// a full page might not cover the entire range, the comparison is
+		// limited to the range both pages are complete for
+		end := final
+		if n := len(remote); n == antiEntropyDigestBatchSize {
+			end = strfmt.UUID(remote[n-1].ID)
+		}
+		if n := len(local); n == antiEntropyDigestBatchSize && local[n-1].ID < end.String() {
+			end = strfmt.UUID(local[n-1].ID)
+		}
+
+		remoteTimes := make(map[string]int64, len(remote))
+		for _, x := range remote {
+			remoteTimes[x.ID] = x.UpdateTime
+		}
+
+		var stale []RepairResponse
+		for _, x := range local {
+			if x.ID > end.String() {
+				break
+			}
+			remoteTime, ok := remoteTimes[x.ID]
+			if !ok || remoteTime < x.UpdateTime {
+				// UpdateTime is reused as the update time the replica is known to have
+				stale = append(stale, RepairResponse{ID: x.ID, UpdateTime: remoteTime})
+			}
+		}
+
+		for start := 0; start < len(stale); start += antiEntropyPushBatchSize {
+			stop := start + antiEntropyPushBatchSize
+			if stop > len(stale) {
+				stop = len(stale)
+			}
+			if err := ae.push(ctx, host, stale[start:stop]); err != nil {
+				return err
+			}
+		}
+
+		if end == final {
+			return nil
+		}
+		next, ok := nextUUID(end)
+		if !ok {
+			return nil
+		}
+		initial = next
+	}
+}
+
+// push overwrites the stale objects of a replica with their local state.
+// Objects which are missing on the replica are only pushed if they have not
+// been deleted there, since the deletion might be more recent.
+func (ae *antiEntropy) push(ctx context.Context, host string, stale []RepairResponse) error {
+	var missing []strfmt.UUID
+	for _, x := range stale {
+		if x.UpdateTime == 0 {
+			missing = append(missing, strfmt.UUID(x.ID))
+		}
+	}
+
+	deleted := make(map[string]bool, len(missing))
+	if len(missing) > 0 {
+		digests, err := ae.client.DigestObjects(ctx, host, ae.class, ae.shard, missing)
+		if err != nil {
+			return fmt.Errorf("digest missing objects: %w", err)
+		}
+		for _, x := range digests {
+			if x.Deleted {
+				deleted[x.ID] = true
+			}
+		}
+	}
+
+	ids := make([]strfmt.UUID, 0, len(stale))
+	staleTimes := make(map[string]int64, len(stale))
+	for _, x := range stale {
+		if deleted[x.ID] {
+			ae.stats.Conflicts++
+			continue
+		}
+		ids = append(ids, strfmt.UUID(x.ID))
+		staleTimes[x.ID] = x.UpdateTime
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	replicas, err := ae.local.FetchObjects(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("fetch local objects: %w", err)
+	}
+
+	updates := make([]*objects.VObject, 0, len(replicas))
+	for _, x := range replicas {
+		// objects might have been deleted locally in the meantime
+		if x.Deleted || x.Object == nil {
+			continue
+		}
+		updates = append(updates, &objects.VObject{
+			LatestObject:    &x.Object.Object,
+			Vector:          x.Object.Vector,
+			Vectors:         x.Object.Vectors,
+			StaleUpdateTime: staleTimes[x.Object.ID().String()],
+		})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	resp, err := ae.client.OverwriteObjects(ctx, host, ae.class, ae.shard, updates)
+	if err != nil {
+		return fmt.Errorf("overwrite objects: %w", err)
+	}
+
+	// only unsuccessful overwrites are part of the response
+	failed := 0
+	for _, x := range resp {
+		if x.Err != "" {
+			failed++
+		}
+	}
+	ae.stats.Conflicts += failed
+	ae.stats.Repaired += len(updates) - failed
+	return nil
+}
+
+func equalDigests(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
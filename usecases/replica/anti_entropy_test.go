@@ -0,0 +1,297 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestRepairShard(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		ctx   = context.Background()
+		nodes = []string{"A", "B"}
+	)
+
+	newReplicator := func(client *fakeAntiEntropyClient) *Replicator {
+		nodeResolver := newFakeNodeResolver(nodes)
+		shardingState := newFakeShardingState("A", map[string][]string{shard: nodes}, nodeResolver)
+		return NewReplicator(cls, shardingState, nodeResolver,
+			struct {
+				rClient
+				wClient
+			}{client, &fakeClient{}}, nil)
+	}
+
+	t.Run("replicas in sync", func(t *testing.T) {
+		local := newMemReplica()
+		remote := newMemReplica()
+		for i := 0; i < 10; i++ {
+			id := strfmt.UUID(uuid.NewString())
+			local.put(object(id, 1))
+			remote.put(object(id, 1))
+		}
+		client := newFakeAntiEntropyClient(map[string]*memReplica{"B": remote})
+
+		stats, err := newReplicator(client).RepairShard(ctx, shard, local)
+		require.Nil(t, err)
+		assert.Equal(t, AntiEntropyStats{}, stats)
+		assert.Equal(t, 1, client.hashTreeCalls, "only the roots are compared")
+	})
+
+	t.Run("stale and missing objects are pushed", func(t *testing.T) {
+		var (
+			stale         = strfmt.UUID("10000000-0000-0000-0000-000000000001")
+			missing       = strfmt.UUID("20000000-0000-0000-0000-000000000001")
+			deleted       = strfmt.UUID("30000000-0000-0000-0000-000000000001")
+			newerOnRemote = strfmt.UUID("40000000-0000-0000-0000-000000000001")
+			onlyOnRemote  = strfmt.UUID("50000000-0000-0000-0000-000000000001")
+			inSync        = strfmt.UUID("60000000-0000-0000-0000-000000000001")
+		)
+
+		local := newMemReplica()
+		local.put(object(stale, 2))
+		local.put(object(missing, 1))
+		local.put(object(deleted, 1))
+		local.put(object(newerOnRemote, 1))
+		local.put(object(inSync, 1))
+
+		remote := newMemReplica()
+		remote.put(object(stale, 1))
+		remote.deleted[deleted] = true
+		remote.put(object(newerOnRemote, 2))
+		remote.put(object(onlyOnRemote, 1))
+		remote.put(object(inSync, 1))
+
+		client := newFakeAntiEntropyClient(map[string]*memReplica{"B": remote})
+		stats, err := newReplicator(client).RepairShard(ctx, shard, local)
+		require.Nil(t, err)
+
+		assert.Equal(t, 5, stats.Ranges)
+		assert.Equal(t, 2, stats.Repaired)
+		assert.Equal(t, 1, stats.Conflicts)
+
+		assert.Equal(t, int64(2), remote.objects[stale].LastUpdateTimeUnix())
+		assert.Equal(t, int64(1), remote.objects[missing].LastUpdateTimeUnix())
+		assert.Nil(t, remote.objects[deleted])
+		assert.Equal(t, int64(2), remote.objects[newerOnRemote].LastUpdateTimeUnix())
+		assert.NotNil(t, remote.objects[onlyOnRemote])
+	})
+
+	t.Run("ranges larger than a page", func(t *testing.T) {
+		local := newMemReplica()
+		remote := newMemReplica()
+		// all objects fall into the first leaf of the hash tree
+		n := 2*antiEntropyDigestBatchSize + 10
+		for i := 0; i < n; i++ {
+			id := strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+			local.put(object(id, 2))
+			if i%2 == 0 {
+				remote.put(object(id, 1))
+			}
+		}
+
+		client := newFakeAntiEntropyClient(map[string]*memReplica{"B": remote})
+		stats, err := newReplicator(client).RepairShard(ctx, shard, local)
+		require.Nil(t, err)
+
+		assert.Equal(t, 1, stats.Ranges)
+		assert.Equal(t, n, stats.Repaired)
+		assert.Equal(t, 0, stats.Conflicts)
+
+		localRoot, err := local.HashTreeLevel(ctx, 0)
+		require.Nil(t, err)
+		remoteRoot, err := remote.HashTreeLevel(ctx, 0)
+		require.Nil(t, err)
+		assert.Equal(t, localRoot, remoteRoot)
+	})
+
+	t.Run("unreachable replica", func(t *testing.T) {
+		local := newMemReplica()
+		local.put(object(strfmt.UUID(uuid.NewString()), 1))
+		client := newFakeAntiEntropyClient(map[string]*memReplica{})
+
+		_, err := newReplicator(client).RepairShard(ctx, shard, local)
+		assert.ErrorContains(t, err, "replica \"B\"")
+	})
+}
+
+// memReplica is an in-memory replica of a shard
+type memReplica struct {
+	objects map[strfmt.UUID]*storobj.Object
+	deleted map[strfmt.UUID]bool
+}
+
+func newMemReplica() *memReplica {
+	return &memReplica{
+		objects: map[strfmt.UUID]*storobj.Object{},
+		deleted: map[strfmt.UUID]bool{},
+	}
+}
+
+func (m *memReplica) put(obj *storobj.Object) {
+	m.objects[obj.ID()] = obj
+	delete(m.deleted, obj.ID())
+}
+
+func (m *memReplica) HashTreeLevel(_ context.Context, level int) ([]uint64, error) {
+	tree, err := NewHashTree(level)
+	if err != nil {
+		return nil, err
+	}
+	for id, obj := range m.objects {
+		parsed := uuid.MustParse(id.String())
+		tree.Add(parsed[:], obj.LastUpdateTimeUnix())
+	}
+	return tree.Digests(), nil
+}
+
+func (m *memReplica) DigestObjectsInRange(_ context.Context,
+	initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]RepairResponse, error) {
+	var res []RepairResponse
+	for id, obj := range m.objects {
+		if id >= initialUUID && id <= finalUUID {
+			res = append(res, RepairResponse{ID: id.String(), UpdateTime: obj.LastUpdateTimeUnix()})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (m *memReplica) FetchObjects(_ context.Context, ids []strfmt.UUID) ([]objects.Replica, error) {
+	res := make([]objects.Replica, len(ids))
+	for i, id := range ids {
+		res[i] = objects.Replica{ID: id, Object: m.objects[id], Deleted: m.deleted[id]}
+	}
+	return res, nil
+}
+
+func (m *memReplica) digest(ids []strfmt.UUID) []RepairResponse {
+	res := make([]RepairResponse, len(ids))
+	for i, id := range ids {
+		res[i] = RepairResponse{ID: id.String(), Deleted: m.deleted[id]}
+		if obj := m.objects[id]; obj != nil {
+			res[i].UpdateTime = obj.LastUpdateTimeUnix()
+		}
+	}
+	return res
+}
+
+func (m *memReplica) overwrite(xs []*objects.VObject) []RepairResponse {
+	var res []RepairResponse
+	for _, x := range xs {
+		var current int64
+		if obj := m.objects[x.LatestObject.ID]; obj != nil {
+			current = obj.LastUpdateTimeUnix()
+		}
+		if current != x.StaleUpdateTime {
+			res = append(res, RepairResponse{ID: x.LatestObject.ID.String(), Err: "conflict"})
+			continue
+		}
+		obj := storobj.FromObject(x.LatestObject, x.Vector, nil)
+		obj.Vectors = x.Vectors
+		m.put(obj)
+	}
+	return res
+}
+
+// fakeAntiEntropyClient serves the replicas of other nodes from memory
+type fakeAntiEntropyClient struct {
+	replicas      map[string]*memReplica
+	hashTreeCalls int
+}
+
+func newFakeAntiEntropyClient(replicas map[string]*memReplica) *fakeAntiEntropyClient {
+	return &fakeAntiEntropyClient{replicas: replicas}
+}
+
+func (f *fakeAntiEntropyClient) replica(host string) (*memReplica, error) {
+	r, ok := f.replicas[host]
+	if !ok {
+		return nil, fmt.Errorf("host %q unreachable", host)
+	}
+	return r, nil
+}
+
+func (f *fakeAntiEntropyClient) FetchObject(_ context.Context, host, index, shard string,
+	id strfmt.UUID, props search.SelectProperties, additional additional.Properties,
+) (objects.Replica, error) {
+	return objects.Replica{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeAntiEntropyClient) FetchObjects(ctx context.Context, host, index, shard string,
+	ids []strfmt.UUID,
+) ([]objects.Replica, error) {
+	r, err := f.replica(host)
+	if err != nil {
+		return nil, err
+	}
+	return r.FetchObjects(ctx, ids)
+}
+
+func (f *fakeAntiEntropyClient) OverwriteObjects(_ context.Context, host, index, shard string,
+	xs []*objects.VObject,
+) ([]RepairResponse, error) {
+	r, err := f.replica(host)
+	if err != nil {
+		return nil, err
+	}
+	return r.overwrite(xs), nil
+}
+
+func (f *fakeAntiEntropyClient) DigestObjects(_ context.Context, host, index, shard string,
+	ids []strfmt.UUID,
+) ([]RepairResponse, error) {
+	r, err := f.replica(host)
+	if err != nil {
+		return nil, err
+	}
+	return r.digest(ids), nil
+}
+
+func (f *fakeAntiEntropyClient) HashTreeLevel(ctx context.Context, host, index, shard string,
+	level int,
+) ([]uint64, error) {
+	f.hashTreeCalls++
+	r, err := f.replica(host)
+	if err != nil {
+		return nil, err
+	}
+	return r.HashTreeLevel(ctx, level)
+}
+
+func (f *fakeAntiEntropyClient) DigestObjectsInRange(ctx context.Context, host, index, shard string,
+	initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]RepairResponse, error) {
+	r, err := f.replica(host)
+	if err != nil {
+		return nil, err
+	}
+	return r.DigestObjectsInRange(ctx, initialUUID, finalUUID, limit)
+}
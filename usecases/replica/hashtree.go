@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/spaolacci/murmur3"
+)
+
+const (
+	// HashTreeLeafLevel is the level of the hash tree whose nodes are compared
+	// to find the uuid ranges which differ between two replicas. It splits the
+	// uuid space into 4096 ranges.
+	HashTreeLeafLevel = 12
+
+	// maxHashTreeLevel limits the number of nodes a replica has to compute
+	maxHashTreeLevel = 16
+)
+
+// HashTree holds one level of a binary hash tree over the uuid space of a
+// replica. Node n of level l covers all uuids whose first l bits equal n.
+//
+// The digest of a node is the xor of the digests of its objects, so it does
+// not depend on the order in which objects are added, and any level of the
+// tree can be computed with a single scan over the objects of a replica.
+type HashTree struct {
+	level   int
+	digests []uint64
+}
+
+func NewHashTree(level int) (*HashTree, error) {
+	if level < 0 || level > maxHashTreeLevel {
+		return nil, fmt.Errorf("hash tree level must be between 0 and %d, got %d",
+			maxHashTreeLevel, level)
+	}
+
+	return &HashTree{
+		level:   level,
+		digests: make([]uint64, 1<<level),
+	}, nil
+}
+
+// Add aggregates an object identified by its binary uuid into the node which
+// covers it
+func (t *HashTree) Add(id []byte, updateTime int64) {
+	node := binary.BigEndian.Uint64(id[:8]) >> (64 - t.level)
+	t.digests[node] ^= objectDigest(id, updateTime)
+}
+
+// Digests returns the digests of all nodes of the level ordered by the uuid
+// ranges they cover
+func (t *HashTree) Digests() []uint64 {
+	return t.digests
+}
+
+func objectDigest(id []byte, updateTime int64) uint64 {
+	var buf [24]byte
+	copy(buf[:16], id)
+	binary.LittleEndian.PutUint64(buf[16:], uint64(updateTime))
+	return murmur3.Sum64(buf[:])
+}
+
+// hashTreeRange returns the first and the last uuid covered by a node
+func hashTreeRange(level, node int) (strfmt.UUID, strfmt.UUID) {
+	prefix := uint64(node) << (64 - level)
+	mask := ^uint64(0) >> level
+
+	var first, last uuid.UUID
+	binary.BigEndian.PutUint64(first[:8], prefix)
+	binary.BigEndian.PutUint64(last[:8], prefix|mask)
+	binary.BigEndian.PutUint64(last[8:], ^uint64(0))
+
+	return strfmt.UUID(first.String()), strfmt.UUID(last.String())
+}
+
+// nextUUID returns the uuid following id, it returns false if id is the last
+// possible uuid
+func nextUUID(id strfmt.UUID) (strfmt.UUID, bool) {
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return "", false
+	}
+
+	for i := len(parsed) - 1; i >= 0; i-- {
+		parsed[i]++
+		if parsed[i] != 0 {
+			return strfmt.UUID(parsed.String()), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashTree(t *testing.T) {
+	ids := []uuid.UUID{
+		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		uuid.MustParse("7fffffff-0000-0000-0000-000000000001"),
+		uuid.MustParse("80000000-0000-0000-0000-000000000001"),
+		uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+	}
+
+	t.Run("invalid level", func(t *testing.T) {
+		_, err := NewHashTree(-1)
+		assert.NotNil(t, err)
+		_, err = NewHashTree(maxHashTreeLevel + 1)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("digests do not depend on the order of objects", func(t *testing.T) {
+		a, err := NewHashTree(1)
+		require.Nil(t, err)
+		b, err := NewHashTree(1)
+		require.Nil(t, err)
+
+		for i := range ids {
+			a.Add(ids[i][:], int64(i))
+			b.Add(ids[len(ids)-1-i][:], int64(len(ids)-1-i))
+		}
+		assert.Equal(t, a.Digests(), b.Digests())
+	})
+
+	t.Run("objects are aggregated into the node covering them", func(t *testing.T) {
+		root, err := NewHashTree(0)
+		require.Nil(t, err)
+		halves, err := NewHashTree(1)
+		require.Nil(t, err)
+
+		for i := range ids {
+			root.Add(ids[i][:], 1)
+			halves.Add(ids[i][:], 1)
+		}
+
+		require.Len(t, root.Digests(), 1)
+		require.Len(t, halves.Digests(), 2)
+		assert.Equal(t, objectDigest(ids[0][:], 1)^objectDigest(ids[1][:], 1),
+			halves.Digests()[0])
+		assert.Equal(t, objectDigest(ids[2][:], 1)^objectDigest(ids[3][:], 1),
+			halves.Digests()[1])
+		assert.Equal(t, halves.Digests()[0]^halves.Digests()[1], root.Digests()[0])
+	})
+
+	t.Run("update times change digests", func(t *testing.T) {
+		assert.NotEqual(t, objectDigest(ids[0][:], 1), objectDigest(ids[0][:], 2))
+	})
+}
+
+func TestHashTreeRange(t *testing.T) {
+	first, last := hashTreeRange(0, 0)
+	assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000000"), first)
+	assert.Equal(t, strfmt.UUID("ffffffff-ffff-ffff-ffff-ffffffffffff"), last)
+
+	first, last = hashTreeRange(12, 0)
+	assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000000"), first)
+	assert.Equal(t, strfmt.UUID("000fffff-ffff-ffff-ffff-ffffffffffff"), last)
+
+	first, last = hashTreeRange(12, 4095)
+	assert.Equal(t, strfmt.UUID("fff00000-0000-0000-0000-000000000000"), first)
+	assert.Equal(t, strfmt.UUID("ffffffff-ffff-ffff-ffff-ffffffffffff"), last)
+}
+
+func TestNextUUID(t *testing.T) {
+	next, ok := nextUUID("00000000-0000-0000-0000-0000000000ff")
+	assert.True(t, ok)
+	assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000100"), next)
+
+	next, ok = nextUUID("000fffff-ffff-ffff-ffff-ffffffffffff")
+	assert.True(t, ok)
+	assert.Equal(t, strfmt.UUID("00100000-0000-0000-0000-000000000000"), next)
+
+	_, ok = nextUUID("ffffffff-ffff-ffff-ffff-ffffffffffff")
+	assert.False(t, ok)
+}
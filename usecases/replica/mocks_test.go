@@ -56,6 +56,20 @@ func (f *fakeRClient) DigestObjects(ctx context.Context, host, index, shard stri
 	return args.Get(0).([]RepairResponse), args.Error(1)
 }
 
+func (f *fakeRClient) HashTreeLevel(ctx context.Context, host, index, shard string,
+	level int,
+) ([]uint64, error) {
+	args := f.Called(ctx, host, index, shard, level)
+	return args.Get(0).([]uint64), args.Error(1)
+}
+
+func (f *fakeRClient) DigestObjectsInRange(ctx context.Context, host, index, shard string,
+	initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]RepairResponse, error) {
+	args := f.Called(ctx, host, index, shard, initialUUID, finalUUID, limit)
+	return args.Get(0).([]RepairResponse), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
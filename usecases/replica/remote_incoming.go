@@ -46,6 +46,10 @@ type RemoteIncomingRepo interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []RepairResponse, err error)
+	HashTreeLevel(ctx context.Context, class, shardName string,
+		level int) ([]uint64, error)
+	DigestObjectsInRange(ctx context.Context, class, shardName string,
+		initialUUID, finalUUID strfmt.UUID, limit int) ([]RepairResponse, error)
 }
 
 type RemoteReplicaIncoming struct {
@@ -129,3 +133,16 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 ) (result []RepairResponse, err error) {
 	return rri.repo.DigestObjects(ctx, indexName, shardName, ids)
 }
+
+func (rri *RemoteReplicaIncoming) HashTreeLevel(ctx context.Context,
+	indexName, shardName string, level int,
+) ([]uint64, error) {
+	return rri.repo.HashTreeLevel(ctx, indexName, shardName, level)
+}
+
+func (rri *RemoteReplicaIncoming) DigestObjectsInRange(ctx context.Context,
+	indexName, shardName string, initialUUID, finalUUID strfmt.UUID, limit int,
+) ([]RepairResponse, error) {
+	return rri.repo.DigestObjectsInRange(ctx, indexName, shardName,
+		initialUUID, finalUUID, limit)
+}
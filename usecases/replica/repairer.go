@@ -88,6 +88,7 @@ func (r *repairer) repairOne(ctx context.Context,
 			ups := []*objects.VObject{{
 				LatestObject:    &updates.Object.Object,
 				Vector:          updates.Object.Vector,
+				Vectors:         updates.Object.Vectors,
 				StaleUpdateTime: vote.UTime,
 			}}
 			resp, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
@@ -152,6 +153,7 @@ func (r *repairer) repairExist(ctx context.Context,
 			ups := []*objects.VObject{{
 				LatestObject:    &resp.Object.Object,
 				Vector:          resp.Object.Vector,
+				Vectors:         resp.Object.Vectors,
 				StaleUpdateTime: vote.UTime,
 			}}
 			resp, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
@@ -268,6 +270,7 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 				obj := objects.VObject{
 					LatestObject:    &result[j].Object,
 					Vector:          result[j].Vector,
+					Vectors:         result[j].Vectors,
 					StaleUpdateTime: cTime,
 				}
 				query = append(query, &obj)
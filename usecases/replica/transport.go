@@ -155,6 +155,13 @@ type RepairResponse struct {
 	Deleted    bool
 }
 
+// DigestRangeRequest is the payload of a DigestObjectsInRange request
+type DigestRangeRequest struct {
+	InitialUUID strfmt.UUID `json:"initialUUID"`
+	FinalUUID   strfmt.UUID `json:"finalUUID"`
+	Limit       int         `json:"limit"`
+}
+
 func fromReplicas(xs []objects.Replica) []*storobj.Object {
 	rs := make([]*storobj.Object, len(xs))
 	for i := range xs {
@@ -202,6 +209,18 @@ type rClient interface {
 	// object
 	DigestObjects(ctx context.Context, host, index, shard string,
 		ids []strfmt.UUID) ([]RepairResponse, error)
+
+	// HashTreeLevel returns the digests of all nodes of one level of the hash
+	// tree over the objects of a replica. It is used by the anti-entropy
+	// process to find uuid ranges which differ between replicas.
+	HashTreeLevel(ctx context.Context, host, index, shard string,
+		level int) ([]uint64, error)
+
+	// DigestObjectsInRange returns the digests of up to limit objects whose
+	// uuids lie between initialUUID and finalUUID, both inclusive, ordered
+	// by uuid
+	DigestObjectsInRange(ctx context.Context, host, index, shard string,
+		initialUUID, finalUUID strfmt.UUID, limit int) ([]RepairResponse, error)
 }
 
 // finderClient extends RClient with consistency checks
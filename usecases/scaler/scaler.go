@@ -30,8 +30,6 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 var (
 	// ErrUnresolvedName cannot resolve the host address of a node
@@ -41,7 +39,8 @@ var (
 
 // Scaler scales out/in class replicas.
 //
-// It scales out a class by replicating its shards on new replicas
+// It scales out a class by replicating its shards on new replicas.
+// It scales in a class by removing replicas from the sharding state.
 type Scaler struct {
 	schema          SchemaManager
 	cluster         cluster
@@ -111,7 +110,7 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 	}
 
 	if newReplFactor < prevReplFactor {
-		return s.scaleIn(ctx, className, updated)
+		return s.scaleIn(ctx, className, ssBefore, updated, newReplFactor)
 	}
 
 	return nil, nil
@@ -213,8 +212,31 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 	return rsync.Push(ctx, bak.Shards, dist, className)
 }
 
-func (s *Scaler) scaleIn(ctx context.Context, className string,
-	updated sharding.Config,
+// scaleIn removes class shards from replicas (nodes):
+//
+// * It calculates new sharding state by dropping the trailing replicas of each shard
+// * It returns the new state without touching any data
+//
+// Data cannot be deleted at this point since dropped replicas keep serving
+// traffic until the new state has been committed. Each node deletes its
+// local copies once it applies the committed state, see
+// schema.Manager.updateClassApplyChanges.
+func (s *Scaler) scaleIn(ctx context.Context, className string, ssBefore *sharding.State,
+	updated sharding.Config, replFactor int64,
 ) (*sharding.State, error) {
-	return nil, errors.Errorf("scaling in not supported yet")
+	ssAfter := ssBefore.DeepCopy()
+	ssAfter.Config = updated
+
+	dropped, err := ssAfter.DropReplicas(int(replFactor))
+	if err != nil {
+		return nil, err
+	}
+	for shard, nodes := range dropped {
+		s.logger.WithField("action", "scale_in").
+			WithField("class", className).
+			WithField("shard", shard).
+			WithField("nodes", nodes).
+			Debug("dropping shard replicas")
+	}
+	return &ssAfter, nil
 }
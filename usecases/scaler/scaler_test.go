@@ -40,15 +40,36 @@ func TestScalerScale(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 2, 2)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
+	t.Run("ScaleInToZero", func(t *testing.T) {
 		scaler := newFakeFactory().Scaler("")
 		old := sharding.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
+		_, err := scaler.Scale(ctx, "C", old, 2, 0)
 		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
+		assert.Contains(t, err.Error(), "at least 1")
 	})
 }
 
+func TestScalerScaleIn(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeFactory()
+	f.ShardingState.M = map[string][]string{
+		"S1": {"N1", "N2", "N3"},
+		"S2": {"N2", "N3", "N4"},
+	}
+	scaler := f.Scaler("")
+	updated := sharding.Config{DesiredCount: 2}
+	ss, err := scaler.Scale(ctx, "C", updated, 3, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, updated, ss.Config)
+	assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+	assert.Equal(t, []string{"N2"}, ss.Physical["S2"].BelongsToNodes)
+	assert.True(t, ss.IsLocalShard("S1"))
+
+	// no data must be moved or deleted before the new state is committed
+	f.Client.AssertExpectations(t)
+	f.Source.AssertExpectations(t)
+}
+
 func TestScalerScaleOut(t *testing.T) {
 	var (
 		dataDir = t.TempDir()
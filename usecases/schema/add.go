@@ -31,6 +31,8 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -41,7 +43,7 @@ import (
 func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	class *models.Class,
 ) error {
-	err := m.Authorizer.Authorize(principal, "create", "schema/objects")
+	err := m.Authorizer.Authorize(principal, "create", classResource(class))
 	if err != nil {
 		return err
 	}
@@ -56,6 +58,14 @@ func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	// TODO gh-846: Rollback state update if migration fails
 }
 
+// classResource is the resource which is authorized when creating the class
+func classResource(class *models.Class) string {
+	if class == nil {
+		return authorization.Schema("")
+	}
+	return authorization.Schema(schema.UppercaseClassName(class.Class))
+}
+
 func (m *Manager) RestoreClass(ctx context.Context, d *backup.ClassDescriptor) error {
 	// get schema and sharding state
 	class := &models.Class{}
@@ -232,30 +242,66 @@ func (m *Manager) addClassApplyChanges(ctx context.Context, class *models.Class,
 }
 
 func (m *Manager) setClassDefaults(class *models.Class) {
+	if len(class.VectorConfig) > 0 {
+		m.setNamedVectorDefaults(class)
+	}
+
 	if class.Vectorizer == "" {
 		class.Vectorizer = m.config.DefaultVectorizerModule
 	}
 
 	if class.VectorIndexType == "" {
-		class.VectorIndexType = "hnsw"
+		class.VectorIndexType = vectorindex.DefaultVectorIndexType
 	}
 
-	if m.config.DefaultVectorDistanceMetric != "" {
-		if class.VectorIndexConfig == nil {
-			class.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
-		} else if class.VectorIndexConfig.(map[string]interface{})["distance"] == nil {
-			class.VectorIndexConfig.(map[string]interface{})["distance"] = m.config.DefaultVectorDistanceMetric
-		}
-	}
+	class.VectorIndexConfig = m.vectorIndexConfigWithDefaults(class.VectorIndexConfig)
 
 	setInvertedConfigDefaults(class)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
 	}
+	setObjectTTLDefaults(class)
 
 	m.moduleConfig.SetClassDefaults(class)
 }
 
+// setNamedVectorDefaults sets the defaults of each named vector. A class with
+// named vectors does not have a class-level vector, so neither a vectorizer
+// nor a vector index is used for it.
+func (m *Manager) setNamedVectorDefaults(class *models.Class) {
+	if class.Vectorizer == "" {
+		class.Vectorizer = config.VectorizerModuleNone
+	}
+	if class.VectorIndexConfig == nil {
+		class.VectorIndexConfig = map[string]interface{}{"skip": true}
+	}
+
+	for targetVector, vc := range class.VectorConfig {
+		if vc.Vectorizer == nil {
+			vc.Vectorizer = map[string]interface{}{config.VectorizerModuleNone: map[string]interface{}{}}
+		}
+		if vc.VectorIndexType == "" {
+			vc.VectorIndexType = vectorindex.DefaultVectorIndexType
+		}
+		vc.VectorIndexConfig = m.vectorIndexConfigWithDefaults(vc.VectorIndexConfig)
+		class.VectorConfig[targetVector] = vc
+	}
+}
+
+func (m *Manager) vectorIndexConfigWithDefaults(cfg interface{}) interface{} {
+	if m.config.DefaultVectorDistanceMetric == "" {
+		return cfg
+	}
+
+	if cfg == nil {
+		return map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+	}
+	if asMap, ok := cfg.(map[string]interface{}); ok && asMap["distance"] == nil {
+		asMap["distance"] = m.config.DefaultVectorDistanceMetric
+	}
+	return cfg
+}
+
 func setPropertyDefaults(prop *models.Property) {
 	setPropertyDefaultTokenization(prop)
 	setPropertyDefaultIndexing(prop)
@@ -394,6 +440,14 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := validateObjectTTLConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateAnalyzersConfig(class); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -438,19 +492,35 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
+	if !validVectorIndexType(class.VectorIndexType) {
 		return errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			class.VectorIndexType)
 	}
 
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.configParser(class.VectorIndexConfig, class.VectorIndexType)
 	if err != nil {
 		return errors.Wrap(err, "parse vector index config")
 	}
 
 	class.VectorIndexConfig = parsed
 
+	for targetVector, vc := range class.VectorConfig {
+		if !validVectorIndexType(vc.VectorIndexType) {
+			return errors.Errorf(
+				"parse vector index config of named vector %q: unsupported vector index type: %q",
+				targetVector, vc.VectorIndexType)
+		}
+
+		parsed, err := m.configParser(vc.VectorIndexConfig, vc.VectorIndexType)
+		if err != nil {
+			return errors.Wrapf(err, "parse vector index config of named vector %q", targetVector)
+		}
+
+		vc.VectorIndexConfig = parsed
+		class.VectorConfig[targetVector] = vc
+	}
+
 	return nil
 }
 
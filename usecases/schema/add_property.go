@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -27,7 +28,7 @@ import (
 func (m *Manager) AddClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property *models.Property,
 ) error {
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	err := m.Authorizer.Authorize(principal, "update", authorization.Schema(class))
 	if err != nil {
 		return err
 	}
@@ -129,6 +129,100 @@ func TestAddClass(t *testing.T) {
 		require.Equal(t, expectedStopwordConfig, mgr.schemaCache.ObjectSchema.Classes[0].InvertedIndexConfig.Stopwords)
 	})
 
+	t.Run("with named vectors", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class: "NewClass",
+				VectorConfig: map[string]models.VectorConfig{
+					"title": {
+						Vectorizer: map[string]interface{}{
+							"text2vec-contextionary": map[string]interface{}{},
+						},
+					},
+					"custom": {
+						VectorIndexType: "flat",
+					},
+				},
+			})
+		require.Nil(t, err)
+
+		class := mgr.schemaCache.ObjectSchema.Classes[0]
+		assert.Equal(t, config.VectorizerModuleNone, class.Vectorizer)
+		require.Len(t, class.VectorConfig, 2)
+
+		title := class.VectorConfig["title"]
+		assert.Equal(t, "hnsw", title.VectorIndexType)
+		titleCfg, ok := title.VectorIndexConfig.(fakeVectorConfig)
+		require.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"distance": "cosine"}, titleCfg.raw)
+
+		custom := class.VectorConfig["custom"]
+		assert.Equal(t, "flat", custom.VectorIndexType)
+		assert.Equal(t, map[string]interface{}{
+			config.VectorizerModuleNone: map[string]interface{}{},
+		}, custom.Vectorizer)
+	})
+
+	t.Run("with invalid named vectors", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			class       *models.Class
+			expectedErr string
+		}{
+			{
+				name: "class-level vectorizer",
+				class: &models.Class{
+					Class:        "NewClass",
+					Vectorizer:   "text2vec-contextionary",
+					VectorConfig: map[string]models.VectorConfig{"title": {}},
+				},
+				expectedErr: "class.vectorizer \"text2vec-contextionary\" can not be set if class.vectorConfig is configured",
+			},
+			{
+				name: "invalid vector name",
+				class: &models.Class{
+					Class:        "NewClass",
+					VectorConfig: map[string]models.VectorConfig{"my title": {}},
+				},
+				expectedErr: "'my title' is not a valid vector name",
+			},
+			{
+				name: "unknown vectorizer",
+				class: &models.Class{
+					Class: "NewClass",
+					VectorConfig: map[string]models.VectorConfig{"title": {
+						Vectorizer: map[string]interface{}{"unknown": map[string]interface{}{}},
+					}},
+				},
+				expectedErr: "named vector \"title\": vectorizer: invalid vectorizer \"unknown\"",
+			},
+			{
+				name: "multiple vectorizers",
+				class: &models.Class{
+					Class: "NewClass",
+					VectorConfig: map[string]models.VectorConfig{"title": {
+						Vectorizer: map[string]interface{}{
+							"model1": map[string]interface{}{},
+							"model2": map[string]interface{}{},
+						},
+					}},
+				},
+				expectedErr: "named vector \"title\": vectorizer needs to be an object " +
+					"with exactly one module name as key",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := newSchemaManager().AddClass(context.Background(), nil, test.class)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+
 	t.Run("with tokenizations", func(t *testing.T) {
 		type testCase struct {
 			propName       string
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// validateAnalyzersConfig makes sure every analyzer of the inverted index
+// config belongs to a text property of the class. The steps of the analyzer
+// chains are validated by the inverted index config validator.
+func validateAnalyzersConfig(class *models.Class) error {
+	if class.InvertedIndexConfig == nil {
+		return nil
+	}
+
+	for propName, cfg := range class.InvertedIndexConfig.Analyzers {
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return fmt.Errorf("invertedIndexConfig.analyzers: %w", err)
+		}
+
+		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+		default:
+			return fmt.Errorf("invertedIndexConfig.analyzers: property %q must be of type %s or %s",
+				prop.Name, schema.DataTypeText, schema.DataTypeTextArray)
+		}
+
+		tokenization := prop.Tokenization
+		if cfg.Tokenization != "" {
+			tokenization = cfg.Tokenization
+		}
+		if (cfg.NgramMin != 0 || cfg.NgramMax != 0) &&
+			tokenization != models.PropertyTokenizationNgram {
+			return fmt.Errorf("invertedIndexConfig.analyzers: ngramMin and ngramMax of property %q "+
+				"require tokenization %s", prop.Name, models.PropertyTokenizationNgram)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_Validation_AnalyzersConfig(t *testing.T) {
+	newClass := func(analyzers map[string]models.AnalyzerConfig) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Analyzers: analyzers,
+			},
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "tags", DataType: []string{"text[]"}},
+				{Name: "wordCount", DataType: []string{"int"}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		analyzers   map[string]models.AnalyzerConfig
+		expectedErr string
+	}{
+		{
+			name: "no analyzers",
+		},
+		{
+			name: "text properties",
+			analyzers: map[string]models.AnalyzerConfig{
+				"title": {Lowercase: true, Stemmer: models.AnalyzerConfigStemmerFrench},
+				"tags":  {AsciiFolding: true},
+			},
+		},
+		{
+			name: "missing property",
+			analyzers: map[string]models.AnalyzerConfig{
+				"doesNotExist": {Lowercase: true},
+			},
+			expectedErr: "invertedIndexConfig.analyzers",
+		},
+		{
+			name: "non-text property",
+			analyzers: map[string]models.AnalyzerConfig{
+				"wordCount": {Lowercase: true},
+			},
+			expectedErr: "must be of type text or text[]",
+		},
+		{
+			name: "ngram lengths with ngram tokenization",
+			analyzers: map[string]models.AnalyzerConfig{
+				"title": {
+					Tokenization: models.PropertyTokenizationNgram,
+					NgramMin:     2,
+					NgramMax:     4,
+				},
+			},
+		},
+		{
+			name: "ngram lengths without ngram tokenization",
+			analyzers: map[string]models.AnalyzerConfig{
+				"title": {NgramMax: 4},
+			},
+			expectedErr: "require tokenization ngram",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateAnalyzersConfig(newClass(test.analyzers))
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
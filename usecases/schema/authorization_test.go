@@ -64,25 +64,25 @@ func Test_Schema_Authorization(t *testing.T) {
 			methodName:       "UpdateClass",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		{
 			methodName:       "AddClassProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		{
 			methodName:       "UpdateShardStatus",
@@ -94,19 +94,25 @@ func Test_Schema_Authorization(t *testing.T) {
 			methodName:       "AddTenants",
 			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1"}}},
 			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/className/tenants",
+		},
+		{
+			methodName:       "UpdateTenants",
+			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1", ActivityStatus: models.TenantActivityStatusCOLD}}},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/tenants",
 		},
 		{
 			methodName:       "DeleteTenants",
 			additionalArgs:   []interface{}{"className", []string{"P1"}},
 			expectedVerb:     "delete",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/className/tenants",
 		},
 		{
 			methodName:       "GetTenants",
 			additionalArgs:   []interface{}{"className"},
 			expectedVerb:     "get",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/className/tenants",
 		},
 	}
 
@@ -74,15 +74,19 @@ func (s *schemaCache) ShardReplicas(class, shard string) ([]string, error) {
 	return x.BelongsToNodes, nil
 }
 
-// TenantShard returns shard name for the provided tenant
-func (s *schemaCache) TenantShard(class, tenant string) string {
+// TenantShard returns shard name and activity status for the provided tenant
+func (s *schemaCache) TenantShard(class, tenant string) (string, string) {
 	s.RLock()
 	defer s.RUnlock()
 	ss := s.ShardingState[class]
 	if ss == nil {
-		return ""
+		return "", ""
+	}
+	name := ss.Shard(tenant, "")
+	if name == "" {
+		return "", ""
 	}
-	return ss.Shard(tenant, "")
+	return name, ss.Physical[name].ActivityStatus()
 }
 
 // ShardFromUUID returns shard name of the provided uuid
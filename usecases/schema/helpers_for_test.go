@@ -22,6 +22,8 @@ import (
 
 type fakeRepo struct {
 	schema State
+	// updateShardsErr is returned by UpdateShards if set
+	updateShardsErr error
 }
 
 func newFakeRepo() *fakeRepo {
@@ -53,6 +55,10 @@ func (f *fakeRepo) NewShards(ctx context.Context, class string, shards []KeyValu
 	return nil
 }
 
+func (f *fakeRepo) UpdateShards(ctx context.Context, class string, shards []KeyValuePair) error {
+	return f.updateShardsErr
+}
+
 func (f *fakeRepo) DeleteShards(_ context.Context, class string, _ []string) error {
 	return nil
 }
@@ -71,7 +77,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schema.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return ""
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schema.VectorIndexConfig, error) {
 	return fakeVectorConfig{raw: in}, nil
 }
 
@@ -34,6 +34,8 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddTenantsCommit(ctx, tx)
 	case deleteTenants:
 		return m.handleDeleteTenantsCommit(ctx, tx)
+	case updateTenants:
+		return m.handleUpdateTenantsCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -190,3 +192,28 @@ func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
 
 	return m.onDeleteTenants(ctx, cls, req)
 }
+
+func (m *Manager) handleUpdateTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(UpdateTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdateTenants, but got %T",
+			tx.Payload)
+	}
+	cls := m.getClassByName(req.Class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", req.Class, ErrNotFound)
+	}
+
+	err := m.onUpdateTenants(ctx, cls, req)
+	if err != nil {
+		m.logger.WithField("action", "on_update_tenants").
+			WithField("n", len(req.Tenants)).
+			WithField("class", cls.Class).Error(err)
+	}
+	return err
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -63,6 +64,14 @@ func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, te
 	return func(bool) {}, nil
 }
 
+func (n *NilMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) error {
+	return nil
+}
+
+func (n *NilMigrator) DropShards(ctx context.Context, className string, shards []string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -83,6 +92,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schema.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -91,6 +104,10 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdatePropertyTokenization(ctx context.Context, updated *models.Class, propNames []string) (commit func(success bool), err error) {
+	return func(bool) {}, nil
+}
+
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
@@ -16,6 +16,9 @@ package migrate
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -37,17 +40,52 @@ type Migrator interface {
 
 	NewTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
 	DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
+	UpdateTenants(ctx context.Context, class *models.Class, updates []*UpdateTenantPayload) error
+	DropShards(ctx context.Context, className string, shards []string) error
 
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
 		updated schema.VectorIndexConfig) error
+	UpdateVectorIndexConfigs(ctx context.Context, className string,
+		updated map[string]schema.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(ctx context.Context,
 		old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
+	UpdatePropertyTokenization(ctx context.Context, updated *models.Class,
+		propNames []string) (commit func(success bool), err error)
 	RecalculateVectorDimensions(ctx context.Context) error
 	RecountProperties(ctx context.Context) error
 	InvertedReindex(ctx context.Context, taskNames ...string) error
 	AdjustFilterablePropSettings(ctx context.Context) error
 }
+
+// UpdateTenantPayload contains the new and the previous activity status of
+// a tenant
+type UpdateTenantPayload struct {
+	Name           string
+	Status         string
+	PreviousStatus string
+}
+
+// UpdateTenantsError is returned by UpdateTenants if only some of the
+// tenants could not be updated, all other tenants have their new status.
+// Any other error means that none of the tenants were updated.
+type UpdateTenantsError struct {
+	Failed map[string]error
+}
+
+func (e *UpdateTenantsError) Error() string {
+	names := make([]string, 0, len(e.Failed))
+	for name := range e.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = fmt.Sprintf("tenant %q: %v", name, e.Failed[name])
+	}
+	return strings.Join(msgs, ", ")
+}
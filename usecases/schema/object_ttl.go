@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func setObjectTTLDefaults(class *models.Class) {
+	cfg := class.ObjectTTLConfig
+	if cfg != nil && cfg.Enabled && cfg.DeleteOn == "" {
+		cfg.DeleteOn = filters.InternalPropCreationTimeUnix
+	}
+}
+
+// validateObjectTTLConfig makes sure expired objects can be found through the
+// inverted index, as the background deletion relies on a filter rather than
+// on scanning all objects
+func validateObjectTTLConfig(class *models.Class) error {
+	cfg := class.ObjectTTLConfig
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.DefaultTTL < 0 {
+		return fmt.Errorf("objectTtlConfig.defaultTtl must not be negative")
+	}
+
+	if cfg.DeleteOn == filters.InternalPropCreationTimeUnix {
+		if cfg.DefaultTTL == 0 {
+			return fmt.Errorf("objectTtlConfig.defaultTtl must be set when deleting on %s",
+				filters.InternalPropCreationTimeUnix)
+		}
+		if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+			return fmt.Errorf("objectTtlConfig: deleting on %s requires "+
+				"invertedIndexConfig.indexTimestamps to be enabled",
+				filters.InternalPropCreationTimeUnix)
+		}
+		return nil
+	}
+
+	prop, err := schema.GetPropertyByName(class, cfg.DeleteOn)
+	if err != nil {
+		return fmt.Errorf("objectTtlConfig.deleteOn: %w", err)
+	}
+
+	if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypeDate {
+		return fmt.Errorf("objectTtlConfig.deleteOn: property %q must be of type %s",
+			prop.Name, schema.DataTypeDate)
+	}
+
+	if (prop.IndexFilterable != nil && !*prop.IndexFilterable) ||
+		(prop.IndexFilterable == nil && prop.IndexInverted != nil && !*prop.IndexInverted) {
+		return fmt.Errorf("objectTtlConfig.deleteOn: property %q must be filterable",
+			prop.Name)
+	}
+
+	return nil
+}
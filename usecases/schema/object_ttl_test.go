@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_Validation_ObjectTTLConfig(t *testing.T) {
+	vFalse := false
+	vTrue := true
+
+	newClass := func(cfg *models.ObjectTTLConfig, indexTimestamps bool) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				IndexTimestamps: indexTimestamps,
+			},
+			ObjectTTLConfig: cfg,
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "expiresAt", DataType: []string{"date"}},
+				{Name: "publishedAt", DataType: []string{"date"}, IndexFilterable: &vFalse},
+				{Name: "legacyDate", DataType: []string{"date"}, IndexInverted: &vFalse},
+				{Name: "indexedDate", DataType: []string{"date"}, IndexFilterable: &vTrue},
+			},
+		}
+	}
+
+	type testCase struct {
+		name            string
+		cfg             *models.ObjectTTLConfig
+		indexTimestamps bool
+		expectedErr     string
+	}
+
+	tests := []testCase{
+		{
+			name: "no config",
+		},
+		{
+			name: "disabled config is not validated",
+			cfg:  &models.ObjectTTLConfig{DeleteOn: "doesNotExist"},
+		},
+		{
+			name:            "creation time with ttl",
+			cfg:             &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+			indexTimestamps: true,
+		},
+		{
+			name:        "creation time without timestamp index",
+			cfg:         &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+			expectedErr: "indexTimestamps",
+		},
+		{
+			name:            "creation time without ttl",
+			cfg:             &models.ObjectTTLConfig{Enabled: true},
+			indexTimestamps: true,
+			expectedErr:     "defaultTtl must be set",
+		},
+		{
+			name:        "negative ttl",
+			cfg:         &models.ObjectTTLConfig{Enabled: true, DefaultTTL: -1},
+			expectedErr: "must not be negative",
+		},
+		{
+			name: "date property",
+			cfg:  &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt"},
+		},
+		{
+			name: "date property with offset",
+			cfg:  &models.ObjectTTLConfig{Enabled: true, DeleteOn: "indexedDate", DefaultTTL: 60},
+		},
+		{
+			name:        "missing property",
+			cfg:         &models.ObjectTTLConfig{Enabled: true, DeleteOn: "doesNotExist"},
+			expectedErr: "objectTtlConfig.deleteOn",
+		},
+		{
+			name:        "non-date property",
+			cfg:         &models.ObjectTTLConfig{Enabled: true, DeleteOn: "title"},
+			expectedErr: "must be of type date",
+		},
+		{
+			name:        "non-filterable property",
+			cfg:         &models.ObjectTTLConfig{Enabled: true, DeleteOn: "publishedAt"},
+			expectedErr: "must be filterable",
+		},
+		{
+			name:        "non-inverted legacy property",
+			cfg:         &models.ObjectTTLConfig{Enabled: true, DeleteOn: "legacyDate"},
+			expectedErr: "must be filterable",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := newClass(test.cfg, test.indexTimestamps)
+			setObjectTTLDefaults(class)
+
+			err := validateObjectTTLConfig(class)
+			if test.expectedErr == "" {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func Test_ObjectTTLDefaults(t *testing.T) {
+	t.Run("enabled without deleteOn", func(t *testing.T) {
+		class := &models.Class{ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true}}
+		setObjectTTLDefaults(class)
+		assert.Equal(t, "_creationTimeUnix", class.ObjectTTLConfig.DeleteOn)
+	})
+
+	t.Run("disabled is left untouched", func(t *testing.T) {
+		class := &models.Class{ObjectTTLConfig: &models.ObjectTTLConfig{}}
+		setObjectTTLDefaults(class)
+		assert.Empty(t, class.ObjectTTLConfig.DeleteOn)
+	})
+
+	t.Run("explicit deleteOn is kept", func(t *testing.T) {
+		class := &models.Class{ObjectTTLConfig: &models.ObjectTTLConfig{
+			Enabled: true, DeleteOn: "expiresAt",
+		}}
+		setObjectTTLDefaults(class)
+		assert.Equal(t, "expiresAt", class.ObjectTTLConfig.DeleteOn)
+	})
+}
@@ -14,20 +14,20 @@ package schema
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var regexTenantName = regexp.MustCompile(`^` + schema.ShardNameRegexCore + `$`)
 
-// tenantsPath is the main path used for authorization
-const tenantsPath = "schema/tenants"
-
 // AddTenants is used to add new tenants to a class
 // Class must exist and has partitioning enabled
 func (m *Manager) AddTenants(ctx context.Context,
@@ -35,18 +35,23 @@ func (m *Manager) AddTenants(ctx context.Context,
 	class string,
 	tenants []*models.Tenant,
 ) (err error) {
-	if err := m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+	if err := m.Authorizer.Authorize(principal, "update", authorization.SchemaTenants(class)); err != nil {
 		return err
 	}
 	tenantNames := make([]string, len(tenants))
+	statuses := make(map[string]string, len(tenants))
 	for i, tenant := range tenants {
 		tenantNames[i] = tenant.Name
+		statuses[tenant.Name] = tenant.ActivityStatus
 	}
 
 	// validation
 	if err := validateTenants(tenantNames); err != nil {
 		return err
 	}
+	if err := m.validateActivityStatuses(tenants, false); err != nil {
+		return err
+	}
 	cls := m.getClassByName(class)
 	if cls == nil {
 		return fmt.Errorf("class %q: %w", class, ErrNotFound)
@@ -66,7 +71,7 @@ func (m *Manager) AddTenants(ctx context.Context,
 	}
 	i := 0
 	for name, owners := range partitions {
-		request.Tenants[i] = Tenant{Name: name, Nodes: owners}
+		request.Tenants[i] = Tenant{Name: name, Nodes: owners, Status: statuses[name]}
 		i++
 	}
 
@@ -122,6 +127,32 @@ func validateTenants(tenants []string) error {
 	return nil
 }
 
+// validateActivityStatuses validates the activity statuses of tenants being
+// created or updated. Tenants can only be FROZEN by an update and only if an
+// offload backend is configured.
+func (m *Manager) validateActivityStatuses(tenants []*models.Tenant, isUpdate bool) error {
+	for i, tenant := range tenants {
+		switch status := tenant.ActivityStatus; status {
+		case models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD:
+		case models.TenantActivityStatusFROZEN:
+			if !isUpdate {
+				return uco.NewErrInvalidUserInput("tenant at index %d: activity status %q can only be set on existing tenants", i, status)
+			}
+			if m.config.TenantOffload.Backend == "" {
+				return uco.NewErrInvalidUserInput("tenant at index %d: activity status %q requires a tenant offload backend to be configured", i, status)
+			}
+		case "":
+			if isUpdate {
+				return uco.NewErrInvalidUserInput("tenant at index %d: missing activity status", i)
+			}
+		default:
+			return uco.NewErrInvalidUserInput("tenant at index %d: invalid activity status %q, allowed values are %q, %q and %q",
+				i, status, models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD, models.TenantActivityStatusFROZEN)
+		}
+	}
+	return nil
+}
+
 func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request AddTenantsPayload,
 ) error {
 	st := sharding.State{
@@ -131,7 +162,7 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 	pairs := make([]KeyValuePair, 0, len(request.Tenants))
 	for _, p := range request.Tenants {
 		if _, ok := st.Physical[p.Name]; !ok {
-			p := st.AddPartition(p.Name, p.Nodes)
+			p := st.AddPartition(p.Name, p.Nodes, p.Status)
 			data, err := json.Marshal(p)
 			if err != nil {
 				return fmt.Errorf("cannot marshal partition %s: %w", p.Name, err)
@@ -141,7 +172,8 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 	}
 	shards := make([]string, 0, len(request.Tenants))
 	for _, p := range request.Tenants {
-		if st.IsLocalShard(p.Name) {
+		// COLD tenants are not loaded, their shard is created once activated
+		if st.IsLocalShard(p.Name) && p.Status != models.TenantActivityStatusCOLD {
 			shards = append(shards, p.Name)
 		}
 	}
@@ -174,7 +206,7 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 //
 // Class must exist and has partitioning enabled
 func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error {
-	if err := m.Authorizer.Authorize(principal, "delete", tenantsPath); err != nil {
+	if err := m.Authorizer.Authorize(principal, "delete", authorization.SchemaTenants(class)); err != nil {
 		return err
 	}
 	// validation
@@ -238,11 +270,224 @@ func (m *Manager) onDeleteTenants(ctx context.Context, class *models.Class, req
 	return nil
 }
 
+// UpdateTenants is used to set the activity status of tenants of a class.
+//
+// Class must exist and has partitioning enabled
+func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal,
+	class string, tenants []*models.Tenant,
+) error {
+	if err := m.Authorizer.Authorize(principal, "update", authorization.SchemaTenants(class)); err != nil {
+		return err
+	}
+	tenantNames := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		tenantNames[i] = tenant.Name
+	}
+
+	// validation
+	if err := validateTenants(tenantNames); err != nil {
+		return err
+	}
+	if err := m.validateActivityStatuses(tenants, true); err != nil {
+		return err
+	}
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+	if err := m.schemaCache.RLockGuard(func() error {
+		ss := m.schemaCache.ShardingState[cls.Class]
+		if ss == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		for _, name := range tenantNames {
+			if _, ok := ss.Physical[name]; !ok {
+				return uco.NewErrInvalidUserInput("tenant %q does not exist", name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	request := UpdateTenantsPayload{
+		Class:   class,
+		Tenants: make([]Tenant, len(tenants)),
+	}
+	for i, tenant := range tenants {
+		request.Tenants[i] = Tenant{Name: tenant.Name, Status: tenant.ActivityStatus}
+	}
+
+	// open cluster-wide transaction
+	tx, err := m.cluster.BeginTransaction(ctx, updateTenants,
+		request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	err = m.onUpdateTenants(ctx, cls, request) // actual update
+	if err != nil {
+		m.logger.WithField("action", "update_tenants").
+			WithField("n", len(request.Tenants)).
+			WithField("class", cls.Class).Error(err)
+	}
+
+	return err
+}
+
+// onUpdateTenants changes the activity status of tenants. Shards of tenants
+// becoming COLD or FROZEN are marked as such before being unloaded, so that
+// no new requests reach them. Shards of tenants becoming HOT are loaded
+// before being marked as HOT. If the shards cannot be updated, the previous
+// status is restored.
+func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, req UpdateTenantsPayload,
+) error {
+	var (
+		updated  = make([]sharding.Physical, 0, len(req.Tenants))
+		previous = make([]sharding.Physical, 0, len(req.Tenants))
+		local    = make([]*migrate.UpdateTenantPayload, 0, len(req.Tenants))
+	)
+	m.schemaCache.RLockGuard(func() error {
+		ss := m.schemaCache.ShardingState[req.Class]
+		if ss == nil {
+			return nil
+		}
+		for _, t := range req.Tenants {
+			p, ok := ss.Physical[t.Name]
+			if !ok || p.ActivityStatus() == t.Status {
+				continue
+			}
+			previous = append(previous, p.DeepCopy())
+			prevStatus := p.ActivityStatus()
+			p = p.DeepCopy()
+			p.Status = t.Status
+			updated = append(updated, p)
+			if ss.IsLocalShard(t.Name) {
+				local = append(local, &migrate.UpdateTenantPayload{
+					Name:           t.Name,
+					Status:         t.Status,
+					PreviousStatus: prevStatus,
+				})
+			}
+		}
+		return nil
+	})
+	if len(updated) == 0 {
+		return nil
+	}
+
+	// tenants which are deactivated are marked in the cache before their
+	// shards are unloaded, activated ones only once their shards are loaded
+	updateCache := func(partitions []sharding.Physical, activated bool) {
+		m.schemaCache.LockGuard(func() {
+			ss := m.schemaCache.ShardingState[req.Class]
+			if ss == nil {
+				return
+			}
+			for _, p := range partitions {
+				if (p.Status == models.TenantActivityStatusHOT) == activated {
+					ss.Physical[p.Name] = p
+				}
+			}
+		})
+	}
+	rollbackCache := func(failed map[string]struct{}) {
+		m.schemaCache.LockGuard(func() {
+			ss := m.schemaCache.ShardingState[req.Class]
+			if ss == nil {
+				return
+			}
+			for _, p := range previous {
+				if _, ok := failed[p.Name]; ok {
+					ss.Physical[p.Name] = p
+				}
+			}
+		})
+	}
+
+	updateCache(updated, false)
+	migratorErr := m.migrator.UpdateTenants(ctx, class, local)
+	failed := make(map[string]struct{}, len(updated))
+	if migratorErr != nil {
+		var partial *migrate.UpdateTenantsError
+		if errors.As(migratorErr, &partial) {
+			for name := range partial.Failed {
+				failed[name] = struct{}{}
+			}
+		} else {
+			for _, p := range updated {
+				failed[p.Name] = struct{}{}
+			}
+		}
+		rollbackCache(failed)
+		migratorErr = fmt.Errorf("migrator.update_tenants: %w", migratorErr)
+	}
+
+	// the tenants which were updated are persisted even if others failed
+	succeeded := make(map[string]struct{}, len(updated))
+	pairs := make([]KeyValuePair, 0, len(updated))
+	for _, p := range updated {
+		if _, ok := failed[p.Name]; ok {
+			continue
+		}
+		data, err := json.Marshal(p)
+		if err != nil {
+			return fmt.Errorf("cannot marshal partition %s: %w", p.Name, err)
+		}
+		pairs = append(pairs, KeyValuePair{p.Name, data})
+		succeeded[p.Name] = struct{}{}
+	}
+	if len(pairs) == 0 {
+		return migratorErr
+	}
+
+	m.logger.
+		WithField("action", "schema.update_tenants").
+		WithField("n", len(pairs)).Debugf("persist schema updates")
+
+	if err := m.repo.UpdateShards(ctx, class.Class, pairs); err != nil {
+		// the persisted schema keeps the previous status, so do the shards
+		revert := make([]*migrate.UpdateTenantPayload, 0, len(local))
+		for _, u := range local {
+			if _, ok := succeeded[u.Name]; ok {
+				revert = append(revert, &migrate.UpdateTenantPayload{
+					Name:           u.Name,
+					Status:         u.PreviousStatus,
+					PreviousStatus: u.Status,
+				})
+			}
+		}
+		if err2 := m.migrator.UpdateTenants(ctx, class, revert); err2 != nil {
+			m.logger.WithField("action", "schema.update_tenants").
+				WithError(err2).Error("cannot restore the previous status of tenants")
+		}
+		rollbackCache(succeeded)
+		return fmt.Errorf("persist tenant status: %w", err)
+	}
+
+	updatedCache := make([]sharding.Physical, 0, len(succeeded))
+	for _, p := range updated {
+		if _, ok := succeeded[p.Name]; ok {
+			updatedCache = append(updatedCache, p)
+		}
+	}
+	updateCache(updatedCache, true)
+
+	return migratorErr
+}
+
 // GetTenants is used to get tenants of a class.
 //
 // Class must exist and has partitioning enabled
 func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, class string) ([]*models.Tenant, error) {
-	if err := m.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
+	if err := m.Authorizer.Authorize(principal, "get", authorization.SchemaTenants(class)); err != nil {
 		return nil, err
 	}
 	// validation
@@ -259,8 +504,11 @@ func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, c
 		if ss := m.schemaCache.ShardingState[cls.Class]; ss != nil {
 			tenants = make([]*models.Tenant, len(ss.Physical))
 			i := 0
-			for tenant := range ss.Physical {
-				tenants[i] = &models.Tenant{Name: tenant}
+			for tenant, p := range ss.Physical {
+				tenants[i] = &models.Tenant{
+					Name:           tenant,
+					ActivityStatus: p.ActivityStatus(),
+				}
 				i++
 			}
 		}
@@ -13,11 +13,14 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 )
 
 func TestAddTenants(t *testing.T) {
@@ -91,6 +94,30 @@ func TestAddTenants(t *testing.T) {
 			},
 			errMsg: "tenant",
 		},
+		{
+			name:    "FrozenActivityStatus",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "Aaaa", ActivityStatus: models.TenantActivityStatusFROZEN}},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsg: "can only be set on existing tenants",
+		},
+		{
+			name:    "InvalidActivityStatus",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "Aaaa", ActivityStatus: "WARM"}},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsg: "invalid activity status",
+		},
 		{
 			name:    "Success",
 			Class:   "C1",
@@ -249,3 +276,213 @@ func TestDeleteTenants(t *testing.T) {
 
 	}
 }
+
+// failingTenantsMigrator fails the update of all tenants with err, or only
+// the update of the failed tenants if set
+type failingTenantsMigrator struct {
+	NilMigrator
+	err    error
+	failed []string
+	calls  [][]*migrate.UpdateTenantPayload
+}
+
+func (m *failingTenantsMigrator) UpdateTenants(ctx context.Context, class *models.Class,
+	updates []*migrate.UpdateTenantPayload,
+) error {
+	m.calls = append(m.calls, updates)
+	if len(m.failed) == 0 {
+		return m.err
+	}
+
+	failed := map[string]error{}
+	for _, u := range updates {
+		for _, name := range m.failed {
+			if u.Name == name {
+				failed[name] = m.err
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &migrate.UpdateTenantsError{Failed: failed}
+}
+
+func TestUpdateTenants(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		tenants = []*models.Tenant{
+			{Name: "USER1"},
+			{Name: "USER2", ActivityStatus: models.TenantActivityStatusCOLD},
+		}
+		newClass = func() *models.Class {
+			return &models.Class{
+				Class:              "C1",
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties: []*models.Property{
+					{
+						Name:     "uUID",
+						DataType: schema.DataTypeText.PropString(),
+					},
+				},
+				ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+			}
+		}
+	)
+
+	statuses := func(t *testing.T, sm *Manager) map[string]string {
+		res, err := sm.GetTenants(ctx, nil, "C1")
+		require.Nil(t, err)
+		out := make(map[string]string, len(res))
+		for _, tenant := range res {
+			out[tenant.Name] = tenant.ActivityStatus
+		}
+		return out
+	}
+
+	tests := []struct {
+		name            string
+		Class           string
+		tenants         []*models.Tenant
+		offloadBackend  string
+		migratorErr     error
+		failedTenants   []string
+		updateShardsErr error
+		errMsg          string
+		expected        map[string]string
+	}{
+		{
+			name:    "UnknownClass",
+			Class:   "UnknownClass",
+			tenants: []*models.Tenant{{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD}},
+			errMsg:  ErrNotFound.Error(),
+		},
+		{
+			name:    "UnknownTenant",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER3", ActivityStatus: models.TenantActivityStatusCOLD}},
+			errMsg:  "does not exist",
+		},
+		{
+			name:    "MissingActivityStatus",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER1"}},
+			errMsg:  "missing activity status",
+		},
+		{
+			name:    "InvalidActivityStatus",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER1", ActivityStatus: "WARM"}},
+			errMsg:  "invalid activity status",
+		},
+		{
+			name:    "FrozenWithoutOffloadBackend",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER1", ActivityStatus: models.TenantActivityStatusFROZEN}},
+			errMsg:  "requires a tenant offload backend",
+		},
+		{
+			name:           "FrozenWithOffloadBackend",
+			Class:          "C1",
+			tenants:        []*models.Tenant{{Name: "USER1", ActivityStatus: models.TenantActivityStatusFROZEN}},
+			offloadBackend: "s3",
+			expected: map[string]string{
+				"USER1": models.TenantActivityStatusFROZEN,
+				"USER2": models.TenantActivityStatusCOLD,
+			},
+		},
+		{
+			name:  "MigratorFailure",
+			Class: "C1",
+			tenants: []*models.Tenant{
+				{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: "USER2", ActivityStatus: models.TenantActivityStatusHOT},
+			},
+			migratorErr: errors.New("disk full"),
+			errMsg:      "disk full",
+		},
+		{
+			name:  "PartialMigratorFailure",
+			Class: "C1",
+			tenants: []*models.Tenant{
+				{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: "USER2", ActivityStatus: models.TenantActivityStatusHOT},
+			},
+			migratorErr:   errors.New("disk full"),
+			failedTenants: []string{"USER1"},
+			errMsg:        `tenant "USER1": disk full`,
+			expected: map[string]string{
+				"USER1": models.TenantActivityStatusHOT,
+				"USER2": models.TenantActivityStatusHOT,
+			},
+		},
+		{
+			name:  "UpdateShardsFailure",
+			Class: "C1",
+			tenants: []*models.Tenant{
+				{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: "USER2", ActivityStatus: models.TenantActivityStatusHOT},
+			},
+			updateShardsErr: errors.New("store unavailable"),
+			errMsg:          "store unavailable",
+		},
+		{
+			name:  "Success",
+			Class: "C1",
+			tenants: []*models.Tenant{
+				{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: "USER2", ActivityStatus: models.TenantActivityStatusHOT},
+			},
+			expected: map[string]string{
+				"USER1": models.TenantActivityStatusCOLD,
+				"USER2": models.TenantActivityStatusHOT,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sm := newSchemaManager()
+			sm.config.TenantOffload.Backend = test.offloadBackend
+			require.Nil(t, sm.AddClass(ctx, nil, newClass()))
+			require.Nil(t, sm.AddTenants(ctx, nil, "C1", tenants))
+			assert.Equal(t, map[string]string{
+				"USER1": models.TenantActivityStatusHOT,
+				"USER2": models.TenantActivityStatusCOLD,
+			}, statuses(t, sm))
+
+			migrator := &failingTenantsMigrator{
+				err:    test.migratorErr,
+				failed: test.failedTenants,
+			}
+			sm.migrator = migrator
+			sm.repo.(*fakeRepo).updateShardsErr = test.updateShardsErr
+
+			err := sm.UpdateTenants(ctx, nil, test.Class, test.tenants)
+			if test.errMsg != "" {
+				assert.ErrorContains(t, err, test.errMsg)
+				// tenants which could not be updated keep their previous status
+				expected := test.expected
+				if expected == nil {
+					expected = map[string]string{
+						"USER1": models.TenantActivityStatusHOT,
+						"USER2": models.TenantActivityStatusCOLD,
+					}
+				}
+				assert.Equal(t, expected, statuses(t, sm))
+
+				if test.updateShardsErr != nil {
+					// the shards are moved back to their previous status
+					require.Len(t, migrator.calls, 2)
+					assert.ElementsMatch(t, []*migrate.UpdateTenantPayload{
+						{Name: "USER1", Status: models.TenantActivityStatusHOT, PreviousStatus: models.TenantActivityStatusCOLD},
+						{Name: "USER2", Status: models.TenantActivityStatusCOLD, PreviousStatus: models.TenantActivityStatusHOT},
+					}, migrator.calls[1])
+				}
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, statuses(t, sm))
+		})
+	}
+}
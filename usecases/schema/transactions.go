@@ -29,6 +29,7 @@ const (
 	// tenant types
 	addTenants    cluster.TransactionType = "add_tenants"
 	deleteTenants cluster.TransactionType = "delete_tenants"
+	updateTenants cluster.TransactionType = "update_tenants"
 
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
@@ -51,8 +52,9 @@ type AddPropertyPayload struct {
 
 // Tenant represents properties of a specific tenant (physical shard)
 type Tenant struct {
-	Name  string   `json:"name"`
-	Nodes []string `json:"nodes"`
+	Name   string   `json:"name"`
+	Nodes  []string `json:"nodes"`
+	Status string   `json:"status,omitempty"`
 }
 
 // AddTenantsPayload allows for adding multiple tenants to a class
@@ -67,6 +69,13 @@ type DeleteTenantsPayload struct {
 	Tenants []string `json:"tenants"`
 }
 
+// UpdateTenantsPayload allows for changing the activity status of multiple
+// tenants of a class
+type UpdateTenantsPayload struct {
+	Class   string   `json:"class_name"`
+	Tenants []Tenant `json:"tenants"`
+}
+
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 }
@@ -103,6 +112,8 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddTenantsPayload](payload)
 	case deleteTenants:
 		return unmarshalRawJson[DeleteTenantsPayload](payload)
+	case updateTenants:
+		return unmarshalRawJson[UpdateTenantsPayload](payload)
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
 
@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -29,7 +30,7 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	m.Lock()
 	defer m.Unlock()
 
-	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	err := m.Authorizer.Authorize(principal, "update", authorization.Schema(className))
 	if err != nil {
 		return err
 	}
@@ -51,6 +52,10 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
+	if err := validateObjectTTLConfig(updated); err != nil {
+		return err
+	}
+
 	if err := m.parseVectorIndexConfig(ctx, updated); err != nil {
 		return err
 	}
@@ -65,6 +70,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	for targetVector, vc := range updated.VectorConfig {
+		if err := m.migrator.ValidateVectorIndexConfigUpdate(ctx,
+			initial.VectorConfig[targetVector].VectorIndexConfig.(schema.VectorIndexConfig),
+			vc.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
+			return errors.Wrapf(err, "vector index config of named vector %q", targetVector)
+		}
+	}
+
 	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -84,7 +97,7 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	if initialRF != updatedRF {
 		uss, err := m.scaleOut.Scale(ctx, className, updatedSharding, initialRF, updatedRF)
 		if err != nil {
-			return errors.Wrapf(err, "scale out from %d to %d replicas",
+			return errors.Wrapf(err, "scale from %d to %d replicas",
 				initialRF, updatedRF)
 		}
 		updatedState = uss
@@ -145,6 +158,16 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	if len(updated.VectorConfig) > 0 {
+		vectorIndexConfigs := make(map[string]schema.VectorIndexConfig, len(updated.VectorConfig))
+		for targetVector, vc := range updated.VectorConfig {
+			vectorIndexConfigs[targetVector] = vc.VectorIndexConfig.(schema.VectorIndexConfig)
+		}
+		if err := m.migrator.UpdateVectorIndexConfigs(ctx, className, vectorIndexConfigs); err != nil {
+			return errors.Wrap(err, "vector index configs")
+		}
+	}
+
 	if err := m.migrator.UpdateInvertedIndexConfig(ctx, className,
 		updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -158,7 +181,21 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		return ErrNotFound
 	}
 
-	m.schemaCache.LockGuard(func() { *initial = *updated })
+	// the objects are reindexed before the updated tokenization is used for
+	// queries, the class is read-only in the meantime
+	commitTokenization := func(success bool) {}
+	if propNames := propertiesWithUpdatedTokenization(initial, updated); len(propNames) > 0 {
+		commit, err := m.migrator.UpdatePropertyTokenization(ctx, updated, propNames)
+		if err != nil {
+			return errors.Wrap(err, "property tokenization")
+		}
+		commitTokenization = commit
+	}
+
+	m.schemaCache.LockGuard(func() {
+		commitTokenization(true)
+		*initial = *updated
+	})
 
 	payload, err := CreateClassPayload(updated, updatedShardingState)
 	if err != nil {
@@ -167,13 +204,20 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	payload.ReplaceShards = updatedShardingState != nil
 	// can be improved by updating the diff
 
+	// shards which were local before the update, but aren't anymore (scale in)
+	var droppedShards []string
 	if updatedShardingState != nil {
 		// do not override if transaction does not contain an updated state
 
 		// the sharding state caches the node name, we must therefore set this
 		// explicitly now.
 		updatedShardingState.SetLocalName(m.clusterState.LocalName())
-		m.schemaCache.LockGuard(func() { m.schemaCache.ShardingState[className] = updatedShardingState })
+		m.schemaCache.LockGuard(func() {
+			if prev := m.schemaCache.ShardingState[className]; prev != nil {
+				droppedShards = droppedLocalShards(prev, updatedShardingState)
+			}
+			m.schemaCache.ShardingState[className] = updatedShardingState
+		})
 	}
 	m.logger.
 		WithField("action", "schema.update_class").
@@ -185,9 +229,28 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	}
 	m.triggerSchemaUpdateCallbacks()
 
+	// The committed state no longer routes traffic to the dropped replicas,
+	// it is now safe to delete their data
+	if len(droppedShards) > 0 {
+		if err := m.migrator.DropShards(ctx, className, droppedShards); err != nil {
+			return errors.Wrap(err, "drop shards")
+		}
+	}
+
 	return nil
 }
 
+// droppedLocalShards returns local shards of before which are not local in after
+func droppedLocalShards(before, after *sharding.State) []string {
+	var dropped []string
+	for _, name := range before.AllLocalPhysicalShards() {
+		if !after.IsLocalShard(name) {
+			dropped = append(dropped, name)
+		}
+	}
+	return dropped
+}
+
 func (m *Manager) validateImmutableFields(initial, updated *models.Class) error {
 	immutableFields := []immutableText{
 		{
@@ -210,17 +273,128 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		}
 	}
 
-	if !reflect.DeepEqual(initial.Properties, updated.Properties) {
+	if !reflect.DeepEqual(propertiesWithoutTokenization(initial.Properties),
+		propertiesWithoutTokenization(updated.Properties)) {
 		return errors.Errorf(
 			"properties cannot be updated through updating the class. Use the add " +
 				"property feature (e.g. \"POST /v1/schema/{className}/properties\") " +
 				"to add additional properties")
 	}
 
+	if err := m.validatePropertyTokenizationUpdates(initial, updated); err != nil {
+		return err
+	}
+
 	if !reflect.DeepEqual(initial.ModuleConfig, updated.ModuleConfig) {
 		return errors.Errorf("module config is immutable")
 	}
 
+	if err := validateImmutableNamedVectors(initial, updated); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// propertiesWithoutTokenization returns shallow copies of the properties with
+// their tokenization unset, as it is the only mutable field of a property
+func propertiesWithoutTokenization(props []*models.Property) []*models.Property {
+	out := make([]*models.Property, len(props))
+	for i, prop := range props {
+		if prop == nil {
+			continue
+		}
+		cp := *prop
+		cp.Tokenization = ""
+		out[i] = &cp
+	}
+	return out
+}
+
+// validatePropertyTokenizationUpdates makes sure that a changed tokenization
+// of a property is valid for its data type. Properties with an analyzer
+// configured cannot change their tokenization, as the analyzer chain is not
+// rebuilt before a restart.
+//
+// The existing objects are reindexed with the new tokenization as part of
+// the update, see updateClassApplyChanges. Inactive tenants would miss the
+// reindex, hence all tenants have to be active.
+func (m *Manager) validatePropertyTokenizationUpdates(initial, updated *models.Class) error {
+	propNames := propertiesWithUpdatedTokenization(initial, updated)
+	if len(propNames) == 0 {
+		return nil
+	}
+
+	if ss := m.schemaCache.CopyShardingState(initial.Class); ss != nil && ss.PartitioningEnabled {
+		for name, physical := range ss.Physical {
+			if status := physical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+				return errors.Errorf("tokenization cannot be updated while tenant %q is %s, "+
+					"activate all tenants first", name, status)
+			}
+		}
+	}
+
+	for i, prop := range updated.Properties {
+		before := initial.Properties[i]
+		if before.Tokenization == prop.Tokenization {
+			continue
+		}
+
+		if initial.InvertedIndexConfig != nil {
+			if _, ok := initial.InvertedIndexConfig.Analyzers[prop.Name]; ok {
+				return errors.Errorf("tokenization of property %q cannot be updated, "+
+					"as it has an analyzer configured", prop.Name)
+			}
+		}
+
+		sch := m.getSchema()
+		propertyDataType, err := (&sch).FindPropertyDataType(prop.DataType)
+		if err != nil {
+			return errors.Wrapf(err, "property %q: invalid dataType", prop.Name)
+		}
+		if err := m.validatePropertyTokenization(prop.Tokenization, propertyDataType); err != nil {
+			return errors.Wrapf(err, "tokenization of property %q", prop.Name)
+		}
+	}
+
+	return nil
+}
+
+// propertiesWithUpdatedTokenization returns the names of the properties whose
+// tokenization differs between initial and updated. The properties are
+// expected to be the same otherwise.
+func propertiesWithUpdatedTokenization(initial, updated *models.Class) []string {
+	var propNames []string
+	for i, prop := range updated.Properties {
+		if i < len(initial.Properties) && initial.Properties[i].Tokenization != prop.Tokenization {
+			propNames = append(propNames, prop.Name)
+		}
+	}
+	return propNames
+}
+
+// validateImmutableNamedVectors makes sure that named vectors are neither
+// added nor removed and that only their vector index config is changed
+func validateImmutableNamedVectors(initial, updated *models.Class) error {
+	if len(initial.VectorConfig) != len(updated.VectorConfig) {
+		return errors.Errorf("named vectors cannot be added or removed")
+	}
+
+	for targetVector, initialCfg := range initial.VectorConfig {
+		updatedCfg, ok := updated.VectorConfig[targetVector]
+		if !ok {
+			return errors.Errorf("named vector %q cannot be removed", targetVector)
+		}
+		if !reflect.DeepEqual(initialCfg.Vectorizer, updatedCfg.Vectorizer) {
+			return errors.Errorf("vectorizer of named vector %q is immutable", targetVector)
+		}
+		if initialCfg.VectorIndexType != updatedCfg.VectorIndexType {
+			return errors.Errorf("vector index type of named vector %q is immutable: "+
+				"attempted change from %q to %q", targetVector,
+				initialCfg.VectorIndexType, updatedCfg.VectorIndexType)
+		}
+	}
+
 	return nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 // As of now, most class settings are immutable, but we need to allow some
@@ -130,6 +131,89 @@ func TestClassUpdates(t *testing.T) {
 						"property feature (e.g. \"POST /v1/schema/{className}/properties\") " +
 						"to add additional properties"),
 			},
+			{
+				name: "updating the tokenization of a text property",
+				initial: &models.Class{
+					Class: "InitialName",
+					Properties: []*models.Property{
+						{
+							Name:     "aProp",
+							DataType: schema.DataTypeText.PropString(),
+						},
+					},
+				},
+				update: &models.Class{
+					Class: "InitialName",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationCjk,
+						},
+					},
+				},
+				expectedError: nil,
+			},
+			{
+				name: "attempting to tokenize a non-text property",
+				initial: &models.Class{
+					Class: "InitialName",
+					Properties: []*models.Property{
+						{
+							Name:     "aProp",
+							DataType: schema.DataTypeInt.PropString(),
+						},
+					},
+				},
+				update: &models.Class{
+					Class: "InitialName",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeInt.PropString(),
+							Tokenization: models.PropertyTokenizationNgram,
+						},
+					},
+				},
+				expectedError: errors.Errorf(
+					"tokenization of property \"aProp\": " +
+						"Tokenization is not allowed for data type 'int'"),
+			},
+			{
+				name: "attempting to update the tokenization of a property with an analyzer",
+				initial: &models.Class{
+					Class: "InitialName",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						Analyzers: map[string]models.AnalyzerConfig{
+							"aProp": {Lowercase: true},
+						},
+					},
+					Properties: []*models.Property{
+						{
+							Name:     "aProp",
+							DataType: schema.DataTypeText.PropString(),
+						},
+					},
+				},
+				update: &models.Class{
+					Class: "InitialName",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						Analyzers: map[string]models.AnalyzerConfig{
+							"aProp": {Lowercase: true},
+						},
+					},
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationNgram,
+						},
+					},
+				},
+				expectedError: errors.Errorf(
+					"tokenization of property \"aProp\" cannot be updated, " +
+						"as it has an analyzer configured"),
+			},
 			{
 				name: "attempting to update the inverted index cleanup interval",
 				initial: &models.Class{
@@ -350,6 +434,97 @@ func TestClassUpdates(t *testing.T) {
 		})
 	})
 
+	t.Run("update property tokenization", func(t *testing.T) {
+		newClass := func(tokenization string) *models.Class {
+			return &models.Class{
+				Class: "ClassWithTokenization",
+				Properties: []*models.Property{
+					{
+						Name:         "aProp",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: tokenization,
+					},
+					{
+						Name:         "otherProp",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+					},
+				},
+			}
+		}
+		tokenizationOf := func(sm *Manager) string {
+			return sm.getClassByName("ClassWithTokenization").Properties[0].Tokenization
+		}
+
+		t.Run("reindexes before the update is applied", func(t *testing.T) {
+			sm := newSchemaManager()
+			migrator := &tokenizationMigrator{}
+			sm.migrator = migrator
+			require.Nil(t, sm.AddClass(context.Background(), nil, newClass(models.PropertyTokenizationWord)))
+
+			err := sm.UpdateClass(context.Background(), nil, "ClassWithTokenization",
+				newClass(models.PropertyTokenizationCjk))
+			require.Nil(t, err)
+
+			assert.Equal(t, []string{"aProp"}, migrator.propNames)
+			assert.Equal(t, []bool{true}, migrator.commits)
+			assert.Equal(t, models.PropertyTokenizationCjk, tokenizationOf(sm))
+		})
+
+		t.Run("keeps the tokenization if reindexing fails", func(t *testing.T) {
+			sm := newSchemaManager()
+			migrator := &tokenizationMigrator{err: errors.New("disk full")}
+			sm.migrator = migrator
+			require.Nil(t, sm.AddClass(context.Background(), nil, newClass(models.PropertyTokenizationWord)))
+
+			err := sm.UpdateClass(context.Background(), nil, "ClassWithTokenization",
+				newClass(models.PropertyTokenizationCjk))
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "disk full")
+
+			assert.Empty(t, migrator.commits)
+			assert.Equal(t, models.PropertyTokenizationWord, tokenizationOf(sm))
+		})
+
+		t.Run("does not reindex if the tokenization is unchanged", func(t *testing.T) {
+			sm := newSchemaManager()
+			migrator := &tokenizationMigrator{}
+			sm.migrator = migrator
+			require.Nil(t, sm.AddClass(context.Background(), nil, newClass(models.PropertyTokenizationWord)))
+
+			err := sm.UpdateClass(context.Background(), nil, "ClassWithTokenization",
+				newClass(models.PropertyTokenizationWord))
+			require.Nil(t, err)
+
+			assert.Nil(t, migrator.propNames)
+		})
+
+		t.Run("rejects the update while a tenant is inactive", func(t *testing.T) {
+			sm := newSchemaManager()
+			migrator := &tokenizationMigrator{}
+			sm.migrator = migrator
+			class := newClass(models.PropertyTokenizationWord)
+			class.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+			require.Nil(t, sm.AddClass(context.Background(), nil, class))
+			sm.schemaCache.LockGuard(func() {
+				sm.schemaCache.ShardingState["ClassWithTokenization"].Physical["tenant1"] = sharding.Physical{
+					Name:   "tenant1",
+					Status: models.TenantActivityStatusCOLD,
+				}
+			})
+
+			update := newClass(models.PropertyTokenizationCjk)
+			update.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+			err := sm.UpdateClass(context.Background(), nil, "ClassWithTokenization", update)
+			require.NotNil(t, err)
+			assert.Equal(t, "tokenization cannot be updated while tenant \"tenant1\" is COLD, "+
+				"activate all tenants first", err.Error())
+
+			assert.Nil(t, migrator.propNames)
+			assert.Equal(t, models.PropertyTokenizationWord, tokenizationOf(sm))
+		})
+	})
+
 	t.Run("update sharding config", func(t *testing.T) {
 		t.Run("with a validation error (immutable field)", func(t *testing.T) {
 			sm := newSchemaManager()
@@ -380,6 +555,38 @@ func TestClassUpdates(t *testing.T) {
 	})
 }
 
+func TestDroppedLocalShards(t *testing.T) {
+	before := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {Name: "S1", BelongsToNodes: []string{"N1", "N2"}},
+		"S2": {Name: "S2", BelongsToNodes: []string{"N2", "N1"}},
+		"S3": {Name: "S3", BelongsToNodes: []string{"N2", "N3"}},
+	}}
+	before.SetLocalName("N1")
+	after := before.DeepCopy()
+	_, err := after.DropReplicas(1)
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"S2"}, droppedLocalShards(before, &after))
+	assert.Empty(t, droppedLocalShards(&after, &after))
+}
+
+type tokenizationMigrator struct {
+	NilMigrator
+	err       error
+	propNames []string
+	commits   []bool
+}
+
+func (m *tokenizationMigrator) UpdatePropertyTokenization(ctx context.Context,
+	updated *models.Class, propNames []string,
+) (func(success bool), error) {
+	m.propNames = propNames
+	if m.err != nil {
+		return nil, m.err
+	}
+	return func(success bool) { m.commits = append(m.commits, success) }, nil
+}
+
 type configMigrator struct {
 	NilMigrator
 	vectorConfigValidationError    error
@@ -402,3 +609,9 @@ func (m *configMigrator) UpdateVectorIndexConfig(ctx context.Context,
 	m.vectorConfigUpdateCalled = true
 	return nil
 }
+
+func (m *configMigrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	return nil
+}
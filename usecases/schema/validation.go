@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -58,7 +59,8 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
 			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
-				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationNgram, models.PropertyTokenizationCjk:
 				return nil
 			}
 		default:
@@ -99,6 +101,19 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		}
 	}
 
+	if prop.IndexPositions != nil {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			// true or false allowed
+		default:
+			if *prop.IndexPositions {
+				return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+					"For other data types set false or leave empty")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -111,6 +126,46 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	if err := m.validateNamedVectors(ctx, class); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Manager) validateNamedVectors(ctx context.Context, class *models.Class) error {
+	if len(class.VectorConfig) == 0 {
+		return nil
+	}
+
+	if class.Vectorizer != config.VectorizerModuleNone {
+		return errors.Errorf("class.vectorizer %q can not be set if class.vectorConfig is configured",
+			class.Vectorizer)
+	}
+
+	for targetVector, vc := range class.VectorConfig {
+		if err := schema.ValidateVectorName(targetVector); err != nil {
+			return err
+		}
+
+		vectorizer, ok := vc.Vectorizer.(map[string]interface{})
+		if !ok || len(vectorizer) != 1 {
+			return errors.Errorf("named vector %q: vectorizer needs to be an object "+
+				"with exactly one module name as key", targetVector)
+		}
+
+		if moduleName, _ := schema.NamedVectorizer(vc); moduleName != config.VectorizerModuleNone {
+			if err := m.vectorizerValidator.ValidateVectorizer(moduleName); err != nil {
+				return errors.Wrapf(err, "named vector %q: vectorizer", targetVector)
+			}
+		}
+
+		if !validVectorIndexType(vc.VectorIndexType) {
+			return errors.Errorf("named vector %q: unrecognized or unsupported vectorIndexType %q",
+				targetVector, vc.VectorIndexType)
+		}
+	}
+
 	return nil
 }
 
@@ -127,11 +182,18 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 }
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
-	switch class.VectorIndexType {
-	case "hnsw":
-		return nil
-	default:
+	if !validVectorIndexType(class.VectorIndexType) {
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
 			class.VectorIndexType)
 	}
+	return nil
+}
+
+func validVectorIndexType(vectorIndexType string) bool {
+	switch vectorIndexType {
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFlat:
+		return true
+	default:
+		return false
+	}
 }
@@ -527,6 +527,40 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("validates indexPositions", func(t *testing.T) {
+		vFalse := false
+		vTrue := true
+
+		mgr := newSchemaManager()
+		for _, dataType := range []schema.DataType{
+			schema.DataTypeText, schema.DataTypeTextArray,
+			schema.DataTypeInt, schema.DataTypeBoolean, schema.DataTypeUUID,
+		} {
+			t.Run(dataType.String(), func(t *testing.T) {
+				for _, positions := range []*bool{nil, &vFalse} {
+					err := mgr.validatePropertyIndexing(&models.Property{
+						Name:           "prop",
+						DataType:       dataType.PropString(),
+						IndexPositions: positions,
+					})
+					require.Nil(t, err)
+				}
+
+				err := mgr.validatePropertyIndexing(&models.Property{
+					Name:           "prop",
+					DataType:       dataType.PropString(),
+					IndexPositions: &vTrue,
+				})
+				if dataType == schema.DataTypeText || dataType == schema.DataTypeTextArray {
+					require.Nil(t, err)
+				} else {
+					assert.EqualError(t, err, "`indexPositions` is allowed only for text/text[] data types. "+
+						"For other data types set false or leave empty")
+				}
+			})
+		}
+	})
 }
 
 type fakePropertyDataType struct {
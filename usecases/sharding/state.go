@@ -18,6 +18,7 @@ import (
 	"sort"
 
 	"github.com/spaolacci/murmur3"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster"
 )
 
@@ -63,6 +64,19 @@ type Physical struct {
 
 	LegacyBelongsToNodeForBackwardCompat string   `json:"belongsToNode,omitempty"`
 	BelongsToNodes                       []string `json:"belongsToNodes,omitempty"`
+
+	// Status is the activity status of a tenant (partition). An empty
+	// status is considered to be HOT for backward compatibility.
+	Status string `json:"status,omitempty"`
+}
+
+// ActivityStatus returns the activity status of the physical shard,
+// defaulting to HOT if none was set.
+func (p Physical) ActivityStatus() string {
+	if p.Status == "" {
+		return models.TenantActivityStatusHOT
+	}
+	return p.Status
 }
 
 // BelongsToNode for backward-compatibility when there was no replication. It
@@ -114,6 +128,26 @@ func (p *Physical) AdjustReplicas(count int, nodes nodes) error {
 	return nil
 }
 
+// DropReplicas shrinks the replica set of every physical shard to count.
+// Replicas at the head of BelongsToNodes are kept, so the node returned by
+// BelongsToNode() continues to own the shard. It returns for each shard the
+// nodes which no longer hold a replica of it.
+func (s *State) DropReplicas(count int) (map[string][]string, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("replication factor must be at least 1, got %d", count)
+	}
+	dropped := make(map[string][]string, len(s.Physical))
+	for name, p := range s.Physical {
+		if len(p.BelongsToNodes) <= count {
+			continue
+		}
+		dropped[name] = append([]string{}, p.BelongsToNodes[count:]...)
+		p.BelongsToNodes = p.BelongsToNodes[:count:count]
+		s.Physical[name] = p
+	}
+	return dropped, nil
+}
+
 type nodes interface {
 	Candidates() []string
 	LocalName() string
@@ -328,11 +362,12 @@ func (s *State) GetPartitions(nodes nodes, shards []string, replFactor int64) (m
 }
 
 // AddPartition to physical shards
-func (s *State) AddPartition(name string, nodes []string) Physical {
+func (s *State) AddPartition(name string, nodes []string, status string) Physical {
 	p := Physical{
 		Name:           name,
 		BelongsToNodes: nodes,
 		OwnsPercentage: 1.0,
+		Status:         status,
 	}
 	s.Physical[name] = p
 	return p
@@ -488,6 +523,7 @@ func (p Physical) DeepCopy() Physical {
 		OwnsVirtual:    ownsVirtualCopy,
 		OwnsPercentage: p.OwnsPercentage,
 		BelongsToNodes: belongsCopy,
+		Status:         p.Status,
 	}
 }
 
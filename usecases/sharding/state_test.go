@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func TestState(t *testing.T) {
@@ -229,6 +230,38 @@ func TestAdjustReplicas(t *testing.T) {
 	})
 }
 
+func TestDropReplicas(t *testing.T) {
+	t.Run("3->2", func(t *testing.T) {
+		state := State{Physical: map[string]Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"N1", "N2", "N3"}},
+			"S2": {Name: "S2", BelongsToNodes: []string{"N2", "N3", "N1"}},
+		}}
+		dropped, err := state.DropReplicas(2)
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]string{"S1": {"N3"}, "S2": {"N1"}}, dropped)
+		assert.Equal(t, []string{"N1", "N2"}, state.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N2", "N3"}, state.Physical["S2"].BelongsToNodes)
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		state := State{Physical: map[string]Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"N1"}},
+		}}
+		dropped, err := state.DropReplicas(2)
+		require.Nil(t, err)
+		assert.Empty(t, dropped)
+		assert.Equal(t, []string{"N1"}, state.Physical["S1"].BelongsToNodes)
+	})
+
+	t.Run("Min", func(t *testing.T) {
+		state := State{Physical: map[string]Physical{
+			"S1": {Name: "S1", BelongsToNodes: []string{"N1", "N2"}},
+		}}
+		_, err := state.DropReplicas(0)
+		require.NotNil(t, err)
+	})
+}
+
 func TestGetPartitions(t *testing.T) {
 	t.Run("EmptyCandidatesList", func(t *testing.T) {
 		// nodes := fakeNodes{nodes: []string{"N1", "N2", "N3", "N4", "N5"}}
@@ -272,14 +305,16 @@ func TestAddPartition(t *testing.T) {
 	s, err := InitState("my-index", cfg, nodes, 1, true)
 	require.Nil(t, err)
 
-	s.AddPartition("A", nodes1)
-	s.AddPartition("B", nodes2)
+	s.AddPartition("A", nodes1, "")
+	s.AddPartition("B", nodes2, models.TenantActivityStatusCOLD)
 
 	want := map[string]Physical{
 		"A": {Name: "A", BelongsToNodes: nodes1, OwnsPercentage: 1},
-		"B": {Name: "B", BelongsToNodes: nodes2, OwnsPercentage: 1},
+		"B": {Name: "B", BelongsToNodes: nodes2, OwnsPercentage: 1, Status: models.TenantActivityStatusCOLD},
 	}
 	require.Equal(t, want, s.Physical)
+	assert.Equal(t, models.TenantActivityStatusHOT, s.Physical["A"].ActivityStatus())
+	assert.Equal(t, models.TenantActivityStatusCOLD, s.Physical["B"].ActivityStatus())
 }
 
 func TestStateDeepCopy(t *testing.T) {
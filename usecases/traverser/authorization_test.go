@@ -23,7 +23,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -97,6 +99,119 @@ func Test_Traverser_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Traverser_Authorization_CrossReferences(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	params := dto.GetParams{
+		ClassName: "Article",
+		Tenant:    "acme",
+		Properties: search.SelectProperties{
+			{Name: "title", IsPrimitive: true},
+			{Name: "ofPublication", Refs: []search.SelectClass{{
+				ClassName: "Publication",
+				RefProperties: search.SelectProperties{{
+					Name: "hasEditors",
+					Refs: []search.SelectClass{{ClassName: "Person"}},
+				}},
+			}}},
+		},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    "Article",
+					Property: "writtenBy",
+					Child:    &filters.Path{Class: "Author", Property: "name"},
+				},
+			}},
+		}},
+	}
+
+	t.Run("all referenced classes are authorized", func(t *testing.T) {
+		authorizer := &authAllower{}
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+			&fakeVectorRepo{}, &fakeExplorer{}, &fakeSchemaGetter{}, nil, nil, -1)
+
+		_, err := manager.GetClass(context.Background(), principal, params)
+		require.Nil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "traversal/*"},
+			{principal, "get", "objects/Article/tenants/acme"},
+			{principal, "get", "objects/Publication/tenants/acme"},
+			{principal, "get", "objects/Person/tenants/acme"},
+			{principal, "get", "objects/Author/tenants/acme"},
+		}, authorizer.calls)
+	})
+
+	t.Run("an unauthorized referenced class fails the query", func(t *testing.T) {
+		authorizer := &authAllower{deny: "objects/Person/tenants/acme"}
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+			&fakeVectorRepo{}, &fakeExplorer{}, &fakeSchemaGetter{}, nil, nil, -1)
+
+		_, err := manager.GetClass(context.Background(), principal, params)
+		assert.Equal(t, errors.New("just a test fake"), err)
+	})
+}
+
+func Test_Traverser_Authorization_AggregateCrossReferences(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	params := &aggregation.Params{
+		ClassName: "Article",
+		Tenant:    "acme",
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    "Article",
+				Property: "writtenBy",
+				Child:    &filters.Path{Class: "Author", Property: "name"},
+			},
+		}},
+	}
+
+	t.Run("an unauthorized referenced class fails the query", func(t *testing.T) {
+		authorizer := &authAllower{deny: "objects/Author/tenants/acme"}
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+			&fakeVectorRepo{}, &fakeExplorer{}, &fakeSchemaGetter{}, nil, nil, -1)
+
+		_, err := manager.Aggregate(context.Background(), principal, params)
+		assert.Equal(t, errors.New("just a test fake"), err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "get", "traversal/*"},
+			{principal, "get", "objects/Article/tenants/acme"},
+			{principal, "get", "objects/Author/tenants/acme"},
+		}, authorizer.calls)
+	})
+}
+
+func Test_Traverser_Authorization_Explore(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	explorer := &fakeExplorer{results: []search.Result{
+		{ClassName: "Article", ID: "a1"},
+		{ClassName: "Secret", ID: "s1"},
+		{ClassName: "Article", ID: "a2"},
+		{ClassName: "Secret", ID: "s2"},
+	}}
+
+	authorizer := &authAllower{deny: "objects/Secret"}
+	manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+		&fakeVectorRepo{}, explorer, &fakeSchemaGetter{}, nil, nil, -1)
+
+	res, err := manager.Explore(context.Background(), principal, ExploreParams{})
+	require.Nil(t, err)
+	assert.Equal(t, []search.Result{
+		{ClassName: "Article", ID: "a1"},
+		{ClassName: "Article", ID: "a2"},
+	}, res)
+	assert.Equal(t, []authorizeCall{
+		{principal, "get", "traversal/*"},
+		{principal, "get", "objects/Article"},
+		{principal, "get", "objects/Secret"},
+	}, authorizer.calls)
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -112,6 +227,20 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// authAllower allows everything except for the deny resource
+type authAllower struct {
+	calls []authorizeCall
+	deny  string
+}
+
+func (a *authAllower) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	if resource == a.deny {
+		return errors.New("just a test fake")
+	}
+	return nil
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
@@ -57,7 +57,7 @@ type explorerMetrics interface {
 type ModulesProvider interface {
 	ValidateSearchParam(name string, value interface{}, className string) error
 	CrossClassValidateSearchParam(name string, value interface{}) error
-	VectorFromSearchParam(ctx context.Context, className string, param string,
+	VectorFromSearchParam(ctx context.Context, className, targetVector string, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string) ([]float32, error)
 	CrossClassVectorFromSearchParam(ctx context.Context, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn) ([]float32, error)
@@ -67,7 +67,7 @@ type ModulesProvider interface {
 	ListExploreAdditionalExtend(ctx context.Context, in []search.Result,
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
-	VectorFromInput(ctx context.Context, className string, input string) ([]float32, error)
+	VectorFromInput(ctx context.Context, className, targetVector string, input string) ([]float32, error)
 }
 
 type objectsSearcher interface {
@@ -90,7 +90,7 @@ type objectsSearcher interface {
 
 type hybridSearcher interface {
 	SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*storobj.Object, []float32, error)
-	DenseObjectSearch(context.Context, string, []float32, int, int,
+	DenseObjectSearch(context.Context, string, []float32, string, int, int,
 		*filters.LocalFilter, additional.Properties, string) ([]*storobj.Object, []float32, error)
 	ResolveReferences(ctx context.Context, objs search.Results, props search.SelectProperties,
 		groupBy *searchparams.GroupBy, additional additional.Properties, tenant string) (search.Results, error)
@@ -196,6 +196,13 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]interface{}, error) {
+	targetVector, err := e.targetVectorFromParams(params.ClassName,
+		e.nearParamsVector.targetVectorFromParams(params.NearVector, params.NearObject, params.ModuleParams))
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: %v", err)
+	}
+	params.TargetVector = targetVector
+
 	searchVector, err := e.vectorFromParams(ctx, params)
 	if err != nil {
 		return nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
@@ -248,6 +255,18 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 }
 
 func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	if params.HybridSearch.Alpha > 0 || params.HybridSearch.SubSearches != nil {
+		// only the vector search of a hybrid search needs a target vector
+		targetVector, err := e.targetVectorFromParams(params.ClassName, params.HybridSearch.TargetVector)
+		if err != nil {
+			return nil, err
+		}
+		hybridSearch := *params.HybridSearch
+		hybridSearch.TargetVector = targetVector
+		params.HybridSearch = &hybridSearch
+		params.TargetVector = targetVector
+	}
+
 	sparseSearch := func() ([]*storobj.Object, []float32, error) {
 		params.KeywordRanking = &searchparams.KeywordRanking{
 			Query:      params.HybridSearch.Query,
@@ -269,7 +288,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 			hybridSearchLimit = hybrid.DefaultLimit
 		}
 		res, dists, err := e.searcher.DenseObjectSearch(ctx,
-			params.ClassName, vec, 0, hybridSearchLimit, params.Filters,
+			params.ClassName, vec, params.TargetVector, 0, hybridSearchLimit, params.Filters,
 			params.AdditionalProperties, params.Tenant)
 		if err != nil {
 			return nil, nil, err
@@ -450,6 +469,13 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 
 		if params.AdditionalProperties.Vector {
 			additionalProperties["vector"] = res.Vector
+			if len(res.Vectors) > 0 {
+				vectors := make(map[string]interface{}, len(res.Vectors))
+				for targetVector, vector := range res.Vectors {
+					vectors[targetVector] = vector
+				}
+				additionalProperties["vectors"] = vectors
+			}
 		}
 
 		if params.AdditionalProperties.CreationTimeUnix {
@@ -628,7 +654,33 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 	params dto.GetParams,
 ) ([]float32, error) {
 	return e.nearParamsVector.vectorFromParams(ctx, params.NearVector,
-		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant)
+		params.NearObject, params.ModuleParams, params.ClassName, params.TargetVector, params.Tenant)
+}
+
+// targetVectorFromParams validates the target vector of a search and returns
+// the named vector which is searched. If a class has exactly one named vector,
+// it is searched when no target vector is set.
+func (e *Explorer) targetVectorFromParams(className, targetVector string) (string, error) {
+	if e.schemaGetter == nil {
+		return targetVector, nil
+	}
+
+	return validateTargetVector(e.schemaGetter.GetSchemaSkipAuth(), className, targetVector)
+}
+
+func validateTargetVector(sch schema.Schema, className, targetVector string) (string, error) {
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil || (targetVector == "" && len(class.VectorConfig) == 0) {
+		return targetVector, nil
+	}
+
+	if _, err := schema.TargetVectorIndexConfig(class, targetVector); err != nil {
+		return "", err
+	}
+	if targetVector == "" {
+		return schema.DefaultTargetVector(class), nil
+	}
+	return targetVector, nil
 }
 
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
@@ -689,12 +741,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := typeAssertVectorIndex(class)
+	vectorConfig, err := typeAssertVectorIndex(class)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if dn := vectorConfig.DistanceName(); dn != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(dn)
 	}
 
 	return nil
@@ -2749,11 +2749,11 @@ type fakeModulesProvider struct {
 	customC11yModule *fakeText2vecContextionaryModule
 }
 
-func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className, targetVector string, input string) ([]float32, error) {
 	panic("not implemented")
 }
 
-func (p *fakeModulesProvider) VectorFromSearchParam(ctx context.Context, className,
+func (p *fakeModulesProvider) VectorFromSearchParam(ctx context.Context, className, targetVector,
 	param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn, tenant string,
 ) ([]float32, error) {
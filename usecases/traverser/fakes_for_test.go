@@ -140,7 +140,7 @@ func (f *fakeVectorSearcher) SparseObjectSearch(ctx context.Context,
 }
 
 func (f *fakeVectorSearcher) DenseObjectSearch(context.Context, string,
-	[]float32, int, int, *filters.LocalFilter, additional.Properties, string,
+	[]float32, string, int, int, *filters.LocalFilter, additional.Properties, string,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -191,14 +191,16 @@ func (f *fakeVectorRepo) GetObject(ctx context.Context, uuid strfmt.UUID,
 	return args.Error(1)
 }
 
-type fakeExplorer struct{}
+type fakeExplorer struct {
+	results []search.Result
+}
 
 func (f *fakeExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
 	return nil, nil
 }
 
 func (f *fakeExplorer) CrossClassVectorSearch(ctx context.Context, p ExploreParams) ([]search.Result, error) {
-	return nil, nil
+	return f.results, nil
 }
 
 type fakeSchemaGetter struct {
@@ -232,7 +234,9 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 }
 
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) { return shard, nil }
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string        { return tenant }
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
+}
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return string(uuid) }
 
 func (f *fakeSchemaGetter) Nodes() []string {
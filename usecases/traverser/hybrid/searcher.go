@@ -74,7 +74,7 @@ type postProcFunc func(hybridResults Results) (postProcResults []search.Result,
 
 type modulesProvider interface {
 	VectorFromInput(ctx context.Context,
-		className string, input string) ([]float32, error)
+		className, targetVector string, input string) ([]float32, error)
 }
 
 type Searcher struct {
@@ -333,7 +333,7 @@ func (s *Searcher) decideSearchVector(ctx context.Context) ([]float32, error) {
 }
 
 func (s *Searcher) vectorFromModuleInput(ctx context.Context, class, input string) ([]float32, error) {
-	vector, err := s.modulesProvider.VectorFromInput(ctx, class, input)
+	vector, err := s.modulesProvider.VectorFromInput(ctx, class, s.params.TargetVector, input)
 	if err != nil {
 		return nil, fmt.Errorf("get vector input from modules provider: %w", err)
 	}
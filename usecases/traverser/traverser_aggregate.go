@@ -20,6 +20,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // Aggregate resolves meta queries
@@ -34,6 +35,16 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	// cross-references in filters are resolved within the same tenant, their
+	// target classes have to be readable just like the aggregated class
+	for _, class := range aggregatedClasses(params) {
+		err = t.authorizer.Authorize(principal, "get",
+			authorization.Objects(class, params.Tenant, ""))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -49,8 +60,14 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		if err != nil {
 			return nil, err
 		}
+		targetVector, err := validateTargetVector(t.schemaGetter.GetSchemaSkipAuth(), className,
+			t.nearParamsVector.targetVectorFromParams(params.NearVector, params.NearObject, params.ModuleParams))
+		if err != nil {
+			return nil, err
+		}
+		params.TargetVector = targetVector
 		searchVector, err := t.nearParamsVector.vectorFromParams(ctx,
-			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant)
+			params.NearVector, params.NearObject, params.ModuleParams, className, targetVector, params.Tenant)
 		if err != nil {
 			return nil, err
 		}
@@ -65,8 +82,14 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	}
 
 	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
+		targetVector, err := validateTargetVector(t.schemaGetter.GetSchemaSkipAuth(),
+			params.ClassName.String(), params.Hybrid.TargetVector)
+		if err != nil {
+			return nil, err
+		}
+		params.TargetVector = targetVector
 		vec, err := t.nearParamsVector.modulesProvider.
-			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query)
+			VectorFromInput(ctx, params.ClassName.String(), targetVector, params.Hybrid.Query)
 		if err != nil {
 			return nil, err
 		}
@@ -86,3 +109,17 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	return inspector.WithTypes(res, *params)
 }
+
+// aggregatedClasses returns the aggregated class followed by all classes
+// which are read through cross-references in the filters
+func aggregatedClasses(params *aggregation.Params) []string {
+	classes := []string{params.ClassName.String()}
+	seen := map[string]struct{}{params.ClassName.String(): {}}
+	for _, class := range filteredClasses(params.Filters) {
+		if _, ok := seen[class]; !ok {
+			seen[class] = struct{}{}
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
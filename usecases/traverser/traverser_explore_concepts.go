@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 // Explore through unstructured search terms
@@ -41,7 +42,33 @@ func (t *Traverser) Explore(ctx context.Context,
 		return nil, err
 	}
 
-	return t.explorer.CrossClassVectorSearch(ctx, params)
+	res, err := t.explorer.CrossClassVectorSearch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.authorizedExploreResults(principal, res), nil
+}
+
+// authorizedExploreResults drops the results of classes which the principal
+// is not allowed to get, as Explore searches across all classes
+func (t *Traverser) authorizedExploreResults(principal *models.Principal,
+	results []search.Result,
+) []search.Result {
+	allowed := map[string]bool{}
+	authorized := make([]search.Result, 0, len(results))
+	for _, res := range results {
+		ok, checked := allowed[res.ClassName]
+		if !checked {
+			ok = t.authorizer.Authorize(principal, "get",
+				authorization.Objects(res.ClassName, "", "")) == nil
+			allowed[res.ClassName] = ok
+		}
+		if ok {
+			authorized = append(authorized, res)
+		}
+	}
+	return authorized
 }
 
 // ExploreParams are the parameters used by the GraphQL `Explore { }` API
@@ -17,7 +17,10 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -44,6 +47,16 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	// cross-references are resolved within the same tenant, their target
+	// classes have to be readable just like the queried class
+	for _, class := range queriedClasses(params) {
+		err = t.authorizer.Authorize(principal, "get",
+			authorization.Objects(class, params.Tenant, ""))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -62,3 +75,59 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	return t.explorer.GetClass(ctx, params)
 }
+
+// queriedClasses returns the class of the query followed by all classes
+// which are read through cross-references, either in the selected properties
+// or in the filters
+func queriedClasses(params dto.GetParams) []string {
+	classes := []string{params.ClassName}
+	seen := map[string]struct{}{params.ClassName: {}}
+	add := func(class string) {
+		if _, ok := seen[class]; !ok && class != "" {
+			seen[class] = struct{}{}
+			classes = append(classes, class)
+		}
+	}
+
+	var addSelected func(props search.SelectProperties)
+	addSelected = func(props search.SelectProperties) {
+		for _, prop := range props {
+			for _, ref := range prop.Refs {
+				add(ref.ClassName)
+				addSelected(ref.RefProperties)
+			}
+		}
+	}
+	addSelected(params.Properties)
+
+	for _, class := range filteredClasses(params.Filters) {
+		add(class)
+	}
+
+	return classes
+}
+
+// filteredClasses returns the classes of all paths in the filter, i.e. the
+// filtered class and the classes read through cross-references. Classes may
+// be contained more than once.
+func filteredClasses(filter *filters.LocalFilter) []string {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+
+	var classes []string
+	var addFiltered func(clause *filters.Clause)
+	addFiltered = func(clause *filters.Clause) {
+		for path := clause.On; path != nil; path = path.Child {
+			if class := path.Class.String(); class != "" {
+				classes = append(classes, class)
+			}
+		}
+		for i := range clause.Operands {
+			addFiltered(&clause.Operands[i])
+		}
+	}
+	addFiltered(filter.Root)
+
+	return classes
+}
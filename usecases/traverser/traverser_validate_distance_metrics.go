@@ -58,14 +58,14 @@ func (t *Traverser) validateCrossClassDistanceCompatibility() (distType string,
 			continue
 		}
 
-		hnswConfig, assertErr := typeAssertVectorIndex(class)
+		vectorConfig, assertErr := typeAssertVectorIndex(class)
 		if assertErr != nil {
 			err = assertErr
 			return
 		}
 
-		distancerTypes[hnswConfig.Distance] = struct{}{}
-		classDistanceConfigs[class.Class] = hnswConfig.Distance
+		distancerTypes[vectorConfig.DistanceName()] = struct{}{}
+		classDistanceConfigs[class.Class] = vectorConfig.DistanceName()
 	}
 
 	if len(distancerTypes) != 1 {
@@ -103,26 +103,31 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 		return fmt.Errorf("failed to find class '%s' in schema", params.ClassName)
 	}
 
-	hnswConfig, err := typeAssertVectorIndex(class)
+	targetVector := t.nearParamsVector.targetVectorFromParams(params.NearVector,
+		params.NearObject, params.ModuleParams)
+	if params.HybridSearch != nil && targetVector == "" {
+		targetVector = params.HybridSearch.TargetVector
+	}
+	vectorConfig, err := schema.TargetVectorIndexConfig(class, targetVector)
 	if err != nil {
 		return err
 	}
 
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if dn := vectorConfig.DistanceName(); dn != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(dn)
 	}
 
 	return nil
 }
 
-func typeAssertVectorIndex(class *models.Class) (hnsw.UserConfig, error) {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+func typeAssertVectorIndex(class *models.Class) (schema.VectorIndexConfig, error) {
+	vectorConfig, ok := class.VectorIndexConfig.(schema.VectorIndexConfig)
 	if !ok {
-		return hnsw.UserConfig{}, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
+		return nil, fmt.Errorf("class '%s' vector index: config is not schema.VectorIndexConfig: %T",
 			class.Class, class.VectorIndexConfig)
 	}
 
-	return hnswConfig, nil
+	return vectorConfig, nil
 }
 
 func crossClassDistCompatError(classDistanceConfigs map[string]string) error {